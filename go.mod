@@ -1,9 +1,14 @@
 module github.com/timworks/terraform-provider-twilio
 
-go 1.16
+go 1.21
 
 require (
+	github.com/ProtonMail/go-crypto v1.1.0-alpha.2
 	github.com/timworks/twilio-sdk-go v0.19.0
-	github.com/hashicorp/terraform-plugin-sdk/v2 v2.7.1
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/terraform-plugin-framework v1.8.0
+	github.com/hashicorp/terraform-plugin-go v0.23.0
+	github.com/hashicorp/terraform-plugin-mux v0.16.0
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.34.0
 	github.com/mitchellh/go-homedir v1.1.0
 )
@@ -1,12 +1,46 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/timworks/terraform-provider-twilio/twilio"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/timworks/terraform-provider-twilio/twilio/generate"
+)
+
+var (
+	// version is set during the release process, see goreleaser.yml
+	version = "dev"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: twilio.Provider,
-	})
+	if len(os.Args) > 1 && os.Args[1] == generate.Command {
+		if err := generate.Run(os.Args[2:], version, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "Start the provider with support for debuggers such as delve")
+	flag.Parse()
+
+	providerServer, err := twilio.ProviderServer(context.Background(), version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	if err := tf6server.Serve("registry.terraform.io/RJPearson94/twilio", providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,202 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveFields are the JSON & form fields which must never be logged. Field names are compared case insensitively with underscores removed, so both `auth_token` (JSON) and `AuthToken` (form) are matched
+var sensitiveFields = []string{
+	"auth_token",
+	"secret",
+	"aws_secret_access_key",
+	"password",
+	"api_secret",
+	"private_key",
+}
+
+// sensitiveFormFields are additional form fields which contain secrets. i.e. AWS credentials are sent as `Credentials=<access key id>:<secret access key>`
+var sensitiveFormFields = []string{
+	"credentials",
+}
+
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// LoggingTransport logs each HTTP request and response when Terraform is run with TF_LOG set to DEBUG or TRACE.
+// Credentials are redacted from the headers and sensitive fields are redacted from form and JSON bodies before being logged
+type LoggingTransport struct {
+	Transport http.RoundTripper
+}
+
+func NewLoggingTransport(transport http.RoundTripper) *LoggingTransport {
+	return &LoggingTransport{
+		Transport: transport,
+	}
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !logging.IsDebugOrHigher() {
+		return t.transport().RoundTrip(req)
+	}
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		log.Printf("[DEBUG] Twilio API Request: %s %s\n%s\n%s", req.Method, redactURL(req.URL), formatHeaders(req.Header), RedactBody(req.Header.Get("Content-Type"), body))
+	} else {
+		log.Printf("[DEBUG] Twilio API Request: %s %s\n%s", req.Method, redactURL(req.URL), formatHeaders(req.Header))
+	}
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] Twilio API Request Error: %s %s: %s", req.Method, redactURL(req.URL), err.Error())
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	log.Printf("[DEBUG] Twilio API Response: %s %s\n%s\n%s\n%s", req.Method, redactURL(req.URL), resp.Status, formatHeaders(resp.Header), RedactBody(resp.Header.Get("Content-Type"), body))
+	return resp, nil
+}
+
+func (t *LoggingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func formatHeaders(headers http.Header) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(headers[name], ", ")
+		for _, sensitiveHeader := range sensitiveHeaders {
+			if strings.EqualFold(name, sensitiveHeader) {
+				value = redactedValue
+			}
+		}
+		lines = append(lines, name+": "+value)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func redactURL(requestURL *url.URL) string {
+	redactedURL := *requestURL
+	redactedURL.User = nil
+	if redactedURL.RawQuery != "" {
+		redactedURL.RawQuery = redactForm(redactedURL.Query()).Encode()
+	}
+	return redactedURL.String()
+}
+
+// RedactBody returns a copy of the body which is safe to be logged or persisted. Sensitive fields are redacted from form and JSON bodies, all other bodies are omitted
+func RedactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "[unparsable form body omitted]"
+		}
+		return redactForm(form).Encode()
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var content interface{}
+		if err := json.Unmarshal(body, &content); err != nil {
+			return "[unparsable JSON body omitted]"
+		}
+		redactedBody, err := json.Marshal(redactJSON(content))
+		if err != nil {
+			return "[unparsable JSON body omitted]"
+		}
+		return string(redactedBody)
+	default:
+		// Multipart uploads (i.e. serverless function & asset versions) and binary content are not logged as they can contain secrets and large files
+		return "[" + mediaType + " body omitted]"
+	}
+}
+
+func redactForm(form url.Values) url.Values {
+	redactedForm := url.Values{}
+	for key, values := range form {
+		if isSensitiveField(key, sensitiveFields) || isSensitiveField(key, sensitiveFormFields) {
+			redactedForm[key] = []string{redactedValue}
+			continue
+		}
+		redactedForm[key] = values
+	}
+	return redactedForm
+}
+
+func redactJSON(content interface{}) interface{} {
+	switch value := content.(type) {
+	case map[string]interface{}:
+		redactedContent := make(map[string]interface{}, len(value))
+		for key, fieldValue := range value {
+			if _, isString := fieldValue.(string); isString && isSensitiveField(key, sensitiveFields) {
+				redactedContent[key] = redactedValue
+				continue
+			}
+			redactedContent[key] = redactJSON(fieldValue)
+		}
+		return redactedContent
+	case []interface{}:
+		redactedContent := make([]interface{}, len(value))
+		for index, item := range value {
+			redactedContent[index] = redactJSON(item)
+		}
+		return redactedContent
+	default:
+		return value
+	}
+}
+
+func isSensitiveField(name string, fields []string) bool {
+	normalisedName := normaliseFieldName(name)
+	for _, field := range fields {
+		if normalisedName == normaliseFieldName(field) {
+			return true
+		}
+	}
+	return false
+}
+
+func normaliseFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
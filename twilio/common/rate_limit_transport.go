@@ -0,0 +1,309 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const defaultMaxBackoff = 60 * time.Second
+
+// RateLimitOptions configures the client-side rate limiting and retry behaviour of the RateLimitTransport.
+// A RequestsPerSecond or MaxConcurrentRequests of 0 disables the respective limit
+type RateLimitOptions struct {
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+	RetryAttempts         int
+	BackoffInterval       time.Duration
+	MaxBackoff            time.Duration
+}
+
+// RateLimitTransport limits the rate and concurrency of requests sent to Twilio and retries requests which are throttled (HTTP 429 / error code 20429) or
+// rejected due to the service being unavailable. Requests which fail with a transport error (i.e. connection reset, DNS failure or timeout) are also
+// retried, when the request is idempotent or the connection could not be established so the request cannot have reached Twilio.
+// Retries use exponential backoff with full jitter, unless Twilio supplies a Retry-After header. Both the backoff and any Retry-After wait are capped at
+// the MaxBackoff, so a large Retry-After value cannot stall the provider.
+// A single transport is shared by every SDK client, so the limits apply to all calls made through the TwilioClient
+type RateLimitTransport struct {
+	Transport http.RoundTripper
+
+	options   RateLimitOptions
+	bucket    *tokenBucket
+	semaphore chan struct{}
+}
+
+func NewRateLimitTransport(options RateLimitOptions, transport http.RoundTripper) *RateLimitTransport {
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = defaultMaxBackoff
+	}
+
+	rateLimitTransport := &RateLimitTransport{
+		Transport: transport,
+		options:   options,
+		bucket:    newTokenBucket(options.RequestsPerSecond),
+	}
+
+	if options.MaxConcurrentRequests > 0 {
+		rateLimitTransport.semaphore = make(chan struct{}, options.MaxConcurrentRequests)
+	}
+	return rateLimitTransport
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if req.Body != nil && req.GetBody == nil {
+		req = req.Clone(ctx)
+		if err := bufferRequestBody(req); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.roundTrip(ctx, attemptReq)
+		if err != nil {
+			if attempt >= t.options.RetryAttempts || !isRetryableError(ctx, req, err) {
+				return nil, err
+			}
+
+			wait := t.backoff(attempt)
+			log.Printf("[DEBUG] Request to %s failed (%s), retrying in %s (attempt %d of %d)", req.URL.Path, err, wait, attempt+1, t.options.RetryAttempts)
+
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if !isRetryableStatus(resp.StatusCode) || attempt >= t.options.RetryAttempts {
+			return resp, nil
+		}
+
+		retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		wait := t.backoff(attempt)
+		if hasRetryAfter {
+			wait = t.capWait(retryAfter)
+			// Twilio has asked for all requests to be paused, so hold back every request not just this one
+			t.bucket.pauseUntil(time.Now().Add(wait))
+		}
+
+		log.Printf("[DEBUG] Request to %s was rate limited (status %d), retrying in %s (attempt %d of %d)", req.URL.Path, resp.StatusCode, wait, attempt+1, t.options.RetryAttempts)
+
+		// Drain the body so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RateLimitTransport) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if err := t.bucket.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+			defer func() { <-t.semaphore }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return t.transport().RoundTrip(req)
+}
+
+// backoff calculates an exponential backoff with full jitter, capped at the max backoff
+func (t *RateLimitTransport) backoff(attempt int) time.Duration {
+	maxWait := float64(t.options.BackoffInterval) * math.Pow(2, float64(attempt))
+	if maxWait <= 0 || maxWait > float64(t.options.MaxBackoff) {
+		maxWait = float64(t.options.MaxBackoff)
+	}
+	return time.Duration(rand.Int63n(int64(maxWait) + 1))
+}
+
+// capWait limits a wait requested by Twilio (via the Retry-After header) to the max backoff
+func (t *RateLimitTransport) capWait(wait time.Duration) time.Duration {
+	if wait > t.options.MaxBackoff {
+		return t.options.MaxBackoff
+	}
+	return wait
+}
+
+func (t *RateLimitTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// isRetryableError determines whether a transport error can be safely retried. Errors raised before a connection is established are always
+// retried as the request was never sent, other errors (i.e. connection reset or timeout) are only retried for idempotent methods as Twilio may have
+// already processed the request
+func isRetryableError(ctx context.Context, req *http.Request, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return isIdempotentMethod(req.Method)
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter supports both the delay in seconds and HTTP date formats of the Retry-After header
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func bufferRequestBody(req *http.Request) error {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket which refills at the configured rate, with a burst size of the rate rounded up.
+// A rate of 0 only enforces pauses requested via Retry-After headers
+type tokenBucket struct {
+	mutex   sync.Mutex
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	resumes time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		wait := b.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token when one is available, otherwise it returns how long to wait before trying again
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if now.Before(b.resumes) {
+		return b.resumes.Sub(now)
+	}
+
+	if b.rate <= 0 {
+		return 0
+	}
+
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) pauseUntil(resumes time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if resumes.After(b.resumes) {
+		b.resumes = resumes
+	}
+}
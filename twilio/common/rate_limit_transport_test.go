@@ -0,0 +1,381 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// failingTransport returns the supplied errors in order, followed by a successful response once all errors have been returned
+func failingTransport(attempts *int, bodies *[]string, errs ...error) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempt := *attempts
+		*attempts++
+
+		if req.Body != nil {
+			body, _ := io.ReadAll(req.Body)
+			*bodies = append(*bodies, string(body))
+		}
+
+		if attempt < len(errs) {
+			return nil, errs[attempt]
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Header:     http.Header{},
+		}, nil
+	})
+}
+
+func TestRateLimitTransportTransportErrors(t *testing.T) {
+	connectionReset := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	connectionRefused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	dnsFailure := &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.twilio.com"}}
+
+	testCases := map[string]struct {
+		method           string
+		errs             []error
+		expectedAttempts int
+		expectError      bool
+	}{
+		"GET connection reset is retried": {
+			method:           http.MethodGet,
+			errs:             []error{connectionReset, connectionReset},
+			expectedAttempts: 3,
+		},
+		"DELETE connection reset is retried": {
+			method:           http.MethodDelete,
+			errs:             []error{connectionReset},
+			expectedAttempts: 2,
+		},
+		"POST connection reset is not retried": {
+			method:           http.MethodPost,
+			errs:             []error{connectionReset},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"POST connection refused is retried": {
+			method:           http.MethodPost,
+			errs:             []error{connectionRefused},
+			expectedAttempts: 2,
+		},
+		"POST DNS failure is retried": {
+			method:           http.MethodPost,
+			errs:             []error{dnsFailure},
+			expectedAttempts: 2,
+		},
+		"GET context cancelled is not retried": {
+			method:           http.MethodGet,
+			errs:             []error{context.Canceled},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"GET retries are exhausted": {
+			method:           http.MethodGet,
+			errs:             []error{connectionReset, connectionReset, connectionReset, connectionReset},
+			expectedAttempts: 4,
+			expectError:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			bodies := make([]string, 0)
+			transport := NewRateLimitTransport(RateLimitOptions{
+				RetryAttempts:   3,
+				BackoffInterval: 0,
+				MaxBackoff:      1,
+			}, failingTransport(&attempts, &bodies, testCase.errs...))
+
+			req, err := http.NewRequest(testCase.method, "https://api.twilio.com/2010-04-01/Accounts.json", strings.NewReader("FriendlyName=test"))
+			if err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("Expected an error to be returned")
+				}
+				if !errors.Is(err, testCase.errs[attempts-1]) {
+					t.Errorf("Expected the error %q to be returned, got %q", testCase.errs[attempts-1], err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("err: %s", err.Error())
+				}
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("Expected status code 200, got %d", resp.StatusCode)
+				}
+			}
+
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("Expected %d attempts, got %d", testCase.expectedAttempts, attempts)
+			}
+			for _, body := range bodies {
+				if body != "FriendlyName=test" {
+					t.Errorf("Expected the request body to be resent on each attempt, got %q", body)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportRetryableStatus(t *testing.T) {
+	attempts := 0
+	transport := NewRateLimitTransport(RateLimitOptions{
+		RetryAttempts: 2,
+		MaxBackoff:    1,
+	}, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		statusCode := http.StatusTooManyRequests
+		if attempts > 1 {
+			statusCode = http.StatusOK
+		}
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Header:     http.Header{},
+		}, nil
+	}))
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.twilio.com/2010-04-01/Accounts.json", strings.NewReader("FriendlyName=test"))
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRateLimitTransportRetryAfterIsCapped(t *testing.T) {
+	attempts := 0
+	transport := NewRateLimitTransport(RateLimitOptions{
+		RetryAttempts: 1,
+		MaxBackoff:    10 * time.Millisecond,
+	}, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		statusCode := http.StatusTooManyRequests
+		if attempts > 1 {
+			statusCode = http.StatusOK
+		}
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Header:     http.Header{"Retry-After": []string{"3600"}},
+		}, nil
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.twilio.com/2010-04-01/Accounts.json", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the Retry-After wait to be capped at the max backoff, took %s", elapsed)
+	}
+	if resumes := transport.bucket.resumes; resumes.After(time.Now().Add(10 * time.Millisecond)) {
+		t.Errorf("Expected the bucket pause to be capped at the max backoff, requests are paused until %s", resumes)
+	}
+}
+
+func TestRateLimitTransportMaxConcurrentRequests(t *testing.T) {
+	var mutex sync.Mutex
+	inFlight := 0
+	maxInFlight := 0
+	release := make(chan struct{})
+
+	transport := NewRateLimitTransport(RateLimitOptions{
+		MaxConcurrentRequests: 2,
+	}, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mutex.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mutex.Unlock()
+
+		<-release
+
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Header:     http.Header{},
+		}, nil
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://api.twilio.com/2010-04-01/Accounts.json", nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Errorf("err: %s", err.Error())
+			}
+		}()
+	}
+
+	// Wait for the semaphore to fill up before checking no further requests have been let through
+	for len(transport.semaphore) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	mutex.Lock()
+	if inFlight != 2 {
+		t.Errorf("Expected 2 requests to be in flight, got %d", inFlight)
+	}
+	mutex.Unlock()
+
+	close(release)
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestRateLimitTransportMaxConcurrentRequestsContextCancelled(t *testing.T) {
+	transport := NewRateLimitTransport(RateLimitOptions{
+		MaxConcurrentRequests: 1,
+	}, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Error("Expected the request not to be sent")
+		return nil, nil
+	}))
+	transport.semaphore <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.twilio.com/2010-04-01/Accounts.json", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the request to be cancelled whilst waiting for the semaphore, got %v", err)
+	}
+}
+
+func TestTokenBucketRateAndBurst(t *testing.T) {
+	testCases := map[string]struct {
+		rate          float64
+		expectedBurst int
+		expectedWait  time.Duration
+	}{
+		"whole rate": {
+			rate:          5,
+			expectedBurst: 5,
+			expectedWait:  200 * time.Millisecond,
+		},
+		"fractional rate is rounded up": {
+			rate:          2.5,
+			expectedBurst: 3,
+			expectedWait:  400 * time.Millisecond,
+		},
+		"rate below one allows a single request": {
+			rate:          0.5,
+			expectedBurst: 1,
+			expectedWait:  2 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bucket := newTokenBucket(testCase.rate)
+			now := bucket.last
+
+			for i := 0; i < testCase.expectedBurst; i++ {
+				if wait := bucket.reserve(now); wait != 0 {
+					t.Fatalf("Expected request %d of the burst not to wait, got %s", i+1, wait)
+				}
+			}
+
+			if wait := bucket.reserve(now); wait != testCase.expectedWait {
+				t.Errorf("Expected a wait of %s once the burst is exhausted, got %s", testCase.expectedWait, wait)
+			}
+
+			// A token is refilled after the wait
+			now = now.Add(testCase.expectedWait)
+			if wait := bucket.reserve(now); wait != 0 {
+				t.Errorf("Expected a token to be available after %s, got a wait of %s", testCase.expectedWait, wait)
+			}
+			if wait := bucket.reserve(now); wait != testCase.expectedWait {
+				t.Errorf("Expected a wait of %s after the refilled token is used, got %s", testCase.expectedWait, wait)
+			}
+
+			// Tokens do not accumulate beyond the burst
+			now = now.Add(time.Hour)
+			for i := 0; i < testCase.expectedBurst; i++ {
+				if wait := bucket.reserve(now); wait != 0 {
+					t.Fatalf("Expected request %d of the burst not to wait, got %s", i+1, wait)
+				}
+			}
+			if wait := bucket.reserve(now); wait == 0 {
+				t.Errorf("Expected the bucket to be limited to a burst of %d", testCase.expectedBurst)
+			}
+		})
+	}
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	bucket := newTokenBucket(0)
+	now := time.Now()
+
+	for i := 0; i < 100; i++ {
+		if wait := bucket.reserve(now); wait != 0 {
+			t.Fatalf("Expected no rate limit to be applied, got a wait of %s", wait)
+		}
+	}
+}
+
+func TestTokenBucketPause(t *testing.T) {
+	bucket := newTokenBucket(0)
+	now := time.Now()
+
+	bucket.pauseUntil(now.Add(time.Second))
+	if wait := bucket.reserve(now); wait != time.Second {
+		t.Errorf("Expected a wait of 1s whilst paused, got %s", wait)
+	}
+
+	// An earlier pause does not shorten the existing pause
+	bucket.pauseUntil(now.Add(time.Millisecond))
+	if wait := bucket.reserve(now); wait != time.Second {
+		t.Errorf("Expected a wait of 1s whilst paused, got %s", wait)
+	}
+
+	if wait := bucket.reserve(now.Add(time.Second)); wait != 0 {
+		t.Errorf("Expected no wait once the pause has passed, got %s", wait)
+	}
+}
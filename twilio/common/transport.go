@@ -0,0 +1,149 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// BaseURLOverrideTransport sends every request to the BaseURL instead of the Twilio host the SDK resolved.
+// The original Twilio host is retained in the Host header so test doubles and proxies know which Twilio product the request was intended for
+type BaseURLOverrideTransport struct {
+	BaseURL   *url.URL
+	Transport http.RoundTripper
+}
+
+func NewBaseURLOverrideTransport(baseURL string, transport http.RoundTripper) (*BaseURLOverrideTransport, error) {
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse base url (%s): %s", baseURL, err.Error())
+	}
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return nil, fmt.Errorf("The base url (%s) must include a scheme and host", baseURL)
+	}
+
+	return &BaseURLOverrideTransport{
+		BaseURL:   parsedURL,
+		Transport: transport,
+	}, nil
+}
+
+func (t *BaseURLOverrideTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	overriddenReq := req.Clone(req.Context())
+	if overriddenReq.Host == "" {
+		overriddenReq.Host = req.URL.Host
+	}
+	overriddenReq.URL.Scheme = t.BaseURL.Scheme
+	overriddenReq.URL.Host = t.BaseURL.Host
+	overriddenReq.URL.Path = strings.TrimSuffix(t.BaseURL.Path, "/") + req.URL.Path
+
+	return t.transport().RoundTrip(overriddenReq)
+}
+
+func (t *BaseURLOverrideTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// RegionalHostTransport sends requests which the SDK addresses to a global Twilio host to the host of the configured region and/or edge.
+// The SDK does not apply the region or edge to some operations, i.e. serverless function and asset version uploads are always sent to `serverless-upload.twilio.com`, so data would otherwise leave the configured region
+type RegionalHostTransport struct {
+	Region    string
+	Edge      string
+	Transport http.RoundTripper
+}
+
+func NewRegionalHostTransport(region string, edge string, transport http.RoundTripper) *RegionalHostTransport {
+	return &RegionalHostTransport{
+		Region:    region,
+		Edge:      edge,
+		Transport: transport,
+	}
+}
+
+func (t *RegionalHostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	host := RegionalHost(req.URL.Host, t.Region, t.Edge)
+	if host == req.URL.Host {
+		return transport.RoundTrip(req)
+	}
+
+	regionalReq := req.Clone(req.Context())
+	regionalReq.URL.Host = host
+	if regionalReq.Host == req.URL.Host {
+		regionalReq.Host = host
+	}
+	return transport.RoundTrip(regionalReq)
+}
+
+// RegionalHost returns the host a request to a global Twilio host (i.e. `serverless-upload.twilio.com`) should be sent to, using the same format as the SDK i.e. `serverless-upload.dublin.ie1.twilio.com`.
+// The `us1` region is used when only an edge is set. Hosts which already include a region or edge and hosts which do not belong to Twilio are returned unchanged
+func RegionalHost(host string, region string, edge string) string {
+	if region == "" && edge == "" {
+		return host
+	}
+
+	subDomain := strings.TrimSuffix(host, ".twilio.com")
+	if subDomain == host || subDomain == "" || strings.Contains(subDomain, ".") {
+		return host
+	}
+
+	if edge != "" {
+		if region == "" {
+			region = "us1"
+		}
+		return fmt.Sprintf("%s.%s.%s.twilio.com", subDomain, edge, region)
+	}
+	return fmt.Sprintf("%s.%s.twilio.com", subDomain, region)
+}
+
+// UserAgentTransport prepends the provider & Terraform versions to the User-Agent header set by the SDK, so requests made by the provider can be identified by Twilio
+type UserAgentTransport struct {
+	UserAgent string
+	Transport http.RoundTripper
+}
+
+func NewUserAgentTransport(userAgent string, transport http.RoundTripper) *UserAgentTransport {
+	return &UserAgentTransport{
+		UserAgent: userAgent,
+		Transport: transport,
+	}
+}
+
+func (t *UserAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	userAgentReq := req.Clone(req.Context())
+
+	userAgent := t.UserAgent
+	if existingUserAgent := req.Header.Get("User-Agent"); existingUserAgent != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, existingUserAgent)
+	}
+	userAgentReq.Header.Set("User-Agent", userAgent)
+
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return transport.RoundTrip(userAgentReq)
+}
+
+// UserAgent builds the User-Agent for the provider i.e. `terraform-provider-twilio/0.18.0 terraform/1.1.0`.
+// Any value of the TF_APPEND_USER_AGENT environment variable is appended to the User-Agent
+func UserAgent(providerVersion string, terraformVersion string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+
+	userAgent := fmt.Sprintf("terraform-provider-twilio/%s terraform/%s", providerVersion, terraformVersion)
+	if appendUserAgent := strings.TrimSpace(os.Getenv("TF_APPEND_USER_AGENT")); appendUserAgent != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, appendUserAgent)
+	}
+	return userAgent
+}
@@ -0,0 +1,194 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// capturingTransport stores the last request which was sent, so the rewritten URL and Host header can be checked
+func capturingTransport(captured **http.Request) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		*captured = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Header:     http.Header{},
+		}, nil
+	})
+}
+
+func TestNewBaseURLOverrideTransport(t *testing.T) {
+	testCases := map[string]struct {
+		baseURL     string
+		expectError bool
+	}{
+		"http url":         {baseURL: "http://127.0.0.1:8080"},
+		"https url":        {baseURL: "https://proxy.example.com/twilio"},
+		"missing scheme":   {baseURL: "127.0.0.1:8080", expectError: true},
+		"missing host":     {baseURL: "http://", expectError: true},
+		"relative path":    {baseURL: "/twilio", expectError: true},
+		"invalid url":      {baseURL: "http://[::1", expectError: true},
+		"empty url string": {baseURL: "", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewBaseURLOverrideTransport(testCase.baseURL, nil)
+			if testCase.expectError && err == nil {
+				t.Error("Expected an error")
+			}
+			if !testCase.expectError && err != nil {
+				t.Errorf("Expected no error, got %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestBaseURLOverrideTransport(t *testing.T) {
+	testCases := map[string]struct {
+		baseURL      string
+		requestURL   string
+		expectedURL  string
+		expectedHost string
+	}{
+		"host is replaced": {
+			baseURL:      "http://127.0.0.1:8080",
+			requestURL:   "https://studio.twilio.com/v2/Flows?PageSize=50",
+			expectedURL:  "http://127.0.0.1:8080/v2/Flows?PageSize=50",
+			expectedHost: "studio.twilio.com",
+		},
+		"base path is prepended": {
+			baseURL:      "https://proxy.example.com/twilio/",
+			requestURL:   "https://api.twilio.com/2010-04-01/Accounts/AC00000000000000000000000000000000.json",
+			expectedURL:  "https://proxy.example.com/twilio/2010-04-01/Accounts/AC00000000000000000000000000000000.json",
+			expectedHost: "api.twilio.com",
+		},
+		"regional host is retained": {
+			baseURL:      "http://127.0.0.1:8080",
+			requestURL:   "https://serverless-upload.dublin.ie1.twilio.com/v1/Services",
+			expectedURL:  "http://127.0.0.1:8080/v1/Services",
+			expectedHost: "serverless-upload.dublin.ie1.twilio.com",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var captured *http.Request
+			transport, err := NewBaseURLOverrideTransport(testCase.baseURL, capturingTransport(&captured))
+			if err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, testCase.requestURL, nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			if captured.URL.String() != testCase.expectedURL {
+				t.Errorf("Expected URL %s, got %s", testCase.expectedURL, captured.URL.String())
+			}
+			if captured.Host != testCase.expectedHost {
+				t.Errorf("Expected Host header %s, got %s", testCase.expectedHost, captured.Host)
+			}
+			if req.URL.String() != testCase.requestURL {
+				t.Errorf("Expected the original request not to be modified, got %s", req.URL.String())
+			}
+		})
+	}
+}
+
+func TestRegionalHost(t *testing.T) {
+	testCases := map[string]struct {
+		host     string
+		region   string
+		edge     string
+		expected string
+	}{
+		"no region or edge":                  {host: "serverless-upload.twilio.com", expected: "serverless-upload.twilio.com"},
+		"region":                             {host: "serverless-upload.twilio.com", region: "ie1", expected: "serverless-upload.ie1.twilio.com"},
+		"edge defaults to the us1 region":    {host: "serverless-upload.twilio.com", edge: "ashburn", expected: "serverless-upload.ashburn.us1.twilio.com"},
+		"region and edge":                    {host: "serverless-upload.twilio.com", region: "au1", edge: "sydney", expected: "serverless-upload.sydney.au1.twilio.com"},
+		"region already applied":             {host: "serverless.ie1.twilio.com", region: "ie1", expected: "serverless.ie1.twilio.com"},
+		"region and edge already applied":    {host: "api.dublin.ie1.twilio.com", region: "ie1", edge: "dublin", expected: "api.dublin.ie1.twilio.com"},
+		"non twilio host":                    {host: "127.0.0.1:8080", region: "ie1", expected: "127.0.0.1:8080"},
+		"host with twilio.com as sub domain": {host: "twilio.com.example.com", region: "ie1", expected: "twilio.com.example.com"},
+		"twilio apex domain":                 {host: "twilio.com", region: "ie1", expected: "twilio.com"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if actual := RegionalHost(testCase.host, testCase.region, testCase.edge); actual != testCase.expected {
+				t.Errorf("Expected %s, got %s", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRegionalHostTransport(t *testing.T) {
+	testCases := map[string]struct {
+		region       string
+		edge         string
+		requestURL   string
+		expectedURL  string
+		expectedHost string
+	}{
+		"upload is sent to the region": {
+			region:      "ie1",
+			edge:        "dublin",
+			requestURL:  "https://serverless-upload.twilio.com/v1/Services/ZS00000000000000000000000000000000/Functions/ZH00000000000000000000000000000000/Versions",
+			expectedURL: "https://serverless-upload.dublin.ie1.twilio.com/v1/Services/ZS00000000000000000000000000000000/Functions/ZH00000000000000000000000000000000/Versions",
+		},
+		"regional request is unchanged": {
+			region:      "ie1",
+			edge:        "dublin",
+			requestURL:  "https://serverless.dublin.ie1.twilio.com/v1/Services",
+			expectedURL: "https://serverless.dublin.ie1.twilio.com/v1/Services",
+		},
+		"request without region is unchanged": {
+			requestURL:  "https://serverless-upload.twilio.com/v1/Services",
+			expectedURL: "https://serverless-upload.twilio.com/v1/Services",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var captured *http.Request
+			transport := NewRegionalHostTransport(testCase.region, testCase.edge, capturingTransport(&captured))
+
+			req, _ := http.NewRequest(http.MethodPost, testCase.requestURL, nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			if captured.URL.String() != testCase.expectedURL {
+				t.Errorf("Expected URL %s, got %s", testCase.expectedURL, captured.URL.String())
+			}
+			if captured.Host != captured.URL.Host {
+				t.Errorf("Expected Host header %s, got %s", captured.URL.Host, captured.Host)
+			}
+		})
+	}
+}
+
+func TestRegionalHostTransportWithBaseURLOverride(t *testing.T) {
+	var captured *http.Request
+	baseURLOverrideTransport, err := NewBaseURLOverrideTransport("http://127.0.0.1:8080", capturingTransport(&captured))
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	transport := NewRegionalHostTransport("au1", "sydney", baseURLOverrideTransport)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://serverless-upload.twilio.com/v1/Services", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if captured.URL.String() != "http://127.0.0.1:8080/v1/Services" {
+		t.Errorf("Expected the request to be sent to the base URL, got %s", captured.URL.String())
+	}
+	if captured.Host != "serverless-upload.sydney.au1.twilio.com" {
+		t.Errorf("Expected the regional host to be retained in the Host header, got %s", captured.Host)
+	}
+}
@@ -1,6 +1,11 @@
 package common
 
 import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/timworks/twilio-sdk-go/client"
 	accounts "github.com/timworks/twilio-sdk-go/service/accounts/v1"
 	api "github.com/timworks/twilio-sdk-go/service/api/v2010"
 	autopilot "github.com/timworks/twilio-sdk-go/service/autopilot/v1"
@@ -17,8 +22,10 @@ import (
 )
 
 type TwilioClient struct {
-	AccountSid       string
-	TerraformVersion string
+	AccountSid         string
+	SubaccountSid      string
+	TerraformVersion   string
+	DeletionProtection bool
 
 	Accounts      *accounts.Accounts
 	API           *api.V2010
@@ -34,3 +41,58 @@ type TwilioClient struct {
 	TaskRouter    *taskrouter.TaskRouter
 	Video         *video.Video
 }
+
+// DefaultAccountSid returns the account which API v2010 requests should be made against when an account sid has not been specified.
+// When a subaccount sid is configured on the provider, the parent account credentials are used to act on the subaccount
+func (c *TwilioClient) DefaultAccountSid() string {
+	if c.SubaccountSid != "" {
+		return c.SubaccountSid
+	}
+	return c.AccountSid
+}
+
+// ResolveAccountSid returns the account sid configured on the resource or data source, falling back to the default account sid of the provider
+func (c *TwilioClient) ResolveAccountSid(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("account_sid"); ok && v.(string) != "" {
+		return v.(string)
+	}
+	return c.DefaultAccountSid()
+}
+
+// CheckDeletionProtection returns an error when deletion protection is enabled on the provider, so resources which cannot be recovered once deleted are not deleted by mistake
+func (c *TwilioClient) CheckDeletionProtection(resourceDescription string, id string) error {
+	if c.DeletionProtection {
+		return fmt.Errorf("Deletion protection is enabled on the provider, so the %s (%s) cannot be deleted. Set `deletion_protection` to false on the provider to allow the %s to be deleted", resourceDescription, id, resourceDescription)
+	}
+	return nil
+}
+
+// SetTransport replaces the HTTP transport used by every SDK client
+func (c *TwilioClient) SetTransport(transport http.RoundTripper) {
+	for _, sdkClient := range c.sdkClients() {
+		sdkClient.GetRestyClient().SetTransport(transport)
+	}
+}
+
+// Transport returns the HTTP transport used by the SDK clients
+func (c *TwilioClient) Transport() http.RoundTripper {
+	return c.API.GetClient().GetRestyClient().GetClient().Transport
+}
+
+func (c *TwilioClient) sdkClients() []*client.Client {
+	return []*client.Client{
+		c.Accounts.GetClient(),
+		c.API.GetClient(),
+		c.Autopilot.GetClient(),
+		c.Chat.GetClient(),
+		c.Conversations.GetClient(),
+		c.Flex.GetClient(),
+		c.Messaging.GetClient(),
+		c.Proxy.GetClient(),
+		c.Serverless.GetClient(),
+		c.SIPTrunking.GetClient(),
+		c.Studio.GetClient(),
+		c.TaskRouter.GetClient(),
+		c.Video.GetClient(),
+	}
+}
@@ -1,6 +1,11 @@
 package twilio
 
 import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
 	"github.com/timworks/twilio-sdk-go/client"
@@ -23,16 +28,29 @@ import (
 )
 
 type Config struct {
-	AccountSid       string
-	AuthToken        string
-	APIKey           string
-	APISecret        string
-	RetryAttempts    int
-	BackoffInterval  int
-	terraformVersion string
+	AccountSid            string
+	SubaccountSid         string
+	AuthToken             string
+	APIKey                string
+	APISecret             string
+	RetryAttempts         int
+	BackoffInterval       int
+	MaxRequestsPerSecond  float64
+	MaxConcurrentRequests int
+	Region                string
+	Edge                  string
+	APIBaseURLOverride    string
+	Profile               string
+	SharedConfigFile      string
+	DeletionProtection    bool
+	terraformVersion      string
+	providerVersion       string
 }
 
 func (config *Config) Client() (interface{}, diag.Diagnostics) {
+	if err := config.loadSharedConfig(); err != nil {
+		return nil, diag.FromErr(err)
+	}
 
 	creds, err := credentials.New(getCredentials(config))
 	if err != nil {
@@ -40,14 +58,19 @@ func (config *Config) Client() (interface{}, diag.Diagnostics) {
 	}
 
 	sess := session.New(creds)
+	// Retries are handled by the rate limit transport, so the SDK retries are disabled to prevent requests being retried by both
 	sdkConfig := &client.Config{
-		RetryAttempts:   utils.Int(config.RetryAttempts),
+		RetryAttempts:   utils.Int(0),
 		BackoffInterval: utils.Int(config.BackoffInterval),
+		Region:          optionalString(config.Region),
+		Edge:            optionalString(config.Edge),
 	}
 
 	client := &common.TwilioClient{
-		AccountSid:       config.AccountSid,
-		TerraformVersion: config.terraformVersion,
+		AccountSid:         config.AccountSid,
+		SubaccountSid:      config.SubaccountSid,
+		TerraformVersion:   config.terraformVersion,
+		DeletionProtection: config.DeletionProtection,
 
 		Accounts:      accounts.New(sess, sdkConfig),
 		API:           api.New(sess, sdkConfig),
@@ -63,9 +86,76 @@ func (config *Config) Client() (interface{}, diag.Diagnostics) {
 		TaskRouter:    taskrouter.New(sess, sdkConfig),
 		Video:         video.New(sess, sdkConfig),
 	}
+
+	var transport http.RoundTripper = common.NewLoggingTransport(http.DefaultTransport)
+	if config.APIBaseURLOverride != "" {
+		baseURLOverrideTransport, err := common.NewBaseURLOverrideTransport(config.APIBaseURLOverride, transport)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		transport = baseURLOverrideTransport
+	}
+	if config.Region != "" || config.Edge != "" {
+		transport = common.NewRegionalHostTransport(config.Region, config.Edge, transport)
+	}
+
+	transport = common.NewRateLimitTransport(common.RateLimitOptions{
+		RequestsPerSecond:     config.MaxRequestsPerSecond,
+		MaxConcurrentRequests: config.MaxConcurrentRequests,
+		RetryAttempts:         config.RetryAttempts,
+		BackoffInterval:       time.Duration(config.BackoffInterval) * time.Millisecond,
+	}, transport)
+	transport = common.NewUserAgentTransport(common.UserAgent(config.providerVersion, client.TerraformVersion), transport)
+
+	client.SetTransport(transport)
+
 	return client, nil
 }
 
+// loadSharedConfig populates the credentials from the shared config file or twilio-cli profile.
+// An explicitly requested profile takes precedence over the credentials set on the provider or via environment variables, otherwise the profile is only used when no credentials have been set.
+// The account SID and secret are always taken from the same source, so credentials from different sources are never combined
+func (config *Config) loadSharedConfig() error {
+	hasStaticCredentials := config.AccountSid != "" || config.AuthToken != "" || config.APIKey != "" || config.APISecret != ""
+	if hasStaticCredentials && config.Profile == "" {
+		if !hasCompleteCredentials(config.AccountSid, config.AuthToken, config.APIKey, config.APISecret) {
+			return fmt.Errorf("The credentials set on the provider or via environment variables are incomplete. The account SID and either the auth token or the API key & secret are required, credentials are not combined with the credentials in a shared config file or twilio-cli profile")
+		}
+		return nil
+	}
+
+	profile, err := loadSharedConfigProfile(config.Profile, config.SharedConfigFile)
+	if err != nil {
+		return err
+	}
+	if profile == nil {
+		return nil
+	}
+
+	if !hasCompleteCredentials(profile.AccountSid, profile.AuthToken, profile.APIKey, profile.APISecret) {
+		return fmt.Errorf("The credentials in the profile (%s) are incomplete. The account SID and either the auth token or the API key & secret are required", profile.Name)
+	}
+	if hasStaticCredentials {
+		log.Printf("[WARN] The credentials set on the provider or via environment variables have been ignored as the profile (%s) has been set", profile.Name)
+	}
+
+	config.AccountSid = profile.AccountSid
+	config.AuthToken = profile.AuthToken
+	config.APIKey = profile.APIKey
+	config.APISecret = profile.APISecret
+	if config.Region == "" {
+		config.Region = profile.Region
+	}
+	if config.Edge == "" {
+		config.Edge = profile.Edge
+	}
+	return nil
+}
+
+func hasCompleteCredentials(accountSid string, authToken string, apiKey string, apiSecret string) bool {
+	return accountSid != "" && (authToken != "" || (apiKey != "" && apiSecret != ""))
+}
+
 func getCredentials(config *Config) credentials.TwilioCredentials {
 	if config.APIKey != "" && config.APISecret != "" {
 		return credentials.APIKey{
@@ -79,3 +169,10 @@ func getCredentials(config *Config) credentials.TwilioCredentials {
 		AuthToken: config.AuthToken,
 	}
 }
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return utils.String(value)
+}
@@ -0,0 +1,194 @@
+package functions_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/timworks/terraform-provider-twilio/twilio"
+)
+
+// callFunction calls the provider function via the mux server in the same way as Terraform. Dynamic arguments must be supplied with the dynamic argument positions
+func callFunction(t *testing.T, name string, dynamicArguments map[int]bool, arguments ...tftypes.Value) (tftypes.Value, *tfprotov6.FunctionError) {
+	ctx := context.Background()
+
+	providerServer, err := twilio.ProviderServer(ctx, "test")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	server := providerServer()
+
+	// Terraform retrieves the functions from the provider schema, which also completes the server discovery of the mux server before any function is called
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	definition, ok := schemaResp.Functions[name]
+	if !ok {
+		t.Fatalf("The %s function is not registered", name)
+	}
+
+	dynamicValues := make([]*tfprotov6.DynamicValue, 0)
+	for index, argument := range arguments {
+		argumentType := argument.Type()
+		if dynamicArguments[index] {
+			argumentType = tftypes.DynamicPseudoType
+		}
+
+		dynamicValue, err := tfprotov6.NewDynamicValue(argumentType, argument)
+		if err != nil {
+			t.Fatalf("err: %s", err.Error())
+		}
+		dynamicValues = append(dynamicValues, &dynamicValue)
+	}
+
+	resp, err := server.CallFunction(ctx, &tfprotov6.CallFunctionRequest{
+		Name:      name,
+		Arguments: dynamicValues,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+
+	result, err := resp.Result.Unmarshal(definition.Return.Type)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	return result, nil
+}
+
+func TestParseSID(t *testing.T) {
+	testCases := map[string]struct {
+		sid          string
+		expectedType interface{}
+	}{
+		"serverless service": {
+			sid:          "ZSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			expectedType: "serverless service",
+		},
+		"shared prefix": {
+			sid:          "CHaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			expectedType: "chat channel or conversation",
+		},
+		"unknown prefix": {
+			sid:          "QQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			expectedType: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := callFunction(t, "parse_sid", nil, tftypes.NewValue(tftypes.String, testCase.sid))
+			if funcErr != nil {
+				t.Fatalf("Unexpected error: %s", funcErr.Text)
+			}
+
+			var attributes map[string]tftypes.Value
+			if err := result.As(&attributes); err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+
+			var prefix string
+			attributes["prefix"].As(&prefix)
+			if prefix != testCase.sid[:2] {
+				t.Errorf("Expected prefix %s but got %s", testCase.sid[:2], prefix)
+			}
+
+			var sidType *string
+			attributes["type"].As(&sidType)
+			if testCase.expectedType == nil && sidType != nil {
+				t.Errorf("Expected type to be null but got %s", *sidType)
+			}
+			if testCase.expectedType != nil && (sidType == nil || *sidType != testCase.expectedType.(string)) {
+				t.Errorf("Expected type %s but got %v", testCase.expectedType, sidType)
+			}
+		})
+	}
+}
+
+func TestParseSID_invalid(t *testing.T) {
+	_, funcErr := callFunction(t, "parse_sid", nil, tftypes.NewValue(tftypes.String, "invalid"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "is not a valid Twilio SID") {
+		t.Fatalf("Expected invalid SID error but got %v", funcErr)
+	}
+}
+
+func TestNormalizeE164(t *testing.T) {
+	testCases := map[string]string{
+		"+447700900000":       "+447700900000",
+		"+44 7700 900000":     "+447700900000",
+		"0044 (7700) 900-000": "+447700900000",
+		"+1 415.555.0100":     "+14155550100",
+	}
+
+	for phoneNumber, expected := range testCases {
+		t.Run(phoneNumber, func(t *testing.T) {
+			result, funcErr := callFunction(t, "normalize_e164", nil, tftypes.NewValue(tftypes.String, phoneNumber))
+			if funcErr != nil {
+				t.Fatalf("Unexpected error: %s", funcErr.Text)
+			}
+
+			var normalizedPhoneNumber string
+			result.As(&normalizedPhoneNumber)
+			if normalizedPhoneNumber != expected {
+				t.Errorf("Expected %s but got %s", expected, normalizedPhoneNumber)
+			}
+		})
+	}
+}
+
+func TestNormalizeE164_invalid(t *testing.T) {
+	for _, phoneNumber := range []string{"07700 900000", "+0123", "+1234567890123456", "not a number"} {
+		t.Run(phoneNumber, func(t *testing.T) {
+			_, funcErr := callFunction(t, "normalize_e164", nil, tftypes.NewValue(tftypes.String, phoneNumber))
+			if funcErr == nil || !strings.Contains(funcErr.Text, "is not a valid E.164 phone number") {
+				t.Fatalf("Expected invalid phone number error but got %v", funcErr)
+			}
+		})
+	}
+}
+
+func TestStudioState(t *testing.T) {
+	propertiesType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"body":   tftypes.String,
+		"offset": tftypes.Object{AttributeTypes: map[string]tftypes.Type{"x": tftypes.Number, "y": tftypes.Number}},
+	}}
+	properties := tftypes.NewValue(propertiesType, map[string]tftypes.Value{
+		"body": tftypes.NewValue(tftypes.String, "Hello World"),
+		"offset": tftypes.NewValue(propertiesType.AttributeTypes["offset"], map[string]tftypes.Value{
+			"x": tftypes.NewValue(tftypes.Number, 0),
+			"y": tftypes.NewValue(tftypes.Number, 200),
+		}),
+	})
+
+	transitionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"event": tftypes.String, "next": tftypes.String}}
+	transitions := tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{transitionType}}, []tftypes.Value{
+		tftypes.NewValue(transitionType, map[string]tftypes.Value{
+			"event": tftypes.NewValue(tftypes.String, "sent"),
+			"next":  tftypes.NewValue(tftypes.String, "End"),
+		}),
+	})
+
+	result, funcErr := callFunction(t, "studio_state", map[int]bool{2: true, 3: true},
+		tftypes.NewValue(tftypes.String, "SendMessage"),
+		tftypes.NewValue(tftypes.String, "send-message"),
+		properties,
+		transitions,
+	)
+	if funcErr != nil {
+		t.Fatalf("Unexpected error: %s", funcErr.Text)
+	}
+
+	var stateJSON string
+	result.As(&stateJSON)
+	for _, expected := range []string{`"name":"SendMessage"`, `"type":"send-message"`, `"body":"Hello World"`, `"y":200`, `"event":"sent"`, `"next":"End"`} {
+		if !strings.Contains(stateJSON, expected) {
+			t.Errorf("Expected %s to contain %s", stateJSON, expected)
+		}
+	}
+}
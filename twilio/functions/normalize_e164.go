@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+var _ function.Function = &normalizeE164Function{}
+
+// phoneNumberFormattingReplacer removes the characters which are commonly used to format phone numbers for display
+var phoneNumberFormattingReplacer = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "\t", "")
+
+type normalizeE164Function struct{}
+
+// NewNormalizeE164Function creates the `normalize_e164` function, which converts a formatted phone number into the E.164 format
+func NewNormalizeE164Function() function.Function {
+	return &normalizeE164Function{}
+}
+
+func (f *normalizeE164Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_e164"
+}
+
+func (f *normalizeE164Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a phone number to the E.164 format",
+		Description: "Removes spaces, dashes, dots and parentheses from a phone number and replaces a leading 00 international dialling prefix with +. An error is returned when the result is not a valid E.164 phone number, using the same rules as the phone number arguments of the provider.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "phone_number",
+				Description: "The phone number to normalize, which must include the country code i.e. +44 7700 900000 or 0044 7700 900000",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeE164Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var phoneNumber string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &phoneNumber))
+	if resp.Error != nil {
+		return
+	}
+
+	normalizedPhoneNumber := phoneNumberFormattingReplacer.Replace(strings.TrimSpace(phoneNumber))
+	if strings.HasPrefix(normalizedPhoneNumber, "00") {
+		normalizedPhoneNumber = "+" + strings.TrimPrefix(normalizedPhoneNumber, "00")
+	}
+
+	if !utils.PhoneNumberRegex.MatchString(normalizedPhoneNumber) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The phone number (%s) is not a valid E.164 phone number, the phone number must start with + or 00 followed by the country code and be at most 15 digits", phoneNumber))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalizedPhoneNumber))
+}
@@ -0,0 +1,141 @@
+package functions
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseSIDFunction{}
+
+var sidRegex = regexp.MustCompile("^([A-Z]{2})[0-9a-fA-F]{32}$")
+
+// sidTypes maps the two letter prefix of a SID to the types of resources which use the prefix. Some prefixes are shared by multiple Twilio products i.e. chat and conversations services
+var sidTypes = map[string][]string{
+	"AC": {"account"},
+	"AD": {"address"},
+	"AI": {"messaging alpha sender"},
+	"AL": {"sip ip access control list"},
+	"AP": {"application"},
+	"BU": {"bundle"},
+	"BY": {"byoc trunk"},
+	"CH": {"chat channel", "conversation"},
+	"CL": {"sip credential list"},
+	"CR": {"credential", "sip credential"},
+	"FJ": {"flex plugin configuration"},
+	"FK": {"flex plugin release"},
+	"FO": {"flex flow"},
+	"FP": {"flex plugin"},
+	"FV": {"flex plugin version"},
+	"FW": {"studio flow"},
+	"HK": {"video composition hook"},
+	"IP": {"sip ip address"},
+	"IS": {"chat service", "conversations service"},
+	"KS": {"proxy service"},
+	"MB": {"chat channel member"},
+	"MG": {"messaging service"},
+	"OU": {"sip trunking origination url"},
+	"PN": {"phone number"},
+	"QU": {"queue"},
+	"RI": {"identity"},
+	"RL": {"chat role", "conversations role"},
+	"SC": {"short code"},
+	"SD": {"sip domain"},
+	"SK": {"api key"},
+	"TC": {"taskrouter task channel"},
+	"TK": {"sip trunk"},
+	"UA": {"autopilot assistant"},
+	"UB": {"autopilot field type"},
+	"UC": {"autopilot field value"},
+	"UD": {"autopilot task"},
+	"UE": {"autopilot task field"},
+	"UF": {"autopilot task sample"},
+	"UG": {"autopilot model build"},
+	"UM": {"autopilot webhook"},
+	"US": {"chat user", "conversations user"},
+	"WA": {"taskrouter activity"},
+	"WH": {"chat channel webhook", "conversations webhook"},
+	"WK": {"taskrouter worker"},
+	"WQ": {"taskrouter task queue"},
+	"WS": {"taskrouter workspace"},
+	"WW": {"taskrouter workflow"},
+	"ZB": {"serverless build"},
+	"ZD": {"serverless deployment"},
+	"ZE": {"serverless environment"},
+	"ZH": {"serverless asset", "serverless function"},
+	"ZN": {"serverless asset version", "serverless function version"},
+	"ZS": {"serverless service"},
+	"ZV": {"serverless variable"},
+}
+
+type parseSIDFunction struct{}
+
+// NewParseSIDFunction creates the `parse_sid` function, which returns the prefix and resource type(s) of a Twilio SID
+func NewParseSIDFunction() function.Function {
+	return &parseSIDFunction{}
+}
+
+func (f *parseSIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_sid"
+}
+
+func (f *parseSIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Twilio SID",
+		Description: "Returns the two letter prefix of a Twilio SID and the types of resources which use the prefix. The type is null when the prefix is not known to the provider.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "sid",
+				Description: "The Twilio SID to parse i.e. ZSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"sid":    types.StringType,
+				"prefix": types.StringType,
+				"type":   types.StringType,
+				"types":  types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+type parsedSID struct {
+	Sid    string       `tfsdk:"sid"`
+	Prefix string       `tfsdk:"prefix"`
+	Type   types.String `tfsdk:"type"`
+	Types  []string     `tfsdk:"types"`
+}
+
+func (f *parseSIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sid string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &sid))
+	if resp.Error != nil {
+		return
+	}
+
+	match := sidRegex.FindStringSubmatch(sid)
+	if len(match) != 2 {
+		resp.Error = function.NewArgumentFuncError(0, "The SID ("+sid+") is not a valid Twilio SID, a SID must be a two letter prefix followed by 32 hexadecimal characters")
+		return
+	}
+
+	result := parsedSID{
+		Sid:    sid,
+		Prefix: match[1],
+		Type:   types.StringNull(),
+		Types:  make([]string, 0),
+	}
+	if sidType, ok := sidTypes[match[1]]; ok {
+		result.Types = append(result.Types, sidType...)
+		sort.Strings(result.Types)
+		result.Type = types.StringValue(strings.Join(result.Types, " or "))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,116 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerSchema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ provider.ProviderWithFunctions = &functionsProvider{}
+
+// functionsProvider is a plugin framework provider which only contains the provider functions. All resources and data sources are provided by the SDKv2 provider, the two providers are combined using a mux server
+type functionsProvider struct {
+	version     string
+	sdkProvider *schema.Provider
+}
+
+// New creates the provider which contains the provider functions. The SDKv2 provider is required as both providers must have an identical provider schema
+func New(version string, sdkProvider *schema.Provider) provider.Provider {
+	return &functionsProvider{
+		version:     version,
+		sdkProvider: sdkProvider,
+	}
+}
+
+func (p *functionsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "twilio"
+	resp.Version = p.version
+}
+
+// Schema mirrors the schema of the SDKv2 provider, the schema is generated from the SDKv2 schema so the two schemas cannot drift apart
+func (p *functionsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	coreSchema := schema.InternalMap(p.sdkProvider.Schema).CoreConfigSchema()
+	if len(coreSchema.BlockTypes) > 0 {
+		resp.Diagnostics.AddError("Unsupported provider schema", "Nested blocks in the provider schema cannot be mirrored into the provider functions schema")
+		return
+	}
+
+	attributes := make(map[string]providerSchema.Attribute)
+	for name, attribute := range coreSchema.Attributes {
+		deprecationMessage := ""
+		if attribute.Deprecated {
+			deprecationMessage = fmt.Sprintf("The %s argument is deprecated", name)
+		}
+
+		switch {
+		case attribute.Type == cty.String:
+			attributes[name] = providerSchema.StringAttribute{
+				Description:        attribute.Description,
+				Required:           attribute.Required,
+				Optional:           attribute.Optional,
+				Sensitive:          attribute.Sensitive,
+				DeprecationMessage: deprecationMessage,
+			}
+		case attribute.Type == cty.Bool:
+			attributes[name] = providerSchema.BoolAttribute{
+				Description:        attribute.Description,
+				Required:           attribute.Required,
+				Optional:           attribute.Optional,
+				Sensitive:          attribute.Sensitive,
+				DeprecationMessage: deprecationMessage,
+			}
+		case attribute.Type == cty.Number:
+			attributes[name] = providerSchema.NumberAttribute{
+				Description:        attribute.Description,
+				Required:           attribute.Required,
+				Optional:           attribute.Optional,
+				Sensitive:          attribute.Sensitive,
+				DeprecationMessage: deprecationMessage,
+			}
+		case attribute.Type.IsListType() && attribute.Type.ElementType() == cty.String:
+			attributes[name] = providerSchema.ListAttribute{
+				ElementType:        types.StringType,
+				Description:        attribute.Description,
+				Required:           attribute.Required,
+				Optional:           attribute.Optional,
+				Sensitive:          attribute.Sensitive,
+				DeprecationMessage: deprecationMessage,
+			}
+		default:
+			resp.Diagnostics.AddError("Unsupported provider schema", fmt.Sprintf("The type of the %s argument cannot be mirrored into the provider functions schema", name))
+			return
+		}
+	}
+
+	resp.Schema = providerSchema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Configure is a no-op as the provider functions do not call Twilio
+func (p *functionsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+}
+
+func (p *functionsProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *functionsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *functionsProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeE164Function,
+		NewParseSIDFunction,
+		NewStudioStateFunction,
+	}
+}
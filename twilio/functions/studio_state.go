@@ -0,0 +1,186 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/timworks/twilio-sdk-go/studio/flow"
+)
+
+var _ function.Function = &studioStateFunction{}
+
+type studioStateFunction struct{}
+
+// NewStudioStateFunction creates the `studio_state` function, which builds the JSON of a studio flow state (widget) in the same way as the `twilio_studio_flow_widget_state` data source
+func NewStudioStateFunction() function.Function {
+	return &studioStateFunction{}
+}
+
+func (f *studioStateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "studio_state"
+}
+
+func (f *studioStateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a studio flow state",
+		Description: "Returns the JSON of a studio flow state (widget), which can be used in the states of a studio flow definition. The state is validated in the same way as the `twilio_studio_flow_widget_state` data source.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the widget",
+			},
+			function.StringParameter{
+				Name:        "type",
+				Description: "The type of the widget i.e. send-message",
+			},
+			function.DynamicParameter{
+				Name:        "properties",
+				Description: "An object containing the properties of the widget",
+			},
+			function.DynamicParameter{
+				Name:        "transitions",
+				Description: "A list of transition objects, each transition supports the `event`, `next` and `conditions` attributes",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *studioStateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, stateType string
+	var propertiesArgument, transitionsArgument types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &stateType, &propertiesArgument, &transitionsArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	properties, err := dynamicToJSONValue(ctx, propertiesArgument)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Failed to read the properties: %s", err.Error()))
+		return
+	}
+	propertiesMap, ok := properties.(map[string]interface{})
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(2, "The properties must be an object")
+		return
+	}
+
+	transitionsValue, err := dynamicToJSONValue(ctx, transitionsArgument)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("Failed to read the transitions: %s", err.Error()))
+		return
+	}
+
+	// The transitions are converted via JSON so the attribute names match the studio flow definition format
+	transitions := []flow.Transition{}
+	if transitionsValue != nil {
+		transitionsJSON, _ := json.Marshal(transitionsValue)
+		if err := json.Unmarshal(transitionsJSON, &transitions); err != nil {
+			resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("The transitions must be a list of transition objects: %s", err.Error()))
+			return
+		}
+	}
+
+	state := flow.State{
+		Name:        name,
+		Properties:  propertiesMap,
+		Transitions: transitions,
+		Type:        stateType,
+	}
+
+	if err := state.Validate(); err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("State failed validation: %s", err.Error()))
+		return
+	}
+
+	stateJSON, err := state.ToString()
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Failed to marshal state to JSON: %s", err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, stateJSON))
+}
+
+func dynamicToJSONValue(ctx context.Context, value types.Dynamic) (interface{}, error) {
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+
+	terraformValue, err := value.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toJSONValue(terraformValue)
+}
+
+// toJSONValue converts a Terraform value into the equivalent value which can be marshalled to JSON, in the same way as jsonencode
+func toJSONValue(value tftypes.Value) (interface{}, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("The value is not known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var stringValue string
+		err := value.As(&stringValue)
+		return stringValue, err
+	case valueType.Is(tftypes.Bool):
+		var boolValue bool
+		err := value.As(&boolValue)
+		return boolValue, err
+	case valueType.Is(tftypes.Number):
+		numberValue := new(big.Float)
+		if err := value.As(&numberValue); err != nil {
+			return nil, err
+		}
+		if numberValue.IsInt() {
+			if intValue, accuracy := numberValue.Int64(); accuracy == big.Exact {
+				return intValue, nil
+			}
+		}
+		floatValue, _ := numberValue.Float64()
+		return floatValue, nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var items []tftypes.Value
+		if err := value.As(&items); err != nil {
+			return nil, err
+		}
+
+		jsonItems := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			jsonItem, err := toJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			jsonItems = append(jsonItems, jsonItem)
+		}
+		return jsonItems, nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var items map[string]tftypes.Value
+		if err := value.As(&items); err != nil {
+			return nil, err
+		}
+
+		jsonItems := make(map[string]interface{}, len(items))
+		for key, item := range items {
+			jsonItem, err := toJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			jsonItems[key] = jsonItem
+		}
+		return jsonItems, nil
+	default:
+		return nil, fmt.Errorf("The type (%s) is not supported", valueType.String())
+	}
+}
@@ -0,0 +1,22 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateChat(g *generator) error {
+	client := g.client.Chat
+
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list chat services: %s", err.Error())
+	}
+
+	for _, service := range paginator.Services {
+		serviceResource := g.add("twilio_chat_service", fmt.Sprintf("/Services/%s", service.Sid), service.FriendlyName, service.Sid)
+		serviceResource.Set("friendly_name", service.FriendlyName)
+	}
+	return nil
+}
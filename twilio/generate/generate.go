@@ -0,0 +1,199 @@
+package generate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/timworks/terraform-provider-twilio/twilio"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+// Command is the name of the subcommand which runs the generator
+const Command = "generate"
+
+type serviceGenerator func(g *generator) error
+
+// generators contains the services which configuration can be generated for
+var generators = map[string]serviceGenerator{
+	"chat":          generateChat,
+	"conversations": generateConversations,
+	"messaging":     generateMessaging,
+	"proxy":         generateProxy,
+	"serverless":    generateServerless,
+	"sip":           generateSIP,
+	"sip_trunking":  generateSIPTrunking,
+	"studio":        generateStudio,
+	"taskrouter":    generateTaskRouter,
+	"twiml":         generateTwiML,
+	"voice":         generateVoice,
+}
+
+// generator discovers the resources for a single service and collects the files which need to be written
+type generator struct {
+	ctx       context.Context
+	client    *common.TwilioClient
+	names     *utils.HCLNames
+	resources []*Resource
+	warnings  []string
+	files     map[string][]byte
+}
+
+func (g *generator) add(resourceType string, importID string, nameCandidates ...interface{}) *Resource {
+	candidates := make([]string, 0, len(nameCandidates))
+	for _, candidate := range nameCandidates {
+		candidates = append(candidates, stringValue(candidate))
+	}
+
+	resource := &Resource{
+		Type:     resourceType,
+		Name:     g.names.Next(resourceType, candidates...),
+		ImportID: importID,
+	}
+	g.resources = append(g.resources, resource)
+	return resource
+}
+
+// warn records a resource which could not be generated, the warnings are written as comments at the top of the generated configuration
+func (g *generator) warn(format string, args ...interface{}) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, args...))
+}
+
+// addFile stores content (i.e. serverless function code) which is referenced by the generated configuration
+func (g *generator) addFile(path string, content []byte) {
+	g.files[path] = content
+}
+
+// Run generates Terraform configuration and import blocks for the resources which already exist in the Twilio account.
+// The provider is configured using the same environment variables and shared configuration as Terraform
+func Run(args []string, version string, output io.Writer) error {
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		fmt.Fprintf(output, "Usage: terraform-provider-twilio %s [options]\n\n", Command)
+		fmt.Fprintf(output, "Generates Terraform configuration and import blocks for the resources in an existing Twilio account.\n")
+		fmt.Fprintf(output, "Supported services: %s\n\nOptions:\n", strings.Join(sortedKeys(generators), ", "))
+		flags.PrintDefaults()
+	}
+
+	services := flags.String("services", strings.Join(sortedKeys(generators), ","), "Comma separated list of services to generate configuration for")
+	outputDir := flags.String("output-dir", "generated", "Directory to write the generated configuration to")
+	profile := flags.String("profile", "", "Name of the profile to load credentials from, see the provider documentation for more information")
+
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	serviceNames, err := parseServices(*services)
+	if err != nil {
+		return err
+	}
+
+	client, err := newClient(version, *profile)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*outputDir, 0755); err != nil {
+		return fmt.Errorf("Failed to create output directory (%s): %s", *outputDir, err.Error())
+	}
+
+	ctx := context.Background()
+	for _, serviceName := range serviceNames {
+		g := &generator{
+			ctx:       ctx,
+			client:    client,
+			names:     utils.NewHCLNames(),
+			resources: make([]*Resource, 0),
+			warnings:  make([]string, 0),
+			files:     make(map[string][]byte),
+		}
+
+		if err := generators[serviceName](g); err != nil {
+			return fmt.Errorf("Failed to generate %s configuration: %s", serviceName, err.Error())
+		}
+
+		for _, warning := range g.warnings {
+			fmt.Fprintf(output, "Warning: %s\n", warning)
+		}
+
+		if len(g.resources) == 0 {
+			fmt.Fprintf(output, "No %s resources were found\n", serviceName)
+			continue
+		}
+
+		g.addFile(serviceName+".tf", render(g.resources, g.warnings))
+		if err := writeFiles(*outputDir, g.files); err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "Generated %d %s resource(s) in %s\n", len(g.resources), serviceName, filepath.Join(*outputDir, serviceName+".tf"))
+	}
+	return nil
+}
+
+func parseServices(value string) ([]string, error) {
+	serviceNames := make([]string, 0)
+	for _, serviceName := range strings.Split(value, ",") {
+		serviceName = strings.TrimSpace(serviceName)
+		if serviceName == "" {
+			continue
+		}
+		if _, ok := generators[serviceName]; !ok {
+			return nil, fmt.Errorf("The service (%s) is not supported, supported services are %s", serviceName, strings.Join(sortedKeys(generators), ", "))
+		}
+		serviceNames = append(serviceNames, serviceName)
+	}
+
+	if len(serviceNames) == 0 {
+		return nil, fmt.Errorf("At least one service must be specified")
+	}
+	return serviceNames, nil
+}
+
+func newClient(version string, profile string) (*common.TwilioClient, error) {
+	config := map[string]interface{}{}
+	if profile != "" {
+		config["profile"] = profile
+	}
+
+	provider := twilio.New(version)()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		messages := make([]string, 0)
+		for _, diagnostic := range diags {
+			messages = append(messages, diagnostic.Summary)
+		}
+		return nil, fmt.Errorf("Failed to configure the Twilio client: %s", strings.Join(messages, ", "))
+	}
+
+	return provider.Meta().(*common.TwilioClient), nil
+}
+
+// writeFiles writes the generated files. Existing files are never overwritten so hand written configuration cannot be lost
+func writeFiles(outputDir string, files map[string][]byte) error {
+	for path := range files {
+		filePath := filepath.Join(outputDir, path)
+		if _, err := os.Stat(filePath); err == nil {
+			return fmt.Errorf("The file (%s) already exists, please remove it or use a different output directory", filePath)
+		}
+	}
+
+	for path, content := range files {
+		filePath := filepath.Join(outputDir, path)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return fmt.Errorf("Failed to create directory (%s): %s", filepath.Dir(filePath), err.Error())
+		}
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			return fmt.Errorf("Failed to write file (%s): %s", filePath, err.Error())
+		}
+	}
+	return nil
+}
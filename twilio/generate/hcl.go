@@ -0,0 +1,122 @@
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+// Resource is a Terraform resource which has been discovered in the Twilio account, along with the ID which is accepted by the importer of the resource
+type Resource struct {
+	Type       string
+	Name       string
+	ImportID   string
+	Attributes []Attribute
+}
+
+// Attribute is an argument of a generated resource. The value is the rendered HCL expression
+type Attribute struct {
+	Name  string
+	Value string
+}
+
+// Set adds the argument to the resource when the value is not nil. Strings, booleans and numbers (including pointers) are supported
+func (r *Resource) Set(name string, value interface{}) {
+	if expression, ok := utils.HCLLiteral(value); ok {
+		r.Attributes = append(r.Attributes, Attribute{Name: name, Value: expression})
+	}
+}
+
+// SetList adds the argument as a list of strings
+func (r *Resource) SetList(name string, values []string) {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, utils.QuoteHCLString(value))
+	}
+	r.Attributes = append(r.Attributes, Attribute{Name: name, Value: "[" + strings.Join(items, ", ") + "]"})
+}
+
+// SetJSON adds the argument as a jsonencode expression, so JSON documents (i.e. studio flow definitions and workflow configurations) are readable in the generated configuration
+func (r *Resource) SetJSON(name string, value interface{}) {
+	if value == nil {
+		return
+	}
+
+	if jsonString, ok := value.(string); ok {
+		var content interface{}
+		if err := json.Unmarshal([]byte(jsonString), &content); err != nil {
+			r.Set(name, jsonString)
+			return
+		}
+		value = content
+	}
+
+	content, err := json.MarshalIndent(value, "  ", "  ")
+	if err != nil {
+		return
+	}
+	r.Attributes = append(r.Attributes, Attribute{Name: name, Value: "jsonencode(" + utils.EscapeHCLTemplate(string(content)) + ")"})
+}
+
+// SetReference adds the argument as a reference to an attribute of another generated resource
+func (r *Resource) SetReference(name string, resource *Resource, attribute string) {
+	r.Attributes = append(r.Attributes, Attribute{Name: name, Value: fmt.Sprintf("%s.%s.%s", resource.Type, resource.Name, attribute)})
+}
+
+// SetFile adds the argument as a file function call, the path is relative to the generated configuration
+func (r *Resource) SetFile(name string, path string) {
+	r.Attributes = append(r.Attributes, Attribute{Name: name, Value: fmt.Sprintf("file(\"${path.module}/%s\")", path)})
+}
+
+// stringValue dereferences the SDK string fields, which are either a string or a string pointer depending on whether the field is optional
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
+// render writes an import block and resource block for each resource, the warnings are written as comments so they are seen when the configuration is reviewed
+func render(resources []*Resource, warnings []string) []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString("# This file was generated by `terraform-provider-twilio generate`. Please review the configuration before applying\n")
+	for _, warning := range warnings {
+		buffer.WriteString("# WARNING: " + strings.ReplaceAll(warning, "\n", " ") + "\n")
+	}
+
+	for _, resource := range resources {
+		importBlock := &utils.HCLBlock{Type: "import"}
+		importBlock.AddAttribute("to", fmt.Sprintf("%s.%s", resource.Type, resource.Name))
+		importBlock.AddAttribute("id", utils.QuoteHCLString(resource.ImportID))
+
+		resourceBlock := &utils.HCLBlock{Type: "resource", Labels: []string{resource.Type, resource.Name}}
+		for _, attribute := range resource.Attributes {
+			resourceBlock.AddAttribute(attribute.Name, attribute.Value)
+		}
+
+		buffer.WriteString("\n")
+		buffer.WriteString(importBlock.String())
+		buffer.WriteString("\n")
+		buffer.WriteString(resourceBlock.String())
+	}
+	return buffer.Bytes()
+}
+
+// sortedKeys returns the keys of the generators in a consistent order
+func sortedKeys(generators map[string]serviceGenerator) []string {
+	keys := make([]string, 0, len(generators))
+	for key := range generators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
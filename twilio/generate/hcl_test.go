@@ -0,0 +1,118 @@
+package generate
+
+import (
+	"testing"
+
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+func TestResourceSet(t *testing.T) {
+	testCases := map[string]struct {
+		value    interface{}
+		expected string
+	}{
+		"string":                   {value: "test", expected: `"test"`},
+		"string pointer":           {value: sdkUtils.String("test"), expected: `"test"`},
+		"quotes and backslashes":   {value: `say "hello" \ goodbye`, expected: `"say \"hello\" \\ goodbye"`},
+		"control characters":       {value: "line 1\nline 2\ttab\r\u0001", expected: `"line 1\nline 2\ttab\r\u0001"`},
+		"interpolation sequence":   {value: "${var.name}", expected: `"$${var.name}"`},
+		"template directive":       {value: "%{if true}yes%{endif}", expected: `"%%{if true}yes%%{endif}"`},
+		"escaped template":         {value: "$${literal}", expected: `"$$${literal}"`},
+		"unicode":                  {value: "héllo ✓", expected: `"héllo ✓"`},
+		"boolean":                  {value: true, expected: "true"},
+		"boolean pointer":          {value: sdkUtils.Bool(false), expected: "false"},
+		"integer":                  {value: 10, expected: "10"},
+		"integer pointer":          {value: sdkUtils.Int(5), expected: "5"},
+		"float":                    {value: 1.5, expected: "1.5"},
+		"list of strings":          {value: []string{"a", "${b}"}, expected: `["a", "$${b}"]`},
+		"list of mixed primitives": {value: []interface{}{"a", 1, true}, expected: `["a", 1, true]`},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource := &Resource{}
+			resource.Set("value", testCase.value)
+
+			if len(resource.Attributes) != 1 {
+				t.Fatalf("Expected 1 attribute, got %d", len(resource.Attributes))
+			}
+			if resource.Attributes[0].Value != testCase.expected {
+				t.Errorf("Expected %s, got %s", testCase.expected, resource.Attributes[0].Value)
+			}
+		})
+	}
+}
+
+func TestResourceSetIgnoresUnsupportedValues(t *testing.T) {
+	var nilString *string
+	resource := &Resource{}
+	resource.Set("nil", nil)
+	resource.Set("nil_pointer", nilString)
+	resource.Set("map", map[string]string{"key": "value"})
+
+	if len(resource.Attributes) != 0 {
+		t.Errorf("Expected nil and unsupported values to be ignored, got %+v", resource.Attributes)
+	}
+}
+
+func TestResourceSetJSON(t *testing.T) {
+	resource := &Resource{}
+	resource.SetJSON("definition", `{"name":"${flow.data}","count":1}`)
+	resource.SetJSON("invalid", `{"name":`)
+	resource.SetJSON("missing", nil)
+
+	expected := []Attribute{
+		{Name: "definition", Value: "jsonencode({\n    \"count\": 1,\n    \"name\": \"$${flow.data}\"\n  })"},
+		{Name: "invalid", Value: `"{\"name\":"`},
+	}
+	if len(resource.Attributes) != len(expected) {
+		t.Fatalf("Expected %d attributes, got %+v", len(expected), resource.Attributes)
+	}
+	for index, attribute := range expected {
+		if resource.Attributes[index] != attribute {
+			t.Errorf("Expected %+v, got %+v", attribute, resource.Attributes[index])
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	service := &Resource{Type: "twilio_serverless_service", Name: "my_service", ImportID: "/Services/ZS00000000000000000000000000000001"}
+	service.Set("unique_name", "my-service")
+	service.Set("friendly_name", `My "Service"`)
+	service.Set("include_credentials", true)
+
+	function := &Resource{Type: "twilio_serverless_function", Name: "hello", ImportID: "/Services/ZS00000000000000000000000000000001/Functions/ZH00000000000000000000000000000002"}
+	function.SetReference("service_sid", service, "sid")
+	function.SetFile("content", "functions/my_service/hello.js")
+	function.SetList("tags", []string{"a", `b"c`})
+
+	content := string(render([]*Resource{service, function}, []string{"The function ZH1 was skipped\nas it has no versions"}))
+	expected := `# This file was generated by ` + "`terraform-provider-twilio generate`" + `. Please review the configuration before applying
+# WARNING: The function ZH1 was skipped as it has no versions
+
+import {
+  to = twilio_serverless_service.my_service
+  id = "/Services/ZS00000000000000000000000000000001"
+}
+
+resource "twilio_serverless_service" "my_service" {
+  unique_name         = "my-service"
+  friendly_name       = "My \"Service\""
+  include_credentials = true
+}
+
+import {
+  to = twilio_serverless_function.hello
+  id = "/Services/ZS00000000000000000000000000000001/Functions/ZH00000000000000000000000000000002"
+}
+
+resource "twilio_serverless_function" "hello" {
+  service_sid = twilio_serverless_service.my_service.sid
+  content     = file("${path.module}/functions/my_service/hello.js")
+  tags        = ["a", "b\"c"]
+}
+`
+	if content != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, content)
+	}
+}
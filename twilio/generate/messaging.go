@@ -0,0 +1,34 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateMessaging(g *generator) error {
+	client := g.client.Messaging
+
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list messaging services: %s", err.Error())
+	}
+
+	for _, service := range paginator.Services {
+		serviceResource := g.add("twilio_messaging_service", fmt.Sprintf("/Services/%s", service.Sid), service.FriendlyName, service.Sid)
+		serviceResource.Set("friendly_name", service.FriendlyName)
+		serviceResource.Set("area_code_geomatch", service.AreaCodeGeomatch)
+		serviceResource.Set("fallback_method", service.FallbackMethod)
+		serviceResource.Set("fallback_to_long_code", service.FallbackToLongCode)
+		serviceResource.Set("fallback_url", service.FallbackURL)
+		serviceResource.Set("inbound_method", service.InboundMethod)
+		serviceResource.Set("inbound_request_url", service.InboundRequestURL)
+		serviceResource.Set("mms_converter", service.MmsConverter)
+		serviceResource.Set("smart_encoding", service.SmartEncoding)
+		serviceResource.Set("status_callback_url", service.StatusCallback)
+		serviceResource.Set("sticky_sender", service.StickySender)
+		serviceResource.Set("use_inbound_webhook_on_number", service.UseInboundWebhookOnNumber)
+		serviceResource.Set("validity_period", service.ValidityPeriod)
+	}
+	return nil
+}
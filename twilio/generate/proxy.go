@@ -0,0 +1,29 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateProxy(g *generator) error {
+	client := g.client.Proxy
+
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list proxy services: %s", err.Error())
+	}
+
+	for _, service := range paginator.Services {
+		serviceResource := g.add("twilio_proxy_service", fmt.Sprintf("/Services/%s", service.Sid), service.UniqueName, service.Sid)
+		serviceResource.Set("unique_name", service.UniqueName)
+		serviceResource.Set("chat_instance_sid", service.ChatInstanceSid)
+		serviceResource.Set("default_ttl", service.DefaultTtl)
+		serviceResource.Set("callback_url", service.CallbackURL)
+		serviceResource.Set("geo_match_level", service.GeoMatchLevel)
+		serviceResource.Set("number_selection_behavior", service.NumberSelectionBehavior)
+		serviceResource.Set("intercept_callback_url", service.InterceptCallbackURL)
+		serviceResource.Set("out_of_session_callback_url", service.OutOfSessionCallbackURL)
+	}
+	return nil
+}
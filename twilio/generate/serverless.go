@@ -0,0 +1,105 @@
+package generate
+
+import (
+	"fmt"
+
+	"github.com/timworks/twilio-sdk-go/service/serverless/v1/service/function/versions"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+// generateServerless generates the services, environments, variables and functions. Assets are not generated as the asset content cannot be downloaded from the API
+func generateServerless(g *generator) error {
+	client := g.client.Serverless
+
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list serverless services: %s", err.Error())
+	}
+
+	for _, service := range paginator.Services {
+		serviceResource := g.add("twilio_serverless_service", fmt.Sprintf("/Services/%s", service.Sid), service.UniqueName, service.Sid)
+		serviceResource.Set("unique_name", service.UniqueName)
+		serviceResource.Set("friendly_name", service.FriendlyName)
+		serviceResource.Set("include_credentials", service.IncludeCredentials)
+		serviceResource.Set("ui_editable", service.UiEditable)
+
+		environmentsPaginator := client.Service(service.Sid).Environments.NewEnvironmentsPaginator()
+		for environmentsPaginator.NextWithContext(g.ctx) {
+		}
+		if err := environmentsPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list serverless environments: %s", err.Error())
+		}
+
+		for _, environment := range environmentsPaginator.Environments {
+			environmentResource := g.add("twilio_serverless_environment", fmt.Sprintf("/Services/%s/Environments/%s", service.Sid, environment.Sid), environment.UniqueName, environment.Sid)
+			environmentResource.SetReference("service_sid", serviceResource, "sid")
+			environmentResource.Set("unique_name", environment.UniqueName)
+			environmentResource.Set("domain_suffix", environment.DomainSuffix)
+
+			variablesPaginator := client.Service(service.Sid).Environment(environment.Sid).Variables.NewVariablesPaginator()
+			for variablesPaginator.NextWithContext(g.ctx) {
+			}
+			if err := variablesPaginator.Error(); err != nil {
+				return fmt.Errorf("Failed to list serverless variables: %s", err.Error())
+			}
+
+			for _, variable := range variablesPaginator.Variables {
+				variableResource := g.add("twilio_serverless_variable", fmt.Sprintf("/Services/%s/Environments/%s/Variables/%s", service.Sid, environment.Sid, variable.Sid), variable.Key, variable.Sid)
+				variableResource.SetReference("service_sid", serviceResource, "sid")
+				variableResource.SetReference("environment_sid", environmentResource, "sid")
+				variableResource.Set("key", variable.Key)
+				variableResource.Set("value", variable.Value)
+			}
+		}
+
+		functionsPaginator := client.Service(service.Sid).Functions.NewFunctionsPaginator()
+		for functionsPaginator.NextWithContext(g.ctx) {
+		}
+		if err := functionsPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list serverless functions: %s", err.Error())
+		}
+
+		for _, function := range functionsPaginator.Functions {
+			functionClient := client.Service(service.Sid).Function(function.Sid)
+
+			versionsPaginator := functionClient.Versions.NewVersionsPaginatorWithOptions(&versions.VersionsPageOptions{
+				PageSize: sdkUtils.Int(5),
+			})
+			// The twilio api return the latest version as the first element in the array.
+			// So there is no need to loop to retrieve all records
+			versionsPaginator.NextWithContext(g.ctx)
+			if err := versionsPaginator.Error(); err != nil {
+				return fmt.Errorf("Failed to list serverless function versions: %s", err.Error())
+			}
+
+			// The content of the function is required, so functions without a version cannot be imported
+			if len(versionsPaginator.Versions) == 0 {
+				g.warn("The serverless function %s (%s) in service %s has no versions, so it was not generated. Create a version of the function or manage the function via a twilio_serverless_function resource", function.FriendlyName, function.Sid, service.Sid)
+				continue
+			}
+			latestVersion := versionsPaginator.Versions[0]
+
+			functionResource := g.add("twilio_serverless_function", fmt.Sprintf("/Services/%s/Functions/%s", service.Sid, function.Sid), function.FriendlyName, function.Sid)
+			functionResource.SetReference("service_sid", serviceResource, "sid")
+			functionResource.Set("friendly_name", function.FriendlyName)
+
+			contentGetResponse, err := functionClient.Version(latestVersion.Sid).Content().FetchWithContext(g.ctx)
+			if err != nil {
+				return fmt.Errorf("Failed to read serverless function version content: %s", err.Error())
+			}
+
+			// The function code is written to a separate file so it can be edited like any other source file
+			contentPath := fmt.Sprintf("functions/%s/%s.js", serviceResource.Name, functionResource.Name)
+			g.addFile(contentPath, []byte(contentGetResponse.Content))
+
+			functionResource.SetFile("content", contentPath)
+			functionResource.Set("content_file_name", functionResource.Name+".js")
+			functionResource.Set("content_type", "application/javascript")
+			functionResource.Set("path", latestVersion.Path)
+			functionResource.Set("visibility", latestVersion.Visibility)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package generate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/timworks/terraform-provider-twilio/twilio"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance/fakeserver"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	"github.com/timworks/twilio-sdk-go/service/serverless/v1/service/function/versions"
+	"github.com/timworks/twilio-sdk-go/service/serverless/v1/service/functions"
+	"github.com/timworks/twilio-sdk-go/service/serverless/v1/services"
+)
+
+func newTestGenerator(t *testing.T) *generator {
+	server := fakeserver.New()
+	t.Cleanup(server.Close)
+
+	config := twilio.Config{
+		AccountSid:         fakeserver.AccountSid,
+		AuthToken:          fakeserver.AuthToken,
+		APIBaseURLOverride: server.URL,
+	}
+	client, diags := config.Client()
+	if diags.HasError() {
+		t.Fatalf("Failed to configure the Twilio client: %v", diags)
+	}
+
+	return &generator{
+		ctx:       context.Background(),
+		client:    client.(*common.TwilioClient),
+		names:     utils.NewHCLNames(),
+		resources: make([]*Resource, 0),
+		warnings:  make([]string, 0),
+		files:     make(map[string][]byte),
+	}
+}
+
+func TestGenerateServerlessSkipsFunctionsWithoutVersions(t *testing.T) {
+	g := newTestGenerator(t)
+	client := g.client.Serverless
+
+	service, err := client.Services.Create(&services.CreateServiceInput{
+		UniqueName:   "test-service",
+		FriendlyName: "test-service",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	function, err := client.Service(service.Sid).Functions.Create(&functions.CreateFunctionInput{FriendlyName: "hello"})
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if _, err := client.Service(service.Sid).Function(function.Sid).Versions.Create(&versions.CreateVersionInput{
+		Content: versions.CreateContentDetails{
+			Body:        strings.NewReader("exports.handler = function (context, event, callback) { callback(null, \"${hello}\"); };"),
+			ContentType: "application/javascript",
+			FileName:    "hello.js",
+		},
+		Path:       "/hello",
+		Visibility: "public",
+	}); err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	emptyFunction, err := client.Service(service.Sid).Functions.Create(&functions.CreateFunctionInput{FriendlyName: "empty"})
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	if err := generateServerless(g); err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	resourceTypes := make([]string, 0)
+	for _, resource := range g.resources {
+		resourceTypes = append(resourceTypes, resource.Type+"."+resource.Name)
+	}
+	expectedTypes := []string{"twilio_serverless_service.test-service", "twilio_serverless_function.hello"}
+	if strings.Join(resourceTypes, ",") != strings.Join(expectedTypes, ",") {
+		t.Errorf("Expected the resources %v to be generated, got %v", expectedTypes, resourceTypes)
+	}
+
+	if len(g.warnings) != 1 || !strings.Contains(g.warnings[0], emptyFunction.Sid) {
+		t.Errorf("Expected a warning for the function without versions (%s), got %v", emptyFunction.Sid, g.warnings)
+	}
+
+	content, ok := g.files["functions/test-service/hello.js"]
+	if !ok || !strings.Contains(string(content), `"${hello}"`) {
+		t.Errorf("Expected the function content to be written unescaped, got %q", string(content))
+	}
+
+	rendered := string(render(g.resources, g.warnings))
+	if !strings.Contains(rendered, "# WARNING: The serverless function empty ("+emptyFunction.Sid+")") {
+		t.Errorf("Expected the warning to be rendered as a comment, got %s", rendered)
+	}
+	if strings.Contains(rendered, `"empty"`) {
+		t.Errorf("Expected the function without versions not to be rendered, got %s", rendered)
+	}
+}
@@ -0,0 +1,66 @@
+package generate
+
+import (
+	"fmt"
+)
+
+// generateSIP generates the domains, credential lists and IP access control lists. Credentials are not generated as the passwords cannot be read from the API
+func generateSIP(g *generator) error {
+	accountSid := g.client.DefaultAccountSid()
+	client := g.client.API.Account(accountSid).Sip
+
+	domainsPaginator := client.Domains.NewDomainsPaginator()
+	for domainsPaginator.NextWithContext(g.ctx) {
+	}
+	if err := domainsPaginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP domains: %s", err.Error())
+	}
+
+	for _, domain := range domainsPaginator.Domains {
+		domainResource := g.add("twilio_sip_domain", fmt.Sprintf("/Accounts/%s/SIP/Domains/%s", accountSid, domain.Sid), domain.FriendlyName, domain.DomainName, domain.Sid)
+		domainResource.Set("domain_name", domain.DomainName)
+		domainResource.Set("friendly_name", domain.FriendlyName)
+		domainResource.Set("secure", domain.Secure)
+		domainResource.Set("sip_registration", domain.SipRegistration)
+	}
+
+	credentialListsPaginator := client.CredentialLists.NewCredentialListsPaginator()
+	for credentialListsPaginator.NextWithContext(g.ctx) {
+	}
+	if err := credentialListsPaginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP credential lists: %s", err.Error())
+	}
+
+	for _, credentialList := range credentialListsPaginator.CredentialLists {
+		credentialListResource := g.add("twilio_sip_credential_list", fmt.Sprintf("/Accounts/%s/SIP/CredentialLists/%s", accountSid, credentialList.Sid), credentialList.FriendlyName, credentialList.Sid)
+		credentialListResource.Set("friendly_name", credentialList.FriendlyName)
+	}
+
+	ipAccessControlListsPaginator := client.IpAccessControlLists.NewIpAccessControlListsPaginator()
+	for ipAccessControlListsPaginator.NextWithContext(g.ctx) {
+	}
+	if err := ipAccessControlListsPaginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP IP access control lists: %s", err.Error())
+	}
+
+	for _, ipAccessControlList := range ipAccessControlListsPaginator.IpAccessControlLists {
+		ipAccessControlListResource := g.add("twilio_sip_ip_access_control_list", fmt.Sprintf("/Accounts/%s/SIP/IpAccessControlLists/%s", accountSid, ipAccessControlList.Sid), ipAccessControlList.FriendlyName, ipAccessControlList.Sid)
+		ipAccessControlListResource.Set("friendly_name", ipAccessControlList.FriendlyName)
+
+		ipAddressesPaginator := client.IpAccessControlList(ipAccessControlList.Sid).IpAddresses.NewIpAddressesPaginator()
+		for ipAddressesPaginator.NextWithContext(g.ctx) {
+		}
+		if err := ipAddressesPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list SIP IP addresses: %s", err.Error())
+		}
+
+		for _, ipAddress := range ipAddressesPaginator.IpAddresses {
+			ipAddressResource := g.add("twilio_sip_ip_address", fmt.Sprintf("/Accounts/%s/SIP/IpAccessControlLists/%s/IpAddresses/%s", accountSid, ipAccessControlList.Sid, ipAddress.Sid), ipAddress.FriendlyName, ipAddress.Sid)
+			ipAddressResource.SetReference("ip_access_control_list_sid", ipAccessControlListResource, "sid")
+			ipAddressResource.Set("friendly_name", ipAddress.FriendlyName)
+			ipAddressResource.Set("ip_address", ipAddress.IpAddress)
+			ipAddressResource.Set("cidr_length_prefix", ipAddress.CidrPrefixLength)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateSIPTrunking(g *generator) error {
+	client := g.client.SIPTrunking
+
+	paginator := client.Trunks.NewTrunksPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP trunks: %s", err.Error())
+	}
+
+	for _, trunk := range paginator.Trunks {
+		trunkResource := g.add("twilio_sip_trunking_trunk", fmt.Sprintf("/Trunks/%s", trunk.Sid), trunk.FriendlyName, trunk.DomainName, trunk.Sid)
+		trunkResource.Set("friendly_name", trunk.FriendlyName)
+		trunkResource.Set("domain_name", trunk.DomainName)
+		trunkResource.Set("cnam_lookup_enabled", trunk.CnamLookupEnabled)
+		trunkResource.Set("disaster_recovery_method", trunk.DisasterRecoveryMethod)
+		trunkResource.Set("disaster_recovery_url", trunk.DisasterRecoveryURL)
+		trunkResource.Set("secure", trunk.Secure)
+		trunkResource.Set("transfer_mode", trunk.TransferMode)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateStudio(g *generator) error {
+	client := g.client.Studio
+
+	paginator := client.Flows.NewFlowsPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list studio flows: %s", err.Error())
+	}
+
+	for _, flow := range paginator.Flows {
+		// The flow definition is not returned when listing flows, so each flow is fetched
+		getResponse, err := client.Flow(flow.Sid).FetchWithContext(g.ctx)
+		if err != nil {
+			return fmt.Errorf("Failed to read studio flow: %s", err.Error())
+		}
+
+		flowResource := g.add("twilio_studio_flow", fmt.Sprintf("/Flows/%s", getResponse.Sid), getResponse.FriendlyName, getResponse.Sid)
+		flowResource.Set("friendly_name", getResponse.FriendlyName)
+		flowResource.Set("status", getResponse.Status)
+		flowResource.SetJSON("definition", getResponse.Definition)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package generate
+
+import (
+	"fmt"
+	"strings"
+)
+
+func generateTaskRouter(g *generator) error {
+	client := g.client.TaskRouter
+
+	paginator := client.Workspaces.NewWorkspacesPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list taskrouter workspaces: %s", err.Error())
+	}
+
+	for _, workspace := range paginator.Workspaces {
+		workspaceClient := client.Workspace(workspace.Sid)
+
+		workspaceResource := g.add("twilio_taskrouter_workspace", fmt.Sprintf("/Workspaces/%s", workspace.Sid), workspace.FriendlyName, workspace.Sid)
+		workspaceResource.Set("friendly_name", workspace.FriendlyName)
+		workspaceResource.Set("event_callback_url", workspace.EventCallbackURL)
+		if workspace.EventsFilter != nil && *workspace.EventsFilter != "" {
+			workspaceResource.SetList("event_filters", strings.Split(*workspace.EventsFilter, ","))
+		}
+		workspaceResource.Set("multi_task_enabled", workspace.MultiTaskEnabled)
+		workspaceResource.Set("prioritize_queue_order", workspace.PrioritizeQueueOrder)
+
+		activitiesPaginator := workspaceClient.Activities.NewActivitiesPaginator()
+		for activitiesPaginator.NextWithContext(g.ctx) {
+		}
+		if err := activitiesPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list taskrouter activities: %s", err.Error())
+		}
+
+		for _, activity := range activitiesPaginator.Activities {
+			activityResource := g.add("twilio_taskrouter_activity", fmt.Sprintf("/Workspaces/%s/Activities/%s", workspace.Sid, activity.Sid), activity.FriendlyName, activity.Sid)
+			activityResource.SetReference("workspace_sid", workspaceResource, "sid")
+			activityResource.Set("friendly_name", activity.FriendlyName)
+			activityResource.Set("available", activity.Available)
+		}
+
+		taskChannelsPaginator := workspaceClient.TaskChannels.NewTaskChannelsPaginator()
+		for taskChannelsPaginator.NextWithContext(g.ctx) {
+		}
+		if err := taskChannelsPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list taskrouter task channels: %s", err.Error())
+		}
+
+		for _, taskChannel := range taskChannelsPaginator.TaskChannels {
+			taskChannelResource := g.add("twilio_taskrouter_task_channel", fmt.Sprintf("/Workspaces/%s/TaskChannels/%s", workspace.Sid, taskChannel.Sid), taskChannel.UniqueName, taskChannel.Sid)
+			taskChannelResource.SetReference("workspace_sid", workspaceResource, "sid")
+			taskChannelResource.Set("friendly_name", taskChannel.FriendlyName)
+			taskChannelResource.Set("unique_name", taskChannel.UniqueName)
+			taskChannelResource.Set("channel_optimized_routing", taskChannel.ChannelOptimizedRouting)
+		}
+
+		taskQueuesPaginator := workspaceClient.TaskQueues.NewTaskQueuesPaginator()
+		for taskQueuesPaginator.NextWithContext(g.ctx) {
+		}
+		if err := taskQueuesPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list taskrouter task queues: %s", err.Error())
+		}
+
+		for _, taskQueue := range taskQueuesPaginator.TaskQueues {
+			taskQueueResource := g.add("twilio_taskrouter_task_queue", fmt.Sprintf("/Workspaces/%s/TaskQueues/%s", workspace.Sid, taskQueue.Sid), taskQueue.FriendlyName, taskQueue.Sid)
+			taskQueueResource.SetReference("workspace_sid", workspaceResource, "sid")
+			taskQueueResource.Set("friendly_name", taskQueue.FriendlyName)
+			taskQueueResource.Set("assignment_activity_sid", taskQueue.AssignmentActivitySid)
+			taskQueueResource.Set("reservation_activity_sid", taskQueue.ReservationActivitySid)
+			taskQueueResource.Set("max_reserved_workers", taskQueue.MaxReservedWorkers)
+			taskQueueResource.Set("target_workers", taskQueue.TargetWorkers)
+			taskQueueResource.Set("task_order", taskQueue.TaskOrder)
+		}
+
+		workflowsPaginator := workspaceClient.Workflows.NewWorkflowsPaginator()
+		for workflowsPaginator.NextWithContext(g.ctx) {
+		}
+		if err := workflowsPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list taskrouter workflows: %s", err.Error())
+		}
+
+		for _, workflow := range workflowsPaginator.Workflows {
+			workflowResource := g.add("twilio_taskrouter_workflow", fmt.Sprintf("/Workspaces/%s/Workflows/%s", workspace.Sid, workflow.Sid), workflow.FriendlyName, workflow.Sid)
+			workflowResource.SetReference("workspace_sid", workspaceResource, "sid")
+			workflowResource.Set("friendly_name", workflow.FriendlyName)
+			workflowResource.Set("assignment_callback_url", workflow.AssignmentCallbackURL)
+			workflowResource.Set("fallback_assignment_callback_url", workflow.FallbackAssignmentCallbackURL)
+			workflowResource.Set("task_reservation_timeout", workflow.TaskReservationTimeout)
+			workflowResource.SetJSON("configuration", workflow.Configuration)
+		}
+
+		workersPaginator := workspaceClient.Workers.NewWorkersPaginator()
+		for workersPaginator.NextWithContext(g.ctx) {
+		}
+		if err := workersPaginator.Error(); err != nil {
+			return fmt.Errorf("Failed to list taskrouter workers: %s", err.Error())
+		}
+
+		for _, worker := range workersPaginator.Workers {
+			workerResource := g.add("twilio_taskrouter_worker", fmt.Sprintf("/Workspaces/%s/Workers/%s", workspace.Sid, worker.Sid), worker.FriendlyName, worker.Sid)
+			workerResource.SetReference("workspace_sid", workspaceResource, "sid")
+			workerResource.Set("friendly_name", worker.FriendlyName)
+			workerResource.Set("activity_sid", worker.ActivitySid)
+			workerResource.SetJSON("attributes", worker.Attributes)
+		}
+	}
+	return nil
+}
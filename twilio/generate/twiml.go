@@ -0,0 +1,23 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateTwiML(g *generator) error {
+	accountSid := g.client.DefaultAccountSid()
+	client := g.client.API.Account(accountSid)
+
+	paginator := client.Applications.NewApplicationsPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list TwiML apps: %s", err.Error())
+	}
+
+	for _, app := range paginator.Applications {
+		appResource := g.add("twilio_twiml_app", fmt.Sprintf("/Accounts/%s/Applications/%s", accountSid, app.Sid), app.FriendlyName, app.Sid)
+		appResource.Set("friendly_name", app.FriendlyName)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package generate
+
+import (
+	"fmt"
+)
+
+func generateVoice(g *generator) error {
+	accountSid := g.client.DefaultAccountSid()
+	client := g.client.API.Account(accountSid)
+
+	paginator := client.Queues.NewQueuesPaginator()
+	for paginator.NextWithContext(g.ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list voice queues: %s", err.Error())
+	}
+
+	for _, queue := range paginator.Queues {
+		queueResource := g.add("twilio_voice_queue", fmt.Sprintf("/Accounts/%s/Queues/%s", accountSid, queue.Sid), queue.FriendlyName, queue.Sid)
+		queueResource.Set("friendly_name", queue.FriendlyName)
+		queueResource.Set("max_size", queue.MaxSize)
+	}
+	return nil
+}
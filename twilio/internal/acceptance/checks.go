@@ -7,6 +7,7 @@ import (
 
 func PreCheck(t *testing.T) {
 	InitialiseProviders()
+	startRecorder(t)
 
 	variables := []string{
 		"TWILIO_ACCOUNT_SID",
@@ -0,0 +1,247 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const accountPath = v2010Prefix + "/Accounts/{AccountSid}"
+
+func (s *Server) registerAPI() {
+	s.routes["api"] = []route{
+		{method: http.MethodGet, pattern: accountPath + "/AvailablePhoneNumbers/{CountryCode}/Local", handler: availablePhoneNumbers},
+		{method: http.MethodGet, pattern: accountPath + "/AvailablePhoneNumbers/{CountryCode}/Mobile", handler: availablePhoneNumbers},
+		{method: http.MethodGet, pattern: accountPath + "/AvailablePhoneNumbers/{CountryCode}/TollFree", handler: availablePhoneNumbers},
+	}
+
+	s.collections["api"] = []*collection{
+		{
+			pattern:   v2010Prefix + "/Accounts",
+			key:       "accounts",
+			sidPrefix: "AC",
+			defaults: record{
+				"status": "active",
+				"type":   "Full",
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				rec["owner_account_sid"] = AccountSid
+				rec["auth_token"] = fmt.Sprintf("%032x", s.counter)
+				delete(rec, "account_sid")
+				return nil
+			},
+		},
+		{
+			pattern:   accountPath + "/Addresses",
+			key:       "addresses",
+			sidPrefix: "AD",
+			defaults: record{
+				"emergency_enabled": false,
+				"validated":         true,
+				"verified":          false,
+			},
+		},
+		{
+			pattern:   accountPath + "/Applications",
+			key:       "applications",
+			sidPrefix: "AP",
+			defaults: record{
+				"api_version": "2010-04-01",
+			},
+		},
+		{
+			pattern:   accountPath + "/Balance",
+			singleton: true,
+			defaults: record{
+				"balance":  "0.00",
+				"currency": "USD",
+			},
+		},
+		{
+			pattern:   accountPath + "/IncomingPhoneNumbers",
+			key:       "incoming_phone_numbers",
+			sidPrefix: "PN",
+			defaults: record{
+				"api_version":      "2010-04-01",
+				"origin":           "twilio",
+				"status":           "in-use",
+				"emergency_status": "Inactive",
+				"capabilities": map[string]interface{}{
+					"fax":   false,
+					"mms":   true,
+					"sms":   true,
+					"voice": true,
+				},
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				if _, ok := rec["phone_number"]; !ok {
+					rec["phone_number"] = fmt.Sprintf("+1500555%04d", s.counter%10000)
+				}
+				if _, ok := rec["friendly_name"]; !ok {
+					rec["friendly_name"] = rec["phone_number"]
+				}
+				return nil
+			},
+			onUpdate: movePhoneNumber,
+		},
+		{
+			pattern:   accountPath + "/Keys",
+			key:       "keys",
+			sidPrefix: "SK",
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				rec["secret"] = fmt.Sprintf("%032x", s.counter)
+				return nil
+			},
+			onUpdate: func(s *Server, rec record, params map[string]string) *apiError {
+				delete(rec, "secret")
+				return nil
+			},
+		},
+		{
+			pattern:   accountPath + "/Queues",
+			key:       "queues",
+			sidPrefix: "QU",
+			intFields: []string{"max_size"},
+			defaults: record{
+				"average_wait_time": 0,
+				"current_size":      0,
+				"max_size":          100,
+			},
+		},
+		{
+			pattern:   accountPath + "/SIP/CredentialLists",
+			key:       "credential_lists",
+			sidPrefix: "CL",
+		},
+		{
+			pattern:   accountPath + "/SIP/CredentialLists/{CredentialListSid}/Credentials",
+			key:       "credentials",
+			sidPrefix: "CR",
+			parents: map[string]string{
+				"CredentialListSid": "credential_list_sid",
+			},
+			onCreate: removePassword,
+			onUpdate: removePassword,
+		},
+		{
+			pattern:   accountPath + "/SIP/Domains",
+			key:       "domains",
+			sidPrefix: "SD",
+			defaults: record{
+				"api_version":                  "2010-04-01",
+				"sip_registration":             false,
+				"emergency_calling_enabled":    false,
+				"secure":                       false,
+				"voice_method":                 "POST",
+				"voice_fallback_method":        "POST",
+				"voice_status_callback_method": "POST",
+			},
+		},
+		mappingCollection(accountPath+"/SIP/Domains/{DomainSid}/CredentialListMappings", "credential_list_mappings", "credential_list_sid"),
+		mappingCollection(accountPath+"/SIP/Domains/{DomainSid}/IpAccessControlListMappings", "ip_access_control_list_mappings", "ip_access_control_list_sid"),
+		mappingCollection(accountPath+"/SIP/Domains/{DomainSid}/Auth/Calls/CredentialListMappings", "contents", "credential_list_sid"),
+		mappingCollection(accountPath+"/SIP/Domains/{DomainSid}/Auth/Calls/IpAccessControlListMappings", "contents", "ip_access_control_list_sid"),
+		mappingCollection(accountPath+"/SIP/Domains/{DomainSid}/Auth/Registrations/CredentialListMappings", "contents", "credential_list_sid"),
+		{
+			pattern:   accountPath + "/SIP/IpAccessControlLists",
+			key:       "ip_access_control_lists",
+			sidPrefix: "AL",
+		},
+		{
+			pattern:   accountPath + "/SIP/IpAccessControlLists/{IpAccessControlListSid}/IpAddresses",
+			key:       "ip_addresses",
+			sidPrefix: "IP",
+			intFields: []string{"cidr_prefix_length"},
+			parents: map[string]string{
+				"IpAccessControlListSid": "ip_access_control_list_sid",
+			},
+			defaults: record{
+				"cidr_prefix_length": 32,
+			},
+		},
+	}
+}
+
+// mappingCollection creates a collection where the SID of the mapping is the SID of the resource being mapped
+func mappingCollection(pattern string, key string, sidField string) *collection {
+	return &collection{
+		pattern: pattern,
+		key:     key,
+		parents: map[string]string{
+			"DomainSid": "domain_sid",
+		},
+		onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+			sid, ok := rec[sidField].(string)
+			if !ok || sid == "" {
+				return badRequest(21201, fmt.Sprintf("%s is required", sidField))
+			}
+			changeSid(rec, sid)
+			delete(rec, sidField)
+			return nil
+		},
+	}
+}
+
+// movePhoneNumber transfers the phone number to the account in the AccountSid parameter, so the number is only returned by the new account
+func movePhoneNumber(s *Server, rec record, params map[string]string) *apiError {
+	accountSid, _ := rec["account_sid"].(string)
+	if accountSid == params["AccountSid"] {
+		return nil
+	}
+
+	accountsPath := v2010Prefix + "/Accounts"
+	if s.get("api", accountsPath, accountSid) == nil {
+		rec["account_sid"] = params["AccountSid"]
+		return badRequest(20001, fmt.Sprintf("The account %s is not a subaccount of %s", accountSid, params["AccountSid"]))
+	}
+
+	sid := rec["sid"].(string)
+	s.remove("api", accountsPath+"/"+params["AccountSid"]+"/IncomingPhoneNumbers", sid)
+
+	listPath := accountsPath + "/" + accountSid + "/IncomingPhoneNumbers"
+	rec["uri"] = listPath + "/" + sid + ".json"
+	s.put("api", listPath, rec)
+	return nil
+}
+
+func removePassword(s *Server, rec record, params map[string]string) *apiError {
+	delete(rec, "password")
+	return nil
+}
+
+// changeSid replaces the generated SID along with any references to the SID
+func changeSid(rec record, sid string) {
+	generatedSid := rec["sid"].(string)
+	rec["sid"] = sid
+	for _, field := range []string{"uri", "url"} {
+		if value, ok := rec[field].(string); ok {
+			rec[field] = strings.Replace(value, generatedSid, sid, 1)
+		}
+	}
+}
+
+func availablePhoneNumbers(s *Server, params map[string]string, form url.Values) (int, interface{}) {
+	numbers := make([]interface{}, 0)
+	for i := 0; i < 5; i++ {
+		phoneNumber := fmt.Sprintf("+1500555%04d", 1000+i)
+		numbers = append(numbers, map[string]interface{}{
+			"friendly_name":        phoneNumber,
+			"phone_number":         phoneNumber,
+			"iso_country":          params["CountryCode"],
+			"address_requirements": "none",
+			"beta":                 false,
+			"capabilities": map[string]interface{}{
+				"fax":   false,
+				"mms":   true,
+				"sms":   true,
+				"voice": true,
+			},
+		})
+	}
+
+	return http.StatusOK, map[string]interface{}{
+		"available_phone_numbers": numbers,
+		"uri":                     v2010Prefix + "/Accounts/" + params["AccountSid"] + "/AvailablePhoneNumbers/" + params["CountryCode"] + ".json",
+	}
+}
@@ -0,0 +1,190 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type record map[string]interface{}
+
+type hook func(s *Server, rec record, params map[string]string) *apiError
+
+// collection describes a Twilio list resource and the instances which belong to it.
+// Requests are translated into records generically, so only the behaviour which cannot be inferred from the request needs to be configured
+type collection struct {
+	// pattern is the path of the list endpoint, path parameters are denoted by {Name}
+	pattern string
+	// key is the name of the array in the list response
+	key string
+	// sidPrefix is the 2 character prefix used when generating a SID
+	sidPrefix string
+	// singleton denotes that the pattern refers to a single instance rather than a list of instances
+	singleton bool
+	// parents maps path parameters onto the field the value is exposed as
+	parents map[string]string
+	// aliases maps form parameters onto a different field name when the Twilio API does not follow the snake case convention
+	aliases map[string]string
+	// defaults are added to a record when it is created
+	defaults record
+	// intFields, listFields and jsonFields are converted from their string form representation
+	intFields  []string
+	listFields []string
+	jsonFields []string
+	// onCreate and onUpdate allow for additional fields to be computed
+	onCreate hook
+	onUpdate hook
+}
+
+type route struct {
+	method  string
+	pattern string
+	handler func(s *Server, params map[string]string, form url.Values) (int, interface{})
+}
+
+var indexSuffixRegex = regexp.MustCompile(`\[\d+\]$`)
+
+func matchPattern(pattern string, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for index, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = pathSegments[index]
+			continue
+		}
+		if segment != pathSegments[index] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (c *collection) apply(rec record, form url.Values) {
+	for key, values := range normaliseForm(form) {
+		field := c.fieldName(key)
+
+		var value interface{}
+		switch {
+		case contains(c.listFields, field):
+			list := make([]interface{}, 0)
+			for _, item := range values {
+				if item != "" {
+					list = append(list, item)
+				}
+			}
+			value = list
+		case contains(c.jsonFields, field):
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(values[0]), &parsed); err != nil {
+				value = values[0]
+			} else {
+				value = parsed
+			}
+		case contains(c.intFields, field):
+			if parsed, err := strconv.Atoi(values[0]); err == nil {
+				value = parsed
+			} else {
+				value = values[0]
+			}
+		case values[0] == "true" || values[0] == "false":
+			value = values[0] == "true"
+		default:
+			value = values[0]
+		}
+
+		setNested(rec, field, value)
+	}
+}
+
+func (c *collection) fieldName(key string) string {
+	if alias, ok := c.aliases[key]; ok {
+		return alias
+	}
+
+	parts := strings.Split(key, ".")
+	for index, part := range parts {
+		parts[index] = toSnakeCase(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// normaliseForm combines indexed form parameters (i.e. Permission[0]) with repeated form parameters (i.e. Permission)
+func normaliseForm(form url.Values) map[string][]string {
+	normalised := map[string][]string{}
+	for key, values := range form {
+		name := indexSuffixRegex.ReplaceAllString(key, "")
+		normalised[name] = append(normalised[name], values...)
+	}
+	return normalised
+}
+
+func setNested(rec record, field string, value interface{}) {
+	parts := strings.Split(field, ".")
+	current := rec
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[part] = next
+		}
+		current = next
+	}
+	current[parts[len(parts)-1]] = value
+}
+
+func toSnakeCase(input string) string {
+	var builder strings.Builder
+	for index, character := range input {
+		if character >= 'A' && character <= 'Z' {
+			if index > 0 {
+				builder.WriteRune('_')
+			}
+			builder.WriteRune(character + ('a' - 'A'))
+			continue
+		}
+		builder.WriteRune(character)
+	}
+	return builder.String()
+}
+
+func contains(slice []string, value string) bool {
+	for _, item := range slice {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func copyValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case record:
+		return map[string]interface{}(copyRecord(typedValue))
+	case map[string]interface{}:
+		return map[string]interface{}(copyRecord(typedValue))
+	case []interface{}:
+		copied := make([]interface{}, len(typedValue))
+		for index, item := range typedValue {
+			copied[index] = copyValue(item)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+func copyRecord(rec map[string]interface{}) record {
+	copied := record{}
+	for key, value := range rec {
+		copied[key] = copyValue(value)
+	}
+	return copied
+}
@@ -0,0 +1,126 @@
+package fakeserver
+
+const conversationsServicePath = "/v1/Services/{ChatServiceSid}"
+
+func (s *Server) registerConversations() {
+	chatServiceParent := map[string]string{
+		"ChatServiceSid": "chat_service_sid",
+	}
+
+	s.collections["conversations"] = []*collection{
+		{
+			pattern:   "/v1/Configuration",
+			singleton: true,
+			defaults: record{
+				"default_closed_timer":          nil,
+				"default_inactive_timer":        nil,
+				"default_chat_service_sid":      FlexChannelServiceSid,
+				"default_messaging_service_sid": nil,
+			},
+		},
+		{
+			pattern:    "/v1/Configuration/Webhooks",
+			singleton:  true,
+			listFields: []string{"filters"},
+			defaults: record{
+				"filters": []interface{}{},
+				"method":  "POST",
+				"target":  "webhook",
+			},
+		},
+		{
+			pattern:   "/v1/Credentials",
+			key:       "credentials",
+			sidPrefix: "CR",
+			defaults: record{
+				"sandbox": false,
+			},
+			onCreate: removeCredentialSecrets,
+			onUpdate: removeCredentialSecrets,
+		},
+		{
+			pattern:   "/v1/Services",
+			key:       "services",
+			sidPrefix: "IS",
+		},
+		{
+			pattern:   conversationsServicePath + "/Configuration",
+			singleton: true,
+			parents:   chatServiceParent,
+			defaults: record{
+				"reachability_enabled": false,
+			},
+		},
+		{
+			pattern:   conversationsServicePath + "/Configuration/Notifications",
+			singleton: true,
+			parents:   chatServiceParent,
+			defaults: record{
+				"log_enabled": false,
+				"added_to_conversation": map[string]interface{}{
+					"enabled": false,
+				},
+				"new_message": map[string]interface{}{
+					"enabled":             false,
+					"badge_count_enabled": false,
+				},
+				"removed_from_conversation": map[string]interface{}{
+					"enabled": false,
+				},
+			},
+		},
+		{
+			pattern:   conversationsServicePath + "/Conversations",
+			key:       "conversations",
+			sidPrefix: "CH",
+			parents:   chatServiceParent,
+			defaults: record{
+				"attributes": "{}",
+				"state":      "active",
+				"timers":     map[string]interface{}{},
+			},
+		},
+		{
+			pattern:    conversationsServicePath + "/Conversations/{ConversationSid}/Webhooks",
+			key:        "webhooks",
+			sidPrefix:  "WH",
+			intFields:  []string{"configuration.replay_after"},
+			listFields: []string{"configuration.filters", "configuration.triggers"},
+			parents: map[string]string{
+				"ChatServiceSid":  "chat_service_sid",
+				"ConversationSid": "conversation_sid",
+			},
+			defaults: record{
+				"configuration": map[string]interface{}{},
+			},
+		},
+		{
+			pattern:    conversationsServicePath + "/Roles",
+			key:        "roles",
+			sidPrefix:  "RL",
+			listFields: []string{"permissions"},
+			aliases: map[string]string{
+				"Permission": "permissions",
+			},
+			parents: chatServiceParent,
+		},
+		{
+			pattern:   conversationsServicePath + "/Users",
+			key:       "users",
+			sidPrefix: "US",
+			parents:   chatServiceParent,
+			defaults: record{
+				"attributes":    "{}",
+				"is_notifiable": nil,
+				"is_online":     nil,
+			},
+		},
+	}
+}
+
+func removeCredentialSecrets(s *Server, rec record, params map[string]string) *apiError {
+	for _, field := range []string{"api_key", "certificate", "private_key", "secret"} {
+		delete(rec, field)
+	}
+	return nil
+}
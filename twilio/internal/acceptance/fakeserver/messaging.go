@@ -0,0 +1,90 @@
+package fakeserver
+
+import "fmt"
+
+const messagingServicePath = "/v1/Services/{MessagingServiceSid}"
+
+func (s *Server) registerMessaging() {
+	messagingServiceParent := map[string]string{
+		"MessagingServiceSid": "service_sid",
+	}
+
+	s.collections["messaging"] = []*collection{
+		{
+			pattern:   "/v1/Services",
+			key:       "services",
+			sidPrefix: "MG",
+			intFields: []string{"validity_period"},
+			defaults: record{
+				"area_code_geomatch":            true,
+				"fallback_method":               "POST",
+				"fallback_to_long_code":         true,
+				"inbound_method":                "POST",
+				"mms_converter":                 true,
+				"preconfigured":                 false,
+				"scan_message_content":          "inherit",
+				"smart_encoding":                true,
+				"sticky_sender":                 true,
+				"synchronous_validation":        false,
+				"us_app_to_person_registered":   false,
+				"use_inbound_webhook_on_number": false,
+				"usecase":                       "undeclared",
+				"validity_period":               14400,
+			},
+		},
+		{
+			pattern:   messagingServicePath + "/AlphaSenders",
+			key:       "alpha_senders",
+			sidPrefix: "AI",
+			parents:   messagingServiceParent,
+			defaults: record{
+				"capabilities": []interface{}{"SMS"},
+			},
+		},
+		{
+			pattern: messagingServicePath + "/PhoneNumbers",
+			key:     "phone_numbers",
+			parents: messagingServiceParent,
+			defaults: record{
+				"capabilities": []interface{}{"Voice", "SMS", "MMS"},
+				"country_code": "US",
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				sid, ok := rec["phone_number_sid"].(string)
+				if !ok || sid == "" {
+					return badRequest(21201, "phone_number_sid is required")
+				}
+				changeSid(rec, sid)
+				delete(rec, "phone_number_sid")
+
+				rec["phone_number"] = PhoneNumber
+				for _, phoneNumber := range s.records("api", v2010Prefix+"/Accounts/"+AccountSid+"/IncomingPhoneNumbers") {
+					if phoneNumber["sid"] == sid {
+						rec["phone_number"] = phoneNumber["phone_number"]
+					}
+				}
+				return nil
+			},
+		},
+		{
+			pattern: messagingServicePath + "/ShortCodes",
+			key:     "short_codes",
+			parents: messagingServiceParent,
+			defaults: record{
+				"capabilities": []interface{}{"SMS", "MMS"},
+				"country_code": "US",
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				sid, ok := rec["short_code_sid"].(string)
+				if !ok || sid == "" {
+					return badRequest(21201, "short_code_sid is required")
+				}
+				changeSid(rec, sid)
+				delete(rec, "short_code_sid")
+
+				rec["short_code"] = fmt.Sprintf("%05d", s.counter%100000)
+				return nil
+			},
+		},
+	}
+}
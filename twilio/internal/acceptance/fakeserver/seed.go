@@ -0,0 +1,31 @@
+package fakeserver
+
+// seed creates the resources which the acceptance tests expect to already exist in the Twilio account
+func (s *Server) seed() {
+	accountsPath := v2010Prefix + "/Accounts"
+	account := s.newRecord(&collection{}, map[string]string{}, accountsPath, AccountSid)
+	delete(account, "account_sid")
+	account["friendly_name"] = "Fake Twilio Account"
+	account["auth_token"] = AuthToken
+	account["owner_account_sid"] = AccountSid
+	account["status"] = "active"
+	account["type"] = "Full"
+	s.put("api", accountsPath, account)
+
+	phoneNumbersPath := accountsPath + "/" + AccountSid + "/IncomingPhoneNumbers"
+	var phoneNumberCollection *collection
+	for _, c := range s.collections["api"] {
+		if c.pattern == accountPath+"/IncomingPhoneNumbers" {
+			phoneNumberCollection = c
+		}
+	}
+	phoneNumber := s.newRecord(phoneNumberCollection, map[string]string{"AccountSid": AccountSid}, phoneNumbersPath, PhoneNumberSid)
+	phoneNumber["friendly_name"] = PhoneNumber
+	phoneNumber["phone_number"] = PhoneNumber
+	s.put("api", phoneNumbersPath, phoneNumber)
+
+	servicesPath := "/v1/Services"
+	flexChannelService := s.newRecord(&collection{}, map[string]string{}, servicesPath, FlexChannelServiceSid)
+	flexChannelService["friendly_name"] = "Flex Chat Service"
+	s.put("conversations", servicesPath, flexChannelService)
+}
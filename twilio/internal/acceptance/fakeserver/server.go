@@ -0,0 +1,440 @@
+// Package fakeserver provides an in-memory stand-in for the Twilio REST APIs, so the acceptance tests can be run without a Twilio account.
+//
+// The server routes each request using the Host header, which the provider retains when the API base URL is overridden,
+// i.e. a request for https://studio.twilio.com/v2/Flows is handled by the Studio routes.
+// API v2010, Conversations, Messaging, Serverless, Studio and TaskRouter are supported.
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	v2010Prefix = "/2010-04-01"
+
+	// Seeded values which are exposed so the acceptance tests can reference pre-existing resources
+	AccountSid            = "AC00000000000000000000000000000000"
+	AuthToken             = "00000000000000000000000000000000"
+	PhoneNumberSid        = "PN00000000000000000000000000000000"
+	PhoneNumber           = "+15005550006"
+	FlexChannelServiceSid = "IS00000000000000000000000000000000"
+)
+
+// productAliases maps products which share state with another product
+var productAliases = map[string]string{
+	"serverless-upload": "serverless",
+}
+
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+type table struct {
+	order []string
+	items map[string]record
+}
+
+// Server is an in-memory implementation of the Twilio REST APIs
+type Server struct {
+	URL string
+
+	httpServer  *httptest.Server
+	mutex       sync.Mutex
+	counter     int
+	tables      map[string]*table
+	singletons  map[string]record
+	collections map[string][]*collection
+	routes      map[string][]route
+}
+
+// New starts a new fake server, seeded with an account and a phone number. The server must be closed when it is no longer required
+func New() *Server {
+	s := &Server{
+		tables:      map[string]*table{},
+		singletons:  map[string]record{},
+		collections: map[string][]*collection{},
+		routes:      map[string][]route{},
+	}
+
+	s.registerAPI()
+	s.registerConversations()
+	s.registerMessaging()
+	s.registerServerless()
+	s.registerStudio()
+	s.registerTaskRouter()
+	s.seed()
+
+	s.httpServer = httptest.NewServer(s)
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// Close shuts down the server and discards all state
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if username, _, ok := r.BasicAuth(); !ok || username == "" {
+		writeError(w, &apiError{status: http.StatusUnauthorized, code: 20003, message: "Authenticate"})
+		return
+	}
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+		writeError(w, &apiError{status: http.StatusBadRequest, code: 20001, message: err.Error()})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, &apiError{status: http.StatusBadRequest, code: 20001, message: err.Error()})
+		return
+	}
+
+	// File uploads (i.e. serverless function versions) are treated as regular form parameters
+	if r.MultipartForm != nil {
+		for name, files := range r.MultipartForm.File {
+			content, err := readFile(files[0])
+			if err != nil {
+				writeError(w, &apiError{status: http.StatusBadRequest, code: 20001, message: err.Error()})
+				return
+			}
+			r.PostForm.Set(name, content)
+		}
+	}
+
+	product := strings.Split(r.Host, ".")[0]
+	path := strings.TrimSuffix(r.URL.Path, ".json")
+
+	// State is stored per product as multiple products share the same paths (i.e. /v1/Services)
+	storeProduct := product
+	if alias, ok := productAliases[product]; ok {
+		storeProduct = alias
+	}
+
+	for _, rt := range s.routes[product] {
+		if params, ok := matchPattern(rt.pattern, path); ok && rt.method == r.Method {
+			status, body := rt.handler(s, params, r.PostForm)
+			writeJSON(w, status, body)
+			return
+		}
+	}
+
+	for _, c := range s.collections[product] {
+		if c.singleton {
+			if params, ok := matchPattern(c.pattern, path); ok {
+				s.handleSingleton(w, r, c, storeProduct, params, path)
+				return
+			}
+			continue
+		}
+		if params, ok := matchPattern(c.pattern, path); ok {
+			s.handleList(w, r, c, storeProduct, params, path)
+			return
+		}
+		if params, ok := matchPattern(c.pattern+"/{Sid}", path); ok {
+			s.handleInstance(w, r, c, storeProduct, params, path)
+			return
+		}
+	}
+
+	writeError(w, notFound(r.URL.Path))
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request, c *collection, product string, params map[string]string, listPath string) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.list(c, product, listPath, r.URL.Query()))
+	case http.MethodPost:
+		rec, err := s.create(c, product, params, listPath, r.PostForm)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, rec)
+	default:
+		writeError(w, methodNotAllowed(r.Method))
+	}
+}
+
+func (s *Server) handleInstance(w http.ResponseWriter, r *http.Request, c *collection, product string, params map[string]string, instancePath string) {
+	listPath := instancePath[:strings.LastIndex(instancePath, "/")]
+	rec := s.get(product, listPath, params["Sid"])
+	if rec == nil {
+		writeError(w, notFound(r.URL.Path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, rec)
+	case http.MethodPost:
+		c.apply(rec, r.PostForm)
+		rec["date_updated"] = s.now(instancePath)
+		if c.onUpdate != nil {
+			if err := c.onUpdate(s, rec, params); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, rec)
+	case http.MethodDelete:
+		s.remove(product, listPath, params["Sid"])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowed(r.Method))
+	}
+}
+
+func (s *Server) handleSingleton(w http.ResponseWriter, r *http.Request, c *collection, product string, params map[string]string, path string) {
+	rec, ok := s.singletons[product+path]
+	if !ok {
+		rec = s.newRecord(c, params, path, "")
+		s.singletons[product+path] = rec
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, rec)
+	case http.MethodPost:
+		c.apply(rec, r.PostForm)
+		rec["date_updated"] = s.now(path)
+		if c.onUpdate != nil {
+			if err := c.onUpdate(s, rec, params); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, rec)
+	default:
+		writeError(w, methodNotAllowed(r.Method))
+	}
+}
+
+func (s *Server) newRecord(c *collection, params map[string]string, listPath string, sid string) record {
+	rec := copyRecord(c.defaults)
+	rec["account_sid"] = AccountSid
+	if accountSid, ok := params["AccountSid"]; ok {
+		rec["account_sid"] = accountSid
+	}
+	for param, field := range c.parents {
+		rec[field] = params[param]
+	}
+
+	instancePath := listPath
+	if sid != "" {
+		rec["sid"] = sid
+		instancePath = listPath + "/" + sid
+	}
+
+	rec["date_created"] = s.now(listPath)
+	rec["date_updated"] = s.now(listPath)
+	if strings.HasPrefix(listPath, v2010Prefix) {
+		rec["uri"] = instancePath + ".json"
+	} else {
+		rec["url"] = "https://twilio.com" + instancePath
+	}
+	return rec
+}
+
+func (s *Server) create(c *collection, product string, params map[string]string, listPath string, form url.Values) (record, *apiError) {
+	rec := s.newRecord(c, params, listPath, s.newSid(c.sidPrefix))
+	c.apply(rec, form)
+
+	if c.onCreate != nil {
+		if err := c.onCreate(s, rec, params); err != nil {
+			return nil, err
+		}
+	}
+
+	s.put(product, listPath, rec)
+	return rec, nil
+}
+
+func (s *Server) list(c *collection, product string, listPath string, query url.Values) map[string]interface{} {
+	items := make([]interface{}, 0)
+	if t, ok := s.tables[product+listPath]; ok {
+		for _, sid := range t.order {
+			if rec := t.items[sid]; matchesQuery(c, rec, query) {
+				items = append(items, rec)
+			}
+		}
+	}
+
+	if strings.HasPrefix(listPath, v2010Prefix) {
+		return map[string]interface{}{
+			c.key:               items,
+			"page":              0,
+			"page_size":         len(items),
+			"start":             0,
+			"end":               len(items),
+			"uri":               listPath + ".json",
+			"first_page_uri":    listPath + ".json",
+			"next_page_uri":     nil,
+			"previous_page_uri": nil,
+		}
+	}
+
+	return map[string]interface{}{
+		c.key: items,
+		"meta": map[string]interface{}{
+			"key":               c.key,
+			"page":              0,
+			"page_size":         len(items),
+			"url":               "https://twilio.com" + listPath,
+			"first_page_url":    "https://twilio.com" + listPath,
+			"next_page_url":     nil,
+			"previous_page_url": nil,
+		},
+	}
+}
+
+func matchesQuery(c *collection, rec record, query url.Values) bool {
+	for key, values := range query {
+		if key == "Page" || key == "PageSize" || key == "PageToken" || len(values) == 0 || values[0] == "" {
+			continue
+		}
+
+		value, ok := rec[c.fieldName(key)]
+		if !ok || fmt.Sprint(value) != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) get(product string, listPath string, sid string) record {
+	if t, ok := s.tables[product+listPath]; ok {
+		return t.items[sid]
+	}
+	return nil
+}
+
+func (s *Server) put(product string, listPath string, rec record) {
+	t, ok := s.tables[product+listPath]
+	if !ok {
+		t = &table{items: map[string]record{}}
+		s.tables[product+listPath] = t
+	}
+
+	sid := rec["sid"].(string)
+	if _, exists := t.items[sid]; !exists {
+		t.order = append(t.order, sid)
+	}
+	t.items[sid] = rec
+}
+
+// remove deletes the instance along with any child resources
+func (s *Server) remove(product string, listPath string, sid string) {
+	if t, ok := s.tables[product+listPath]; ok {
+		delete(t.items, sid)
+		for index, orderedSid := range t.order {
+			if orderedSid == sid {
+				t.order = append(t.order[:index], t.order[index+1:]...)
+				break
+			}
+		}
+	}
+
+	childPrefix := product + listPath + "/" + sid + "/"
+	for path := range s.tables {
+		if strings.HasPrefix(path, childPrefix) {
+			delete(s.tables, path)
+		}
+	}
+	for path := range s.singletons {
+		if strings.HasPrefix(path, childPrefix) {
+			delete(s.singletons, path)
+		}
+	}
+}
+
+func (s *Server) records(product string, listPath string) []record {
+	records := make([]record, 0)
+	if t, ok := s.tables[product+listPath]; ok {
+		for _, sid := range t.order {
+			records = append(records, t.items[sid])
+		}
+	}
+	return records
+}
+
+func readFile(fileHeader *multipart.FileHeader) (string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	return string(content), err
+}
+
+func (s *Server) newSid(prefix string) string {
+	s.counter++
+	return fmt.Sprintf("%s%032x", prefix, s.counter)
+}
+
+func (s *Server) now(path string) string {
+	if strings.HasPrefix(path, v2010Prefix) {
+		return time.Now().UTC().Format(time.RFC1123Z)
+	}
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func notFound(path string) *apiError {
+	return &apiError{
+		status:  http.StatusNotFound,
+		code:    20404,
+		message: fmt.Sprintf("The requested resource %s was not found", path),
+	}
+}
+
+func methodNotAllowed(method string) *apiError {
+	return &apiError{
+		status:  http.StatusMethodNotAllowed,
+		code:    20004,
+		message: fmt.Sprintf("Method %s not allowed", method),
+	}
+}
+
+func badRequest(code int, message string) *apiError {
+	return &apiError{
+		status:  http.StatusBadRequest,
+		code:    code,
+		message: message,
+	}
+}
+
+func errorBody(err *apiError) map[string]interface{} {
+	return map[string]interface{}{
+		"code":      err.code,
+		"message":   err.message,
+		"more_info": fmt.Sprintf("https://www.twilio.com/docs/errors/%d", err.code),
+		"status":    err.status,
+	}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, errorBody(err))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
@@ -0,0 +1,197 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const serverlessServicePath = "/v1/Services/{ServiceSid}"
+
+func (s *Server) registerServerless() {
+	serviceParent := map[string]string{
+		"ServiceSid": "service_sid",
+	}
+	environmentParent := map[string]string{
+		"ServiceSid":     "service_sid",
+		"EnvironmentSid": "environment_sid",
+	}
+
+	functionVersions := &collection{
+		pattern:   serverlessServicePath + "/Functions/{FunctionSid}/Versions",
+		key:       "function_versions",
+		sidPrefix: "ZN",
+		parents: map[string]string{
+			"ServiceSid":  "service_sid",
+			"FunctionSid": "function_sid",
+		},
+	}
+	assetVersions := &collection{
+		pattern:   serverlessServicePath + "/Assets/{AssetSid}/Versions",
+		key:       "asset_versions",
+		sidPrefix: "ZN",
+		parents: map[string]string{
+			"ServiceSid": "service_sid",
+			"AssetSid":   "asset_sid",
+		},
+	}
+
+	// Versions are uploaded via a separate host
+	s.collections["serverless-upload"] = []*collection{
+		functionVersions,
+		assetVersions,
+	}
+
+	s.routes["serverless"] = []route{
+		{method: http.MethodGet, pattern: serverlessServicePath + "/Builds/{BuildSid}/Status", handler: buildStatus},
+		{method: http.MethodGet, pattern: serverlessServicePath + "/Functions/{FunctionSid}/Versions/{Sid}/Content", handler: functionVersionContent},
+	}
+
+	s.collections["serverless"] = []*collection{
+		{
+			pattern:   "/v1/Services",
+			key:       "services",
+			sidPrefix: "ZS",
+			defaults: record{
+				"include_credentials": true,
+				"ui_editable":         false,
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				rec["domain_base"] = fmt.Sprintf("%s-%04d", rec["unique_name"], s.counter%10000)
+				return nil
+			},
+		},
+		{
+			pattern:   serverlessServicePath + "/Assets",
+			key:       "assets",
+			sidPrefix: "ZH",
+			parents:   serviceParent,
+		},
+		assetVersions,
+		{
+			pattern:   serverlessServicePath + "/Builds",
+			key:       "builds",
+			sidPrefix: "ZB",
+			parents:   serviceParent,
+			aliases: map[string]string{
+				"AssetVersions":    "asset_version_sids",
+				"FunctionVersions": "function_version_sids",
+			},
+			listFields: []string{"asset_version_sids", "function_version_sids"},
+			jsonFields: []string{"dependencies"},
+			defaults: record{
+				"dependencies": []interface{}{},
+				"runtime":      "node12",
+				"status":       "completed",
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				servicePath := "/v1/Services/" + params["ServiceSid"]
+				rec["asset_versions"] = s.findVersions(servicePath+"/Assets/", rec["asset_version_sids"])
+				rec["function_versions"] = s.findVersions(servicePath+"/Functions/", rec["function_version_sids"])
+				delete(rec, "asset_version_sids")
+				delete(rec, "function_version_sids")
+				return nil
+			},
+		},
+		{
+			pattern:   serverlessServicePath + "/Environments",
+			key:       "environments",
+			sidPrefix: "ZE",
+			parents:   serviceParent,
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				domainBase := "fake"
+				if service := s.get("serverless", "/v1/Services", params["ServiceSid"]); service != nil {
+					domainBase = fmt.Sprint(service["domain_base"])
+				}
+
+				domainName := domainBase + ".twil.io"
+				if domainSuffix, ok := rec["domain_suffix"].(string); ok && domainSuffix != "" {
+					domainName = fmt.Sprintf("%s-%s.twil.io", domainBase, domainSuffix)
+				}
+				rec["domain_name"] = domainName
+				return nil
+			},
+		},
+		{
+			pattern:   serverlessServicePath + "/Environments/{EnvironmentSid}/Deployments",
+			key:       "deployments",
+			sidPrefix: "ZD",
+			parents:   environmentParent,
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				if environment := s.get("serverless", "/v1/Services/"+params["ServiceSid"]+"/Environments", params["EnvironmentSid"]); environment != nil {
+					environment["build_sid"] = rec["build_sid"]
+				}
+				return nil
+			},
+		},
+		{
+			pattern:   serverlessServicePath + "/Environments/{EnvironmentSid}/Variables",
+			key:       "variables",
+			sidPrefix: "ZV",
+			parents:   environmentParent,
+		},
+		{
+			pattern:   serverlessServicePath + "/Functions",
+			key:       "functions",
+			sidPrefix: "ZH",
+			parents:   serviceParent,
+		},
+		functionVersions,
+	}
+}
+
+// findVersions looks up the version details for each version SID, so the details can be embedded in the build response
+func (s *Server) findVersions(pathPrefix string, sids interface{}) []interface{} {
+	versions := make([]interface{}, 0)
+
+	sidList, ok := sids.([]interface{})
+	if !ok {
+		return versions
+	}
+
+	for _, sid := range sidList {
+		for path, t := range s.tables {
+			if !strings.HasPrefix(path, "serverless"+pathPrefix) || !strings.HasSuffix(path, "/Versions") {
+				continue
+			}
+			if version, ok := t.items[sid.(string)]; ok {
+				versionCopy := copyRecord(version)
+				delete(versionCopy, "content")
+				versions = append(versions, versionCopy)
+			}
+		}
+	}
+	return versions
+}
+
+func buildStatus(s *Server, params map[string]string, form url.Values) (int, interface{}) {
+	build := s.get("serverless", "/v1/Services/"+params["ServiceSid"]+"/Builds", params["BuildSid"])
+	if build == nil {
+		return http.StatusNotFound, errorBody(notFound(params["BuildSid"]))
+	}
+
+	return http.StatusOK, map[string]interface{}{
+		"sid":         build["sid"],
+		"account_sid": build["account_sid"],
+		"service_sid": build["service_sid"],
+		"status":      build["status"],
+		"url":         fmt.Sprintf("%s/Status", build["url"]),
+	}
+}
+
+func functionVersionContent(s *Server, params map[string]string, form url.Values) (int, interface{}) {
+	version := s.get("serverless", "/v1/Services/"+params["ServiceSid"]+"/Functions/"+params["FunctionSid"]+"/Versions", params["Sid"])
+	if version == nil {
+		return http.StatusNotFound, errorBody(notFound(params["Sid"]))
+	}
+
+	return http.StatusOK, map[string]interface{}{
+		"sid":          version["sid"],
+		"account_sid":  version["account_sid"],
+		"service_sid":  version["service_sid"],
+		"function_sid": version["function_sid"],
+		"content":      version["content"],
+		"url":          fmt.Sprintf("%s/Content", version["url"]),
+	}
+}
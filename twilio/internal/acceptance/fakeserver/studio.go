@@ -0,0 +1,153 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const studioFlowPath = "/v2/Flows/{Sid}"
+
+func (s *Server) registerStudio() {
+	s.routes["studio"] = []route{
+		{method: http.MethodPost, pattern: "/v2/Flows/Validate", handler: validateFlow},
+		{method: http.MethodGet, pattern: studioFlowPath + "/Revisions", handler: flowRevisions},
+		{method: http.MethodGet, pattern: studioFlowPath + "/Revisions/{Revision}", handler: flowRevision},
+	}
+
+	s.collections["studio"] = []*collection{
+		{
+			pattern:    "/v2/Flows",
+			key:        "flows",
+			sidPrefix:  "FW",
+			jsonFields: []string{"definition"},
+			defaults: record{
+				"errors":   []interface{}{},
+				"revision": 1,
+				"valid":    true,
+				"warnings": []interface{}{},
+			},
+			onCreate: func(s *Server, rec record, params map[string]string) *apiError {
+				rec["webhook_url"] = "https://webhooks.twilio.com/v1/Accounts/" + AccountSid + "/Flows/" + rec["sid"].(string)
+				s.putFlowRevision(rec)
+				return nil
+			},
+			onUpdate: func(s *Server, rec record, params map[string]string) *apiError {
+				rec["revision"] = rec["revision"].(int) + 1
+				s.putFlowRevision(rec)
+				return nil
+			},
+		},
+	}
+}
+
+// putFlowRevision stores a copy of the flow each time a new revision is created, the revisions are removed along with the flow
+func (s *Server) putFlowRevision(rec record) {
+	listPath := "/v2/Flows/" + rec["sid"].(string) + "/Revisions"
+	t, ok := s.tables["studio"+listPath]
+	if !ok {
+		t = &table{items: map[string]record{}}
+		s.tables["studio"+listPath] = t
+	}
+
+	revision := strconv.Itoa(rec["revision"].(int))
+	revisionRecord := copyRecord(rec)
+	revisionRecord["url"] = "https://twilio.com" + listPath + "/" + revision
+
+	// Revisions are returned newest first
+	t.order = append([]string{revision}, t.order...)
+	t.items[revision] = revisionRecord
+}
+
+func flowRevisions(s *Server, params map[string]string, form url.Values) (int, interface{}) {
+	if s.get("studio", "/v2/Flows", params["Sid"]) == nil {
+		return http.StatusNotFound, errorBody(notFound("/v2/Flows/" + params["Sid"]))
+	}
+
+	listPath := "/v2/Flows/" + params["Sid"] + "/Revisions"
+	revisions := make([]interface{}, 0)
+	for _, rec := range s.records("studio", listPath) {
+		revisions = append(revisions, rec)
+	}
+
+	return http.StatusOK, map[string]interface{}{
+		"revisions": revisions,
+		"meta": map[string]interface{}{
+			"key":               "revisions",
+			"page":              0,
+			"page_size":         len(revisions),
+			"url":               "https://twilio.com" + listPath,
+			"first_page_url":    "https://twilio.com" + listPath,
+			"next_page_url":     nil,
+			"previous_page_url": nil,
+		},
+	}
+}
+
+func flowRevision(s *Server, params map[string]string, form url.Values) (int, interface{}) {
+	listPath := "/v2/Flows/" + params["Sid"] + "/Revisions"
+	rec := s.get("studio", listPath, params["Revision"])
+	if rec == nil {
+		return http.StatusNotFound, errorBody(notFound(listPath + "/" + params["Revision"]))
+	}
+	return http.StatusOK, rec
+}
+
+func validateFlow(s *Server, params map[string]string, form url.Values) (int, interface{}) {
+	if form.Get("FriendlyName") == "" || form.Get("Status") == "" || form.Get("Definition") == "" {
+		return http.StatusBadRequest, errorBody(badRequest(20001, "FriendlyName, Status and Definition are required"))
+	}
+
+	if validationErrors := validateFlowDefinition(form.Get("Definition")); len(validationErrors) > 0 {
+		body := errorBody(badRequest(20001, "Flow definition validation failed, check `details` for more information"))
+		body["details"] = map[string]interface{}{
+			"errors":   validationErrors,
+			"warnings": []interface{}{},
+		}
+		return http.StatusBadRequest, body
+	}
+
+	return http.StatusOK, map[string]interface{}{
+		"valid": true,
+	}
+}
+
+// validateFlowDefinition performs a subset of the structural checks which Twilio performs, returning the errors in the same format as Twilio
+func validateFlowDefinition(definition string) []interface{} {
+	validationErrors := make([]interface{}, 0)
+	addError := func(propertyPath string, message string) {
+		validationErrors = append(validationErrors, map[string]interface{}{
+			"message":       message,
+			"property_path": propertyPath,
+		})
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal([]byte(definition), &content); err != nil {
+		addError("#", "must be a JSON object")
+		return validationErrors
+	}
+
+	for _, property := range []string{"initial_state", "states"} {
+		if _, ok := content[property]; !ok {
+			addError("#", fmt.Sprintf("must have required property '%s'", property))
+		}
+	}
+
+	states, _ := content["states"].([]interface{})
+	for index, item := range states {
+		state, ok := item.(map[string]interface{})
+		if !ok {
+			addError(fmt.Sprintf("#/states/%d", index), "must be object")
+			continue
+		}
+		for _, property := range []string{"name", "type", "transitions", "properties"} {
+			if _, ok := state[property]; !ok {
+				addError(fmt.Sprintf("#/states/%d", index), fmt.Sprintf("must have required property '%s'", property))
+			}
+		}
+	}
+	return validationErrors
+}
@@ -0,0 +1,156 @@
+package fakeserver
+
+const workspacePath = "/v1/Workspaces/{WorkspaceSid}"
+
+func (s *Server) registerTaskRouter() {
+	workspaceParent := map[string]string{
+		"WorkspaceSid": "workspace_sid",
+	}
+
+	s.collections["taskrouter"] = []*collection{
+		{
+			pattern:   "/v1/Workspaces",
+			key:       "workspaces",
+			sidPrefix: "WS",
+			defaults: record{
+				"event_callback_url":     nil,
+				"events_filter":          nil,
+				"multi_task_enabled":     true,
+				"prioritize_queue_order": "FIFO",
+			},
+			onCreate: createWorkspaceDefaults,
+			onUpdate: func(s *Server, rec record, params map[string]string) *apiError {
+				activitiesPath := "/v1/Workspaces/" + rec["sid"].(string) + "/Activities"
+				for _, field := range []string{"default_activity", "timeout_activity"} {
+					if sid, ok := rec[field+"_sid"].(string); ok {
+						if activity := s.get("taskrouter", activitiesPath, sid); activity != nil {
+							rec[field+"_name"] = activity["friendly_name"]
+						}
+					}
+				}
+				return nil
+			},
+		},
+		{
+			pattern:   workspacePath + "/Activities",
+			key:       "activities",
+			sidPrefix: "WA",
+			parents:   workspaceParent,
+			defaults: record{
+				"available": false,
+			},
+		},
+		{
+			pattern:   workspacePath + "/TaskChannels",
+			key:       "channels",
+			sidPrefix: "TC",
+			parents:   workspaceParent,
+			defaults: record{
+				"channel_optimized_routing": false,
+			},
+		},
+		{
+			pattern:   workspacePath + "/TaskQueues",
+			key:       "task_queues",
+			sidPrefix: "WQ",
+			parents:   workspaceParent,
+			intFields: []string{"max_reserved_workers"},
+			defaults: record{
+				"max_reserved_workers": 1,
+				"target_workers":       "1==1",
+				"task_order":           "FIFO",
+			},
+			onCreate: setTaskQueueActivityNames,
+			onUpdate: setTaskQueueActivityNames,
+		},
+		{
+			pattern:   workspacePath + "/Workers",
+			key:       "workers",
+			sidPrefix: "WK",
+			parents:   workspaceParent,
+			defaults: record{
+				"attributes": "{}",
+			},
+			onCreate: setWorkerActivity,
+			onUpdate: setWorkerActivity,
+		},
+		{
+			pattern:   workspacePath + "/Workflows",
+			key:       "workflows",
+			sidPrefix: "WW",
+			parents:   workspaceParent,
+			intFields: []string{"task_reservation_timeout"},
+			defaults: record{
+				"task_reservation_timeout": 120,
+			},
+		},
+	}
+}
+
+// createWorkspaceDefaults creates the activities and task channels which Twilio provisions with every workspace
+func createWorkspaceDefaults(s *Server, rec record, params map[string]string) *apiError {
+	workspaceSid := rec["sid"].(string)
+	activitiesPath := "/v1/Workspaces/" + workspaceSid + "/Activities"
+	taskChannelsPath := "/v1/Workspaces/" + workspaceSid + "/TaskChannels"
+
+	activities := map[string]record{}
+	for _, activity := range []struct {
+		name      string
+		available bool
+	}{
+		{name: "Offline", available: false},
+		{name: "Available", available: true},
+		{name: "Unavailable", available: false},
+	} {
+		activityRecord := s.newRecord(&collection{}, map[string]string{}, activitiesPath, s.newSid("WA"))
+		activityRecord["workspace_sid"] = workspaceSid
+		activityRecord["friendly_name"] = activity.name
+		activityRecord["available"] = activity.available
+		s.put("taskrouter", activitiesPath, activityRecord)
+		activities[activity.name] = activityRecord
+	}
+
+	for _, uniqueName := range []string{"default", "voice", "chat", "sms", "video"} {
+		taskChannelRecord := s.newRecord(&collection{}, map[string]string{}, taskChannelsPath, s.newSid("TC"))
+		taskChannelRecord["workspace_sid"] = workspaceSid
+		taskChannelRecord["friendly_name"] = uniqueName
+		taskChannelRecord["unique_name"] = uniqueName
+		taskChannelRecord["channel_optimized_routing"] = false
+		s.put("taskrouter", taskChannelsPath, taskChannelRecord)
+	}
+
+	rec["default_activity_sid"] = activities["Offline"]["sid"]
+	rec["default_activity_name"] = activities["Offline"]["friendly_name"]
+	rec["timeout_activity_sid"] = activities["Offline"]["sid"]
+	rec["timeout_activity_name"] = activities["Offline"]["friendly_name"]
+	return nil
+}
+
+func setTaskQueueActivityNames(s *Server, rec record, params map[string]string) *apiError {
+	activitiesPath := "/v1/Workspaces/" + params["WorkspaceSid"] + "/Activities"
+	for _, field := range []string{"assignment_activity", "reservation_activity"} {
+		if sid, ok := rec[field+"_sid"].(string); ok {
+			if activity := s.get("taskrouter", activitiesPath, sid); activity != nil {
+				rec[field+"_name"] = activity["friendly_name"]
+			}
+		}
+	}
+	return nil
+}
+
+func setWorkerActivity(s *Server, rec record, params map[string]string) *apiError {
+	if _, ok := rec["activity_sid"].(string); !ok {
+		if workspace := s.get("taskrouter", "/v1/Workspaces", params["WorkspaceSid"]); workspace != nil {
+			rec["activity_sid"] = workspace["default_activity_sid"]
+		}
+	}
+
+	activitiesPath := "/v1/Workspaces/" + params["WorkspaceSid"] + "/Activities"
+	if sid, ok := rec["activity_sid"].(string); ok {
+		if activity := s.get("taskrouter", activitiesPath, sid); activity != nil {
+			rec["activity_name"] = activity["friendly_name"]
+			rec["available"] = activity["available"]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package acceptance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+// ResourcePrefix is prepended to the names of all resources created by the acceptance tests, so leaked resources can be found and removed by the test sweepers
+const ResourcePrefix = "tf-acc"
+
+// RandomName generates a random name for a resource created by an acceptance test
+func RandomName() string {
+	return fmt.Sprintf("%s-%s", ResourcePrefix, acctest.RandString(10))
+}
+
+// HasResourcePrefix returns whether the friendly or unique name was generated by RandomName.
+// The SDK returns names as either a string or a string pointer depending on whether the field is optional, so both are supported
+func HasResourcePrefix(name interface{}) bool {
+	switch value := name.(type) {
+	case string:
+		return strings.HasPrefix(value, ResourcePrefix+"-")
+	case *string:
+		return value != nil && strings.HasPrefix(*value, ResourcePrefix+"-")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,423 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+)
+
+type Mode string
+
+const (
+	// ModeRecord sends requests to Twilio and records the scrubbed interactions into the cassette
+	ModeRecord Mode = "record"
+	// ModeReplay serves the responses from the cassette, no requests are sent to Twilio
+	ModeReplay Mode = "replay"
+)
+
+// minSubstringSubstitutionLength is the minimum length of a recorded value before it is substituted when found within a longer value, this prevents short values being replaced unintentionally
+const minSubstringSubstitutionLength = 8
+
+// Recorder records the HTTP interactions of a test into a cassette and replays them on subsequent runs.
+// Only a single cassette can be in use at a time, so tests which use the recorder must not be run in parallel.
+//
+// Acceptance tests generate random names on each run, so when replaying, the differences between the recorded and actual
+// request parameters are learnt as the test progresses and are substituted into the recorded URLs and response bodies
+type Recorder struct {
+	Mode Mode
+
+	replacements  map[string]string
+	mutex         sync.Mutex
+	cassette      *Cassette
+	cassettePath  string
+	scrubber      *scrubber
+	substitutions map[string]string
+	lastReads     map[string]*Interaction
+}
+
+// New creates a recorder. The replacements are values (i.e. the account SID and auth token) which are replaced with fake values when recording
+func New(mode Mode, replacements map[string]string) (*Recorder, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("The recorder mode (%s) is not supported, valid modes are %s or %s", mode, ModeRecord, ModeReplay)
+	}
+
+	return &Recorder{
+		Mode:         mode,
+		replacements: replacements,
+	}, nil
+}
+
+// Start loads (replay) or creates (record) the cassette at the path
+func (r *Recorder) Start(name string, path string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cassette != nil {
+		return fmt.Errorf("The cassette (%s) is already in use, tests which use the recorder cannot be run in parallel", r.cassette.Name)
+	}
+
+	cassette := &Cassette{
+		Name:         name,
+		Interactions: make([]*Interaction, 0),
+	}
+	if r.Mode == ModeReplay {
+		loadedCassette, err := loadCassette(path)
+		if err != nil {
+			return err
+		}
+		cassette = loadedCassette
+	}
+
+	r.cassette = cassette
+	r.cassettePath = path
+	r.scrubber = newScrubber(r.replacements)
+	r.substitutions = make(map[string]string)
+	r.lastReads = make(map[string]*Interaction)
+	return nil
+}
+
+// Stop ejects the cassette. When recording and save is true the cassette is written to disk
+func (r *Recorder) Stop(save bool) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cassette == nil {
+		return nil
+	}
+
+	cassette := r.cassette
+	r.cassette = nil
+
+	if r.Mode == ModeReplay {
+		unused := 0
+		for _, interaction := range cassette.Interactions {
+			if !interaction.replayed {
+				unused++
+			}
+		}
+		if unused > 0 {
+			log.Printf("[DEBUG] %d interaction(s) were not replayed from the cassette (%s)", unused, r.cassettePath)
+		}
+		return nil
+	}
+
+	if !save {
+		return nil
+	}
+	return cassette.save(r.cassettePath)
+}
+
+// Wrap returns a transport which records or replays the requests. When recording, requests are sent using the supplied transport
+func (r *Recorder) Wrap(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &recorderTransport{
+		recorder:  r,
+		transport: transport,
+	}
+}
+
+type recorderTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		requestBody, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+
+		body = requestBody
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	if t.recorder.Mode == ModeReplay {
+		return t.recorder.replay(req, body)
+	}
+	return t.recorder.record(t.transport, req, body)
+}
+
+func (r *Recorder) record(transport http.RoundTripper, req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Requests made outside of a test (i.e. by a test sweeper) are not recorded
+	if r.cassette == nil {
+		return resp, nil
+	}
+
+	requestContentType := req.Header.Get("Content-Type")
+	responseContentType := resp.Header.Get("Content-Type")
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: Request{
+			Method:      req.Method,
+			URL:         r.scrubber.scrub(req.URL.String()),
+			ContentType: requestContentType,
+			Body:        r.scrubber.scrub(common.RedactBody(requestContentType, body)),
+		},
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: responseContentType,
+			Body:        r.scrubber.scrub(common.RedactBody(responseContentType, responseBody)),
+		},
+	})
+	return resp, nil
+}
+
+// replay serves the recorded response of the interaction which best matches the request.
+//
+// Terraform may read a resource a different number of times than when the cassette was recorded, so interactions are matched in sequence for each resource:
+//   - a read is not matched to a recorded read which happened after a write (i.e. update or delete) to the same resource which has not yet been replayed
+//   - when a write is replayed, any recorded reads of the resource before the write which were not replayed are skipped
+//   - when there are no recorded reads left for the current state of the resource, the last read of the resource is replayed again
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("No cassette has been loaded to replay %s %s", req.Method, req.URL.String())
+	}
+
+	actualParams := requestParams(req.URL, req.Header.Get("Content-Type"), body)
+
+	var match *Interaction
+	matchIndex := -1
+	matchScore := -1
+	for index, interaction := range r.cassette.Interactions {
+		if interaction.replayed || interaction.Request.Method != req.Method {
+			continue
+		}
+
+		recordedURL, err := url.Parse(r.substitute(interaction.Request.URL))
+		if err != nil || recordedURL.Host != req.URL.Host || recordedURL.Path != req.URL.Path {
+			continue
+		}
+
+		if isRead(req.Method) && r.hasPendingWrite(index, recordedURL) {
+			continue
+		}
+
+		// When multiple interactions match the method & URL, i.e. creating multiple resources of the same type, the interaction with the most matching parameters is used
+		score := 0
+		recordedParams := requestParams(recordedURL, interaction.Request.ContentType, []byte(interaction.Request.Body))
+		for key, values := range recordedParams {
+			if r.substitute(strings.Join(values, ",")) == strings.Join(actualParams[key], ",") {
+				score++
+			}
+		}
+		if score > matchScore {
+			match = interaction
+			matchIndex = index
+			matchScore = score
+		}
+	}
+
+	if match == nil && isRead(req.Method) {
+		match = r.lastReads[resourceKey(req.URL)]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("No interaction was found in the cassette (%s) for %s %s", r.cassettePath, req.Method, req.URL.String())
+	}
+
+	if !match.replayed {
+		match.replayed = true
+
+		recordedURL, _ := url.Parse(match.Request.URL)
+		r.learnSubstitutions(requestParams(recordedURL, match.Request.ContentType, []byte(match.Request.Body)), actualParams)
+
+		if isRead(req.Method) {
+			r.lastReads[resourceKey(req.URL)] = match
+		} else {
+			r.skipStaleReads(matchIndex, req.URL)
+		}
+	}
+
+	header := http.Header{}
+	if match.Response.ContentType != "" {
+		header.Set("Content-Type", match.Response.ContentType)
+	}
+
+	responseBody := r.substituteBody(match.Response.Body)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, nil
+}
+
+// hasPendingWrite returns whether a write to the resource was recorded before the interaction at the index and has not yet been replayed
+func (r *Recorder) hasPendingWrite(index int, resourceURL *url.URL) bool {
+	for _, interaction := range r.cassette.Interactions[:index] {
+		if interaction.replayed || isRead(interaction.Request.Method) {
+			continue
+		}
+		if recordedURL, err := url.Parse(r.substitute(interaction.Request.URL)); err == nil && resourceKey(recordedURL) == resourceKey(resourceURL) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipStaleReads marks the reads of the resource which were recorded before the write at the index as replayed, as the responses no longer reflect the state of the resource
+func (r *Recorder) skipStaleReads(index int, resourceURL *url.URL) {
+	for _, interaction := range r.cassette.Interactions[:index] {
+		if interaction.replayed || !isRead(interaction.Request.Method) {
+			continue
+		}
+		if recordedURL, err := url.Parse(r.substitute(interaction.Request.URL)); err == nil && resourceKey(recordedURL) == resourceKey(resourceURL) {
+			interaction.replayed = true
+		}
+	}
+	delete(r.lastReads, resourceKey(resourceURL))
+}
+
+func isRead(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func resourceKey(resourceURL *url.URL) string {
+	return resourceURL.Host + resourceURL.Path
+}
+
+// learnSubstitutions records the parameter values which differ between the recorded and actual request, so they can be substituted into subsequent interactions
+func (r *Recorder) learnSubstitutions(recordedParams url.Values, actualParams url.Values) {
+	for key, recordedValues := range recordedParams {
+		actualValues, ok := actualParams[key]
+		if !ok || len(actualValues) != len(recordedValues) {
+			continue
+		}
+
+		for index, recordedValue := range recordedValues {
+			actualValue := actualValues[index]
+			if recordedValue == "" || recordedValue == actualValue || strings.Contains(recordedValue, "[REDACTED]") {
+				continue
+			}
+			r.substitutions[recordedValue] = actualValue
+		}
+	}
+}
+
+// substitute replaces recorded values which have changed since the cassette was recorded
+func (r *Recorder) substitute(value string) string {
+	if substitution, ok := r.substitutions[value]; ok {
+		return substitution
+	}
+
+	for _, recordedValue := range r.substitutionKeys() {
+		if len(recordedValue) < minSubstringSubstitutionLength {
+			continue
+		}
+		value = strings.ReplaceAll(value, recordedValue, r.substitutions[recordedValue])
+		if escapedValue := url.PathEscape(recordedValue); escapedValue != recordedValue {
+			value = strings.ReplaceAll(value, escapedValue, url.PathEscape(r.substitutions[recordedValue]))
+		}
+	}
+	return value
+}
+
+// substituteBody replaces recorded values in each of the string values of a JSON body
+func (r *Recorder) substituteBody(body string) string {
+	if len(r.substitutions) == 0 || body == "" {
+		return body
+	}
+
+	var content interface{}
+	if err := json.Unmarshal([]byte(body), &content); err != nil {
+		return body
+	}
+
+	substitutedBody, err := json.Marshal(r.substituteJSON(content))
+	if err != nil {
+		return body
+	}
+	return string(substitutedBody)
+}
+
+func (r *Recorder) substituteJSON(content interface{}) interface{} {
+	switch value := content.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range value {
+			value[key] = r.substituteJSON(fieldValue)
+		}
+		return value
+	case []interface{}:
+		for index, item := range value {
+			value[index] = r.substituteJSON(item)
+		}
+		return value
+	case string:
+		return r.substitute(value)
+	default:
+		return value
+	}
+}
+
+// substitutionKeys returns the recorded values longest first, so values which contain other values are substituted correctly
+func (r *Recorder) substitutionKeys() []string {
+	keys := make([]string, 0, len(r.substitutions))
+	for key := range r.substitutions {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+	return keys
+}
+
+// requestParams combines the query string and form body parameters of a request
+func requestParams(requestURL *url.URL, contentType string, body []byte) url.Values {
+	params := url.Values{}
+	if requestURL != nil {
+		for key, values := range requestURL.Query() {
+			params[key] = append(params[key], values...)
+		}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" && len(body) > 0 {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for key, values := range form {
+				params[key] = append(params[key], values...)
+			}
+		}
+	}
+	return params
+}
@@ -0,0 +1,75 @@
+package recorder
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var sidRegex = regexp.MustCompile(`\b[A-Z]{2}[0-9a-f]{32}\b`)
+
+// minReplacementLength prevents short values (i.e. ISO country codes) from being replaced within unrelated values. Short values are instead substituted when the cassette is replayed
+const minReplacementLength = 6
+
+// scrubber replaces identifying values in the recorded interactions, so cassettes can be committed to version control.
+// Known values (i.e. the account SID & auth token) are replaced with the supplied fake values and all other SIDs are replaced with sequential fake SIDs.
+// The same scrubber is used for every interaction in a cassette so the scrubbed SIDs are consistent across requests
+type scrubber struct {
+	replacements []replacement
+	sids         map[string]string
+	counter      int
+}
+
+type replacement struct {
+	value    string
+	scrubbed string
+}
+
+func newScrubber(replacements map[string]string) *scrubber {
+	s := &scrubber{
+		replacements: make([]replacement, 0),
+		sids:         make(map[string]string),
+	}
+
+	for value, scrubbed := range replacements {
+		if len(value) < minReplacementLength {
+			continue
+		}
+		// SIDs which are already fake (i.e. when recording against the fake server) are still registered, so they are not replaced with a sequential SID
+		if sidRegex.MatchString(value) && sidRegex.MatchString(scrubbed) {
+			s.sids[value] = scrubbed
+			continue
+		}
+		if value == scrubbed {
+			continue
+		}
+		s.replacements = append(s.replacements, replacement{
+			value:    value,
+			scrubbed: scrubbed,
+		})
+	}
+
+	// Replace the longest values first so values which contain other values are scrubbed correctly
+	sort.Slice(s.replacements, func(i, j int) bool {
+		return len(s.replacements[i].value) > len(s.replacements[j].value)
+	})
+	return s
+}
+
+func (s *scrubber) scrub(value string) string {
+	for _, replacement := range s.replacements {
+		value = strings.ReplaceAll(value, replacement.value, replacement.scrubbed)
+	}
+
+	return sidRegex.ReplaceAllStringFunc(value, func(sid string) string {
+		if scrubbedSid, ok := s.sids[sid]; ok {
+			return scrubbedSid
+		}
+
+		s.counter++
+		scrubbedSid := fmt.Sprintf("%s%032x", sid[:2], s.counter)
+		s.sids[sid] = scrubbedSid
+		return scrubbedSid
+	})
+}
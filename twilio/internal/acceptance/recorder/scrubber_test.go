@@ -0,0 +1,49 @@
+package recorder
+
+import (
+	"testing"
+)
+
+func TestScrubberReplacements(t *testing.T) {
+	s := newScrubber(map[string]string{
+		"ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa": "AC00000000000000000000000000000000",
+		"real-auth-token-value":              "fake-auth-token-value",
+		"GB":                                 "US",
+	})
+
+	scrubbed := s.scrub("/Accounts/ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa/Keys?token=real-auth-token-value&IsoCountry=GB")
+	expected := "/Accounts/AC00000000000000000000000000000000/Keys?token=fake-auth-token-value&IsoCountry=GB"
+	if scrubbed != expected {
+		t.Errorf("Expected %q, got %q", expected, scrubbed)
+	}
+}
+
+func TestScrubberSequentialSids(t *testing.T) {
+	s := newScrubber(map[string]string{})
+
+	first := s.scrub("MGbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	second := s.scrub("ZSbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	repeated := s.scrub(`{"sid":"MGbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}`)
+
+	if first != "MG00000000000000000000000000000001" {
+		t.Errorf("Expected the first SID to be replaced with MG00000000000000000000000000000001, got %q", first)
+	}
+	if second != "ZS00000000000000000000000000000002" {
+		t.Errorf("Expected the second SID to be replaced with ZS00000000000000000000000000000002, got %q", second)
+	}
+	if repeated != `{"sid":"MG00000000000000000000000000000001"}` {
+		t.Errorf("Expected the same SID to be replaced consistently, got %q", repeated)
+	}
+}
+
+func TestScrubberRetainsFakeSids(t *testing.T) {
+	s := newScrubber(map[string]string{
+		"AC00000000000000000000000000000000": "AC00000000000000000000000000000000",
+	})
+
+	scrubbed := s.scrub("/Accounts/AC00000000000000000000000000000000/SIP/CredentialLists/CLcccccccccccccccccccccccccccccccc.json")
+	expected := "/Accounts/AC00000000000000000000000000000000/SIP/CredentialLists/CL00000000000000000000000000000001.json"
+	if scrubbed != expected {
+		t.Errorf("Expected %q, got %q", expected, scrubbed)
+	}
+}
@@ -0,0 +1,105 @@
+package acceptance
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/timworks/terraform-provider-twilio/twilio"
+)
+
+// UpgradeState runs the state upgraders of the resource against a state fixture, starting from the schema version of the fixture through to the current schema version of the resource
+func UpgradeState(t *testing.T, resourceType string, version int, rawState map[string]interface{}) map[string]interface{} {
+	resource, ok := twilio.Provider().ResourcesMap[resourceType]
+	if !ok {
+		t.Fatalf("The resource (%s) is not registered with the provider", resourceType)
+	}
+
+	if version >= resource.SchemaVersion {
+		t.Fatalf("The fixture version (%d) must be lower than the schema version (%d) of %s", version, resource.SchemaVersion, resourceType)
+	}
+
+	// The fixture is copied so the same fixture can be used by multiple tests
+	state := copyState(t, rawState)
+	for _, upgrader := range resource.StateUpgraders {
+		if upgrader.Version < version {
+			continue
+		}
+
+		if upgrader.Type.IsObjectType() {
+			for name := range state {
+				if name != "id" && name != "timeouts" && !upgrader.Type.HasAttribute(name) {
+					t.Fatalf("The fixture attribute (%s) is not defined in version %d of the %s schema", name, upgrader.Version, resourceType)
+				}
+			}
+		}
+
+		upgradedState, err := upgrader.Upgrade(context.Background(), state, nil)
+		if err != nil {
+			t.Fatalf("Failed to upgrade %s state from version %d: %s", resourceType, upgrader.Version, err.Error())
+		}
+		state = upgradedState
+	}
+	return state
+}
+
+// CheckUpgradedState upgrades the state fixture and compares the upgraded state with the expected state.
+// Both states are compared in the form they are decoded from JSON, so numbers can be supplied as any numeric type
+func CheckUpgradedState(t *testing.T, resourceType string, version int, rawState map[string]interface{}, expected map[string]interface{}) {
+	actual := copyState(t, UpgradeState(t, resourceType, version, rawState))
+	expected = copyState(t, expected)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Unexpected upgraded %s state\n\nExpected: %#v\n\nActual: %#v", resourceType, expected, actual)
+	}
+}
+
+func copyState(t *testing.T, rawState map[string]interface{}) map[string]interface{} {
+	content, err := json.Marshal(rawState)
+	if err != nil {
+		t.Fatalf("Failed to copy state fixture: %s", err.Error())
+	}
+
+	var state map[string]interface{}
+	if err := json.Unmarshal(content, &state); err != nil {
+		t.Fatalf("Failed to copy state fixture: %s", err.Error())
+	}
+	return state
+}
+
+// ConfigureProvider returns a new provider which has been configured with the supplied arguments, any arguments which are not supplied are read from the same environment variables as the acceptance tests
+func ConfigureProvider(t *testing.T, config map[string]interface{}) *schema.Provider {
+	provider := twilio.Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("Failed to configure the provider: %v", diags)
+	}
+	return provider
+}
+
+// DestroyState runs the delete function of the resource against a state fixture, so destroying existing (i.e. upgraded) state can be tested without the state being created by Terraform
+func DestroyState(t *testing.T, provider *schema.Provider, resourceType string, rawState map[string]interface{}) diag.Diagnostics {
+	resource, ok := provider.ResourcesMap[resourceType]
+	if !ok {
+		t.Fatalf("The resource (%s) is not registered with the provider", resourceType)
+	}
+
+	content, err := json.Marshal(rawState)
+	if err != nil {
+		t.Fatalf("Failed to encode state fixture: %s", err.Error())
+	}
+
+	value, err := ctyjson.Unmarshal(content, resource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Failed to decode %s state fixture: %s", resourceType, err.Error())
+	}
+
+	state, err := resource.ShimInstanceStateFromValue(value)
+	if err != nil {
+		t.Fatalf("Failed to decode %s state fixture: %s", resourceType, err.Error())
+	}
+	return resource.DeleteContext(context.Background(), resource.Data(state), provider.Meta())
+}
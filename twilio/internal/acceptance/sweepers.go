@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/timworks/terraform-provider-twilio/twilio"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+)
+
+// SweeperClient configures a Twilio client using the provider environment variables (i.e. TWILIO_ACCOUNT_SID & TWILIO_AUTH_TOKEN) for use by the test sweepers.
+// The region is the value supplied to the -sweep flag, i.e. us1 or ie1
+func SweeperClient(region string) (*common.TwilioClient, error) {
+	config := map[string]interface{}{}
+	if region != "" {
+		config["region"] = region
+	}
+
+	provider := twilio.Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		messages := make([]string, 0)
+		for _, diagnostic := range diags {
+			messages = append(messages, diagnostic.Summary)
+		}
+		return nil, fmt.Errorf("Failed to configure sweeper client: %s", strings.Join(messages, ", "))
+	}
+
+	return provider.Meta().(*common.TwilioClient), nil
+}
+
+// SweepErrors combines the errors which occurred whilst sweeping, so a single failure does not prevent the remaining resources from being swept
+type SweepErrors []error
+
+func (e *SweepErrors) Add(resourceType string, id string, err error) {
+	log.Printf("[ERROR] Failed to sweep %s (%s): %s", resourceType, id, err.Error())
+	*e = append(*e, fmt.Errorf("Failed to sweep %s (%s): %s", resourceType, id, err.Error()))
+}
+
+func (e SweepErrors) ErrorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0)
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Errorf("%d error(s) occurred:\n%s", len(e), strings.Join(messages, "\n"))
+}
+
+// LogSweep logs the resource which is about to be swept
+func LogSweep(resourceType string, id string, name interface{}) {
+	if value, ok := name.(*string); ok && value != nil {
+		name = *value
+	}
+	log.Printf("[INFO] Sweeping %s %s (%v)", resourceType, id, name)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..")
+
+	files, err := generate(dir)
+	if err != nil {
+		t.Fatalf("Failed to generate files: %s", err.Error())
+	}
+
+	for path, content := range files {
+		existing, err := os.ReadFile(filepath.Join(dir, path))
+		if content == nil {
+			if err == nil {
+				t.Errorf("%s is no longer generated, run `make generate` to remove it", path)
+			}
+			continue
+		}
+		if err != nil || !bytes.Equal(existing, content) {
+			t.Errorf("%s is out of date, run `make generate` to update it", path)
+		}
+	}
+}
+
+func TestHandWrittenFunctionsOverrideGeneratedFunctions(t *testing.T) {
+	dir := t.TempDir()
+	handWritten := "package utils\n\nfunc MessagingServiceSidValidation() {}\n"
+	if err := os.WriteFile(filepath.Join(dir, "validation.go"), []byte(handWritten), 0644); err != nil {
+		t.Fatalf("Failed to write file: %s", err.Error())
+	}
+
+	files := map[string][]byte{}
+	funcs := []generatedFunc{
+		{Name: "MessagingServiceSidValidation", Code: "func MessagingServiceSidValidation() {}\n"},
+		{Name: "MessagingAlphaSenderSidValidation", Code: "func MessagingAlphaSenderSidValidation() {}\n"},
+	}
+	if err := addFile(files, dir, "validation_gen.go", "utils", funcs, nil); err != nil {
+		t.Fatalf("Failed to add file: %s", err.Error())
+	}
+
+	content := string(files["validation_gen.go"])
+	if strings.Contains(content, "func MessagingServiceSidValidation") {
+		t.Errorf("Expected the hand written MessagingServiceSidValidation to override the generated function, got %s", content)
+	}
+	if !strings.Contains(content, "func MessagingAlphaSenderSidValidation") {
+		t.Errorf("Expected MessagingAlphaSenderSidValidation to be generated, got %s", content)
+	}
+
+	funcs = funcs[:1]
+	if err := addFile(files, dir, "validation_gen.go", "utils", funcs, nil); err != nil {
+		t.Fatalf("Failed to add file: %s", err.Error())
+	}
+	if files["validation_gen.go"] != nil {
+		t.Errorf("Expected the file to be removed when all of the functions are overridden")
+	}
+}
+
+func TestNameConversion(t *testing.T) {
+	testCases := []struct {
+		parameter string
+		snakeCase string
+		goName    string
+	}{
+		{parameter: "FriendlyName", snakeCase: "friendly_name", goName: "FriendlyName"},
+		{parameter: "InboundRequestUrl", snakeCase: "inbound_request_url", goName: "InboundRequestURL"},
+		{parameter: "StatusCallback", snakeCase: "status_callback", goName: "StatusCallback"},
+		{parameter: "Usecase", snakeCase: "usecase", goName: "Usecase"},
+		{parameter: "VoiceFallbackURL", snakeCase: "voice_fallback_url", goName: "VoiceFallbackURL"},
+		{parameter: "Sip2Enabled", snakeCase: "sip2_enabled", goName: "Sip2Enabled"},
+	}
+
+	for _, testCase := range testCases {
+		if snakeCase := toSnakeCase(testCase.parameter); snakeCase != testCase.snakeCase {
+			t.Errorf("Expected %s to be converted to %s, got %s", testCase.parameter, testCase.snakeCase, snakeCase)
+		}
+		if goName := toGoName(testCase.snakeCase); goName != testCase.goName {
+			t.Errorf("Expected %s to be converted to %s, got %s", testCase.snakeCase, testCase.goName, goName)
+		}
+	}
+}
+
+func TestSdkPath(t *testing.T) {
+	path, pathParameter, err := sdkPath("/v1/Services/{Sid}")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if path != "/Services/{Sid}" {
+		t.Errorf("Expected the API version to be removed from the path, got %s", path)
+	}
+	if pathParameter != "Sid" {
+		t.Errorf("Expected the path parameter to be Sid, got %s", pathParameter)
+	}
+
+	for _, invalidPath := range []string{"/Services", "/v1/Services", "/v1/Services/{ServiceSid}/Numbers/{Sid}"} {
+		if _, _, err := sdkPath(invalidPath); err == nil {
+			t.Errorf("Expected an error for the path %s", invalidPath)
+		}
+	}
+}
+
+func TestRenderRaw(t *testing.T) {
+	r := resource{Name: "MessagingService", UpdatePath: "/v1/Services/{Sid}"}
+	attributes := []*attribute{
+		{Name: "friendly_name", Field: "FriendlyName", Parameter: "FriendlyName", Property: "friendly_name", Type: "string", InResponse: true, Create: true, Update: true},
+		{Name: "usecase", Field: "Usecase", Parameter: "Usecase", Property: "usecase", Type: "string", InResponse: true, Create: true, Update: true, Raw: true},
+		{Name: "preconfigured", Field: "Preconfigured", Property: "preconfigured", Type: "boolean", InResponse: true, Raw: true},
+	}
+
+	funcs, err := renderRaw(r, attributes)
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	var code strings.Builder
+	for _, fn := range funcs {
+		code.WriteString(fn.Code)
+	}
+	content := code.String()
+
+	for _, expected := range []string{
+		"MessagingServiceRawInput",
+		`form:"Usecase,omitempty"`,
+		`json:"usecase,omitempty"`,
+		`json:"preconfigured,omitempty"`,
+		`"/Services/{Sid}"`,
+		"func FetchMessagingServiceRaw(",
+		"func UpdateMessagingServiceRaw(",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("Expected the raw functions to contain %s, got %s", expected, content)
+		}
+	}
+	if strings.Contains(content, "FriendlyName") {
+		t.Errorf("Expected attributes which are supported by twilio-sdk-go to be excluded, got %s", content)
+	}
+	if strings.Contains(content, `form:"Preconfigured`) {
+		t.Errorf("Expected read only attributes to be excluded from the input, got %s", content)
+	}
+
+	attributes[1].Update = false
+	if _, err := renderRaw(r, attributes); err == nil {
+		t.Error("Expected an error when an unsupported attribute cannot be updated")
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+// resource describes a resource which has the schema and expand/flatten helpers generated from an OpenAPI definition.
+// The generated code can be overridden by declaring a function with the same name in a hand written file in the same package
+type resource struct {
+	// Name is used to name the generated functions, i.e. `MessagingService` generates `resourceMessagingServiceSchema`
+	Name string
+	// Package is the name of the service package in `twilio/internal/services`
+	Package string
+	// Spec is the name of the OpenAPI definition in the specs directory
+	Spec string
+	// Component is the name of the schema component which describes the API response
+	Component string
+	// CreatePath & UpdatePath are the paths of the POST operations which create & update the resource
+	CreatePath string
+	UpdatePath string
+	// CreateInput, UpdateInput & FetchResponse are the twilio-sdk-go types in the `<import path>.<type>` format
+	CreateInput   string
+	UpdateInput   string
+	FetchResponse string
+	// SidValidation is the name of the validation function in the utils package which validates the SID of the resource
+	SidValidation string
+	// Exclude contains the attributes which are not managed by the provider
+	Exclude []string
+	// Unsupported contains the attributes which are not yet supported by twilio-sdk-go. These are sent & received using a raw client.Operation
+	// against the update path, so the API fields can be managed before they are added to the SDK
+	Unsupported []string
+	// Rename maps the API property names to the attribute names, where the provider uses a different name
+	Rename map[string]string
+	// Defaults & Validations contain Go expressions which are used as the default value & validation function of the attribute
+	Defaults    map[string]string
+	Validations map[string]string
+}
+
+// validator describes a SID validation function which is generated in the utils package from the pattern of the `sid` property of the component
+type validator struct {
+	Name      string
+	Section   string
+	Spec      string
+	Component string
+}
+
+const sdkModule = "github.com/timworks/twilio-sdk-go/service"
+
+var resources = []resource{
+	{
+		Name:          "MessagingService",
+		Package:       "messaging",
+		Spec:          "twilio_messaging_v1.json",
+		Component:     "messaging.v1.service",
+		CreatePath:    "/v1/Services",
+		UpdatePath:    "/v1/Services/{Sid}",
+		CreateInput:   sdkModule + "/messaging/v1/services.CreateServiceInput",
+		UpdateInput:   sdkModule + "/messaging/v1/service.UpdateServiceInput",
+		FetchResponse: sdkModule + "/messaging/v1/service.FetchServiceResponse",
+		SidValidation: "MessagingServiceSidValidation",
+		Exclude: []string{
+			"links",
+		},
+		Unsupported: []string{
+			"preconfigured",
+			"us_app_to_person_registered",
+			"usecase",
+		},
+		Rename: map[string]string{
+			"status_callback": "status_callback_url",
+		},
+		Defaults: map[string]string{
+			"area_code_geomatch":            "true",
+			"fallback_method":               `"POST"`,
+			"fallback_to_long_code":         "true",
+			"inbound_method":                `"POST"`,
+			"mms_converter":                 "true",
+			"smart_encoding":                "true",
+			"sticky_sender":                 "true",
+			"use_inbound_webhook_on_number": "false",
+			"validity_period":               "14400",
+		},
+		Validations: map[string]string{
+			"usecase":         `validation.StringInSlice([]string{"notifications", "marketing", "verification", "discussion", "poll", "undeclared"}, false)`,
+			"validity_period": "validation.IntBetween(1, 14400)",
+		},
+	},
+}
+
+var validators = []validator{
+	{
+		Name:      "MessagingAlphaSenderSidValidation",
+		Section:   "Messaging",
+		Spec:      "twilio_messaging_v1.json",
+		Component: "messaging.v1.service.alpha_sender",
+	},
+	{
+		Name:      "MessagingServiceSidValidation",
+		Section:   "Messaging",
+		Spec:      "twilio_messaging_v1.json",
+		Component: "messaging.v1.service",
+	},
+	{
+		Name:      "MessagingUsAppToPersonSidValidation",
+		Section:   "Messaging",
+		Spec:      "twilio_messaging_v1.json",
+		Component: "messaging.v1.service.us_app_to_person",
+	},
+}
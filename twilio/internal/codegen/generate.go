@@ -0,0 +1,496 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const header = "// Code generated by twilio/internal/codegen; DO NOT EDIT.\n\n"
+
+// attribute is a Terraform attribute which is generated from the properties of the API response and the create/update request bodies
+type attribute struct {
+	Name       string
+	Field      string
+	Parameter  string
+	Property   string
+	Type       string
+	Format     string
+	InResponse bool
+	Create     bool
+	Update     bool
+	Required   bool
+	Default    string
+	Validation string
+	// Raw is true when the attribute is not supported by twilio-sdk-go, see resource.Unsupported
+	Raw bool
+}
+
+// generatedFunc is a function which is generated, the code is only written when a hand written function with the same name does not exist
+type generatedFunc struct {
+	Name string
+	Code string
+}
+
+// generate returns the content of the generated files keyed by the path relative to the twilio directory. A nil value means the file is no longer required and should be removed
+func generate(dir string) (map[string][]byte, error) {
+	specs := map[string]*spec{}
+	loader := func(name string) (*spec, error) {
+		if definition, ok := specs[name]; ok {
+			return definition, nil
+		}
+		definition, err := loadSpec(filepath.Join(dir, "internal", "codegen", "specs", name))
+		if err != nil {
+			return nil, err
+		}
+		specs[name] = definition
+		return definition, nil
+	}
+
+	files := map[string][]byte{}
+	for _, r := range resources {
+		definition, err := loader(r.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes, err := buildAttributes(r, definition)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate %s: %s", r.Name, err.Error())
+		}
+
+		servicePath := filepath.Join("internal", "services", r.Package)
+		schemaFuncs, err := renderSchemaFuncs(r, attributes)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate %s schemas: %s", r.Name, err.Error())
+		}
+		if err := addFile(files, dir, filepath.Join(servicePath, toSnakeCase(r.Name)+"_gen.go"), r.Package, schemaFuncs, nil); err != nil {
+			return nil, err
+		}
+
+		helperFuncs, imports, err := renderHelperFuncs(r, attributes)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate %s helpers: %s", r.Name, err.Error())
+		}
+		if err := addFile(files, dir, filepath.Join(servicePath, "helper", toSnakeCase(r.Name)+"_gen.go"), "helper", helperFuncs, imports); err != nil {
+			return nil, err
+		}
+	}
+
+	// Overridden validators are skipped before rendering, so the section comment is added to the first generated validator in each section
+	declaredValidators, err := declaredFuncs(filepath.Join(dir, "utils"))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorFuncs := make([]generatedFunc, 0)
+	previousSection := ""
+	sortedValidators := append([]validator{}, validators...)
+	sort.SliceStable(sortedValidators, func(i, j int) bool {
+		if sortedValidators[i].Section != sortedValidators[j].Section {
+			return sortedValidators[i].Section < sortedValidators[j].Section
+		}
+		return sortedValidators[i].Name < sortedValidators[j].Name
+	})
+	for _, v := range sortedValidators {
+		if declaredValidators[v.Name] {
+			continue
+		}
+
+		definition, err := loader(v.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		code, err := renderValidator(v, definition, v.Section != previousSection)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate %s: %s", v.Name, err.Error())
+		}
+		previousSection = v.Section
+		validatorFuncs = append(validatorFuncs, generatedFunc{Name: v.Name, Code: code})
+	}
+	if err := addFile(files, dir, filepath.Join("utils", "validation_gen.go"), "utils", validatorFuncs, nil); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// addFile formats the generated functions which have not been overridden by a hand written function
+func addFile(files map[string][]byte, dir string, path string, packageName string, funcs []generatedFunc, imports []string) error {
+	declared, err := declaredFuncs(filepath.Join(dir, filepath.Dir(path)))
+	if err != nil {
+		return err
+	}
+
+	body := bytes.NewBuffer(nil)
+	for _, fn := range funcs {
+		if declared[fn.Name] {
+			continue
+		}
+		body.WriteString(fn.Code)
+		body.WriteString("\n")
+	}
+
+	if body.Len() == 0 {
+		files[path] = nil
+		return nil
+	}
+
+	content := bytes.NewBufferString(header)
+	fmt.Fprintf(content, "package %s\n\n", packageName)
+	content.WriteString("import (\n")
+	previousStandard := true
+	for _, importPath := range usedImports(body.String(), imports) {
+		if previousStandard && !isStandardImport(importPath) {
+			content.WriteString("\n")
+		}
+		previousStandard = isStandardImport(importPath)
+		fmt.Fprintf(content, "\t%q\n", importPath)
+	}
+	content.WriteString(")\n\n")
+	content.Write(body.Bytes())
+
+	formatted, err := format.Source(content.Bytes())
+	if err != nil {
+		return fmt.Errorf("Failed to format %s: %s", path, err.Error())
+	}
+	files[path] = formatted
+	return nil
+}
+
+var knownImports = map[string]string{
+	"context.":    "context",
+	"http.":       "net/http",
+	"regexp.":     "regexp",
+	"time.":       "time",
+	"client.":     "github.com/timworks/twilio-sdk-go/client",
+	"schema.":     "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema",
+	"utils.":      "github.com/timworks/terraform-provider-twilio/twilio/utils",
+	"validation.": "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation",
+}
+
+// usedImports returns the sorted imports which are referenced in the generated code, standard library imports are listed first
+func usedImports(code string, additional []string) []string {
+	imports := make([]string, 0)
+	for _, importPath := range additional {
+		if strings.Contains(code, filepath.Base(importPath)+".") {
+			imports = append(imports, importPath)
+		}
+	}
+	for prefix, importPath := range knownImports {
+		if strings.Contains(code, prefix) {
+			imports = append(imports, importPath)
+		}
+	}
+
+	sort.Slice(imports, func(i, j int) bool {
+		if isStandardImport(imports[i]) != isStandardImport(imports[j]) {
+			return isStandardImport(imports[i])
+		}
+		return imports[i] < imports[j]
+	})
+	return imports
+}
+
+func isStandardImport(importPath string) bool {
+	return !strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+func buildAttributes(r resource, definition *spec) ([]*attribute, error) {
+	component, err := definition.component(r.Component)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := map[string]bool{}
+	for _, name := range r.Exclude {
+		excluded[name] = true
+	}
+	unsupported := map[string]bool{}
+	for _, name := range r.Unsupported {
+		unsupported[name] = true
+	}
+
+	attributes := map[string]*attribute{}
+	addProperty := func(name string, property *schemaObject) (*attribute, error) {
+		if existing, ok := attributes[name]; ok {
+			return existing, nil
+		}
+
+		resolved, err := definition.resolve(property)
+		if err != nil {
+			return nil, err
+		}
+
+		attr := &attribute{
+			Name:       name,
+			Field:      toGoName(name),
+			Property:   name,
+			Raw:        unsupported[name],
+			Type:       resolved.Type,
+			Format:     resolved.Format,
+			Validation: inferValidation(resolved),
+		}
+		attributes[name] = attr
+		return attr, nil
+	}
+
+	for name, property := range component.Properties {
+		if excluded[name] {
+			continue
+		}
+		attr, err := addProperty(name, property)
+		if err != nil {
+			return nil, err
+		}
+		attr.InResponse = true
+	}
+
+	for _, operation := range []struct {
+		path   string
+		create bool
+	}{
+		{path: r.CreatePath, create: true},
+		{path: r.UpdatePath, create: false},
+	} {
+		if operation.path == "" {
+			continue
+		}
+
+		body, err := definition.requestBody(operation.path)
+		if err != nil {
+			return nil, err
+		}
+		for parameter, property := range body.Properties {
+			name := toSnakeCase(parameter)
+			if excluded[name] {
+				continue
+			}
+			attr, err := addProperty(name, property)
+			if err != nil {
+				return nil, err
+			}
+			attr.Parameter = parameter
+			if operation.create {
+				attr.Create = true
+				attr.Required = body.isRequired(parameter)
+			} else {
+				attr.Update = true
+			}
+		}
+	}
+
+	for name := range excluded {
+		if !hasProperty(component, name) {
+			return nil, fmt.Errorf("The excluded property (%s) does not exist", name)
+		}
+	}
+	for name := range unsupported {
+		if _, ok := attributes[name]; !ok {
+			return nil, fmt.Errorf("The unsupported property (%s) does not exist or has been excluded", name)
+		}
+	}
+	for _, overrides := range []map[string]string{r.Rename, r.Defaults, r.Validations} {
+		for name := range overrides {
+			if _, ok := attributes[name]; !ok {
+				return nil, fmt.Errorf("The property (%s) does not exist or has been excluded", name)
+			}
+		}
+	}
+
+	results := make([]*attribute, 0)
+	for name, attr := range attributes {
+		if _, err := attr.schemaType(); err != nil {
+			return nil, fmt.Errorf("The property (%s) is not supported: %s", name, err.Error())
+		}
+		if newName, ok := r.Rename[name]; ok {
+			attr.Name = newName
+		}
+		if value, ok := r.Defaults[name]; ok {
+			attr.Default = value
+		}
+		if value, ok := r.Validations[name]; ok {
+			attr.Validation = value
+		}
+		results = append(results, attr)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+	return results, nil
+}
+
+func hasProperty(component *schemaObject, name string) bool {
+	_, ok := component.Properties[name]
+	return ok
+}
+
+// inferValidation returns the validation function for the property based on the format & enum values.
+// Twilio only supports GET & POST when calling webhooks, so HTTP methods are restricted to these values
+func inferValidation(property *schemaObject) string {
+	switch {
+	case property.Format == "http-method":
+		return `validation.StringInSlice([]string{"POST", "GET"}, false)`
+	case property.Format == "uri":
+		return "validation.IsURLWithHTTPorHTTPS"
+	case len(property.Enum) > 0:
+		values := make([]string, 0)
+		for _, value := range property.Enum {
+			values = append(values, fmt.Sprintf("%q", value))
+		}
+		return fmt.Sprintf("validation.StringInSlice([]string{%s}, false)", strings.Join(values, ", "))
+	case property.Pattern != "":
+		return fmt.Sprintf("validation.StringMatch(regexp.MustCompile(%q), \"\")", property.Pattern)
+	}
+	return ""
+}
+
+func (a *attribute) schemaType() (string, error) {
+	switch a.Type {
+	case "string":
+		return "schema.TypeString", nil
+	case "boolean":
+		return "schema.TypeBool", nil
+	case "integer":
+		return "schema.TypeInt", nil
+	case "number":
+		return "schema.TypeFloat", nil
+	}
+	return "", fmt.Errorf("the type (%s) cannot be converted to a Terraform type", a.Type)
+}
+
+func (a *attribute) goType() string {
+	switch a.Type {
+	case "boolean":
+		return "bool"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	}
+	return "string"
+}
+
+// isComputed returns whether the attribute can only be read
+func (a *attribute) isComputed() bool {
+	return !a.Create && !a.Update
+}
+
+// isOptionalComputed returns whether Twilio may return a value for an optional attribute which does not have a default value.
+// URLs are cleared using an empty string instead, see expandValue
+func (a *attribute) isOptionalComputed() bool {
+	return !a.isComputed() && !a.Required && a.Default == "" && a.Format != "uri"
+}
+
+// isForceNew returns whether the attribute can only be set when the resource is created
+func (a *attribute) isForceNew() bool {
+	return a.Create && !a.Update
+}
+
+// validateFunc returns the validation function of the argument, required strings must not be empty
+func (a *attribute) validateFunc() string {
+	if a.Validation == "" && a.Required && a.Type == "string" {
+		return "validation.StringIsNotEmpty"
+	}
+	return a.Validation
+}
+
+func (a *attribute) expandValue(required bool) (string, error) {
+	if required {
+		return fmt.Sprintf("d.Get(%q).(%s)", a.Name, a.goType()), nil
+	}
+
+	switch a.Type {
+	case "string":
+		if a.Format == "uri" {
+			return fmt.Sprintf("utils.OptionalStringWithEmptyStringOnChange(d, %q)", a.Name), nil
+		}
+		return fmt.Sprintf("utils.OptionalString(d, %q)", a.Name), nil
+	case "boolean":
+		return fmt.Sprintf("utils.OptionalBool(d, %q)", a.Name), nil
+	case "integer":
+		return fmt.Sprintf("utils.OptionalInt(d, %q)", a.Name), nil
+	}
+	return "", fmt.Errorf("The optional argument (%s) of type (%s) cannot be expanded", a.Name, a.Type)
+}
+
+// rawType returns the Go type of the attribute in the raw input & response structs, pointers are used so unset values are omitted
+func (a *attribute) rawType() string {
+	if a.Format == "date-time" {
+		return "*time.Time"
+	}
+	return "*" + a.goType()
+}
+
+func (a *attribute) flattenValue() string {
+	if a.Format == "date-time" {
+		return fmt.Sprintf("utils.FormatTime(resp.%s)", a.Field)
+	}
+	return fmt.Sprintf("resp.%s", a.Field)
+}
+
+func resourceType(r resource) string {
+	return "twilio_" + toSnakeCase(r.Name)
+}
+
+// sdkPath returns the path of the operation relative to the base URL of the twilio-sdk-go client, which includes the API version
+// i.e. `/v1/Services/{Sid}` becomes `/Services/{Sid}`, and the name of the path parameter which identifies the resource
+func sdkPath(path string) (string, string, error) {
+	index := strings.Index(strings.TrimPrefix(path, "/"), "/")
+	if index == -1 {
+		return "", "", fmt.Errorf("The path (%s) does not contain an API version", path)
+	}
+	relativePath := path[index+1:]
+
+	parameters := pathParameterRegex.FindAllStringSubmatch(relativePath, -1)
+	if len(parameters) != 1 {
+		return "", "", fmt.Errorf("The path (%s) must contain a single path parameter", path)
+	}
+	return relativePath, parameters[0][1], nil
+}
+
+var pathParameterRegex = regexp.MustCompile(`\{(\w+)\}`)
+
+// sdkType returns the import path and the qualified type name of a twilio-sdk-go type
+func sdkType(value string) (string, string, error) {
+	index := strings.LastIndex(value, ".")
+	if index == -1 {
+		return "", "", fmt.Errorf("The type (%s) must be in the `<import path>.<type>` format", value)
+	}
+	importPath := value[:index]
+	return importPath, filepath.Base(importPath) + "." + value[index+1:], nil
+}
+
+var initialisms = map[string]string{
+	"id":  "ID",
+	"ip":  "IP",
+	"uri": "URI",
+	"url": "URL",
+}
+
+// toGoName converts a snake case property name into the name used by twilio-sdk-go, i.e. `inbound_request_url` becomes `InboundRequestURL`
+func toGoName(name string) string {
+	words := strings.Split(name, "_")
+	for i, word := range words {
+		if initialism, ok := initialisms[word]; ok {
+			words[i] = initialism
+			continue
+		}
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+var wordBoundaryRegex = regexp.MustCompile(`([a-z0-9])([A-Z])|([A-Z]+)([A-Z][a-z])`)
+
+// toSnakeCase converts a pascal case parameter name into a snake case attribute name, i.e. `InboundRequestUrl` becomes `inbound_request_url`
+func toSnakeCase(name string) string {
+	return strings.ToLower(wordBoundaryRegex.ReplaceAllString(name, "${1}${3}_${2}${4}"))
+}
@@ -0,0 +1,53 @@
+// Command codegen generates the resource schemas, expand/flatten helpers and SID validators from the vendored Twilio OpenAPI definitions in the specs directory.
+// The generated files have a `_gen.go` suffix and must not be edited, any function can be overridden by declaring a function with the same name in a hand written file in the same package.
+// The generator is run from the twilio directory using `go generate ./...` or `make generate`
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "Path to the twilio directory")
+	flag.Parse()
+
+	if err := run(*dir); err != nil {
+		fmt.Fprintf(os.Stderr, "codegen: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	files, err := generate(dir)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0)
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		filePath := filepath.Join(dir, path)
+		if files[path] == nil {
+			if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("Failed to remove file (%s): %s", filePath, err.Error())
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return fmt.Errorf("Failed to create directory (%s): %s", filepath.Dir(filePath), err.Error())
+		}
+		if err := os.WriteFile(filePath, files[path], 0644); err != nil {
+			return fmt.Errorf("Failed to write file (%s): %s", filePath, err.Error())
+		}
+	}
+	return nil
+}
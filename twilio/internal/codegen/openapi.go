@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// spec contains the parts of an OpenAPI definition which are used by the generator
+type spec struct {
+	Paths      map[string]pathItem `json:"paths"`
+	Components struct {
+		Schemas map[string]*schemaObject `json:"schemas"`
+	} `json:"components"`
+}
+
+type pathItem struct {
+	Post *operation `json:"post"`
+}
+
+type operation struct {
+	OperationID string `json:"operationId"`
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema *schemaObject `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+}
+
+type schemaObject struct {
+	Ref         string                   `json:"$ref"`
+	Type        string                   `json:"type"`
+	Format      string                   `json:"format"`
+	Pattern     string                   `json:"pattern"`
+	Enum        []string                 `json:"enum"`
+	Description string                   `json:"description"`
+	Properties  map[string]*schemaObject `json:"properties"`
+	Required    []string                 `json:"required"`
+}
+
+func loadSpec(path string) (*spec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read OpenAPI definition (%s): %s", path, err.Error())
+	}
+
+	var definition spec
+	if err := json.Unmarshal(content, &definition); err != nil {
+		return nil, fmt.Errorf("Failed to parse OpenAPI definition (%s): %s", filepath.Base(path), err.Error())
+	}
+	return &definition, nil
+}
+
+// component returns the schema of the component with the name, i.e. `messaging.v1.service`
+func (s *spec) component(name string) (*schemaObject, error) {
+	component, ok := s.Components.Schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("The component (%s) was not found", name)
+	}
+	return component, nil
+}
+
+// resolve returns the schema which is referenced by the `$ref` of the property, enums are defined as separate components in the Twilio definitions
+func (s *spec) resolve(property *schemaObject) (*schemaObject, error) {
+	if property.Ref == "" {
+		return property, nil
+	}
+
+	name := strings.TrimPrefix(property.Ref, "#/components/schemas/")
+	referenced, err := s.component(name)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := *referenced
+	if property.Description != "" {
+		resolved.Description = property.Description
+	}
+	return &resolved, nil
+}
+
+// requestBody returns the form encoded request body of the POST operation for the path, which is used to create or update a resource
+func (s *spec) requestBody(path string) (*schemaObject, error) {
+	item, ok := s.Paths[path]
+	if !ok || item.Post == nil {
+		return nil, fmt.Errorf("The POST operation for path (%s) was not found", path)
+	}
+	if item.Post.RequestBody == nil {
+		return nil, fmt.Errorf("The POST operation for path (%s) does not have a request body", path)
+	}
+
+	content, ok := item.Post.RequestBody.Content["application/x-www-form-urlencoded"]
+	if !ok || content.Schema == nil {
+		return nil, fmt.Errorf("The POST operation for path (%s) does not have a form encoded request body", path)
+	}
+	return content.Schema, nil
+}
+
+func (o *schemaObject) isRequired(name string) bool {
+	for _, required := range o.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
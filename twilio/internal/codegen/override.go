@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// declaredFuncs returns the names of the functions which are declared in the hand written files in the directory, so the generated functions can be overridden
+func declaredFuncs(dir string) (map[string]bool, error) {
+	declared := map[string]bool{}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return declared, nil
+		}
+		return nil, fmt.Errorf("Failed to read directory (%s): %s", dir, err.Error())
+	}
+
+	fileSet := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_gen.go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse file (%s): %s", filepath.Join(dir, name), err.Error())
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				declared[fn.Name.Name] = true
+			}
+		}
+	}
+	return declared, nil
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+var resourceSchemaTemplate = template.Must(template.New("resourceSchema").Parse(`
+// resource{{.Name}}Schema returns the schema of the {{.Type}} resource
+func resource{{.Name}}Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+{{- range .Attributes}}
+		"{{.Name}}": {
+			Type: {{.SchemaType}},
+{{- if .Computed}}
+			Computed: true,
+{{- else if .Required}}
+			Required: true,
+{{- else}}
+			Optional: true,
+{{- if .OptionalComputed}}
+			Computed: true,
+{{- end}}
+{{- if .Default}}
+			Default: {{.Default}},
+{{- end}}
+{{- end}}
+{{- if .ForceNew}}
+			ForceNew: true,
+{{- end}}
+{{- if .ValidateFunc}}
+			ValidateFunc: {{.ValidateFunc}},
+{{- end}}
+		},
+{{- end}}
+	}
+}
+`))
+
+var dataSourceSchemaTemplate = template.Must(template.New("dataSourceSchema").Parse(`
+// dataSource{{.Name}}Schema returns the schema of the {{.Type}} data source
+func dataSource{{.Name}}Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+{{- range .Attributes}}
+		"{{.Name}}": {
+			Type: {{.SchemaType}},
+{{- if eq .Name "sid"}}
+			Required: true,
+			ValidateFunc: utils.{{$.SidValidation}}(),
+{{- else}}
+			Computed: true,
+{{- end}}
+		},
+{{- end}}
+	}
+}
+`))
+
+var expandTemplate = template.Must(template.New("expand").Parse(`
+// Expand{{.Name}}{{.Operation}}Input returns the input which is used to {{.Verb}} the {{.Type}} resource
+func Expand{{.Name}}{{.Operation}}Input(d *schema.ResourceData) *{{.InputType}} {
+	return &{{.InputType}}{
+{{- range .Fields}}
+		{{.Field}}: {{.Value}},
+{{- end}}
+	}
+}
+`))
+
+var flattenTemplate = template.Must(template.New("flatten").Parse(`
+// Flatten{{.Name}} returns the attributes of the {{.Type}} resource, keyed by the attribute name
+func Flatten{{.Name}}(resp *{{.ResponseType}}) map[string]interface{} {
+	return map[string]interface{}{
+{{- range .Fields}}
+		"{{.Name}}": {{.Value}},
+{{- end}}
+	}
+}
+`))
+
+var rawTemplate = template.Must(template.New("raw").Parse(`
+{{- define "types"}}
+// {{.Name}}RawAttributes are the arguments of the {{.Type}} resource which are not yet supported by twilio-sdk-go
+var {{.Name}}RawAttributes = []string{
+{{- range .Inputs}}
+	"{{.Name}}",
+{{- end}}
+}
+
+// {{.Name}}RawInput contains the parameters of the {{.Type}} resource which are not yet supported by twilio-sdk-go
+type {{.Name}}RawInput struct {
+{{- range .Inputs}}
+	{{.Field}} {{.Type}} ` + "`" + `form:"{{.Tag}},omitempty"` + "`" + `
+{{- end}}
+}
+
+// {{.Name}}RawResponse contains the properties of the {{.Type}} resource which are not yet supported by twilio-sdk-go
+type {{.Name}}RawResponse struct {
+{{- range .Outputs}}
+	{{.Field}} {{.Type}} ` + "`" + `json:"{{.Tag}},omitempty"` + "`" + `
+{{- end}}
+}
+{{end}}
+
+{{- define "expand"}}
+// Expand{{.Name}}RawInput returns the input which is used to set the parameters of the {{.Type}} resource which are not yet supported by twilio-sdk-go
+func Expand{{.Name}}RawInput(d *schema.ResourceData) *{{.Name}}RawInput {
+	return &{{.Name}}RawInput{
+{{- range .Inputs}}
+		{{.Field}}: {{.Value}},
+{{- end}}
+	}
+}
+{{end}}
+
+{{- define "flatten"}}
+// Flatten{{.Name}}Raw returns the attributes of the {{.Type}} resource which are not yet supported by twilio-sdk-go, keyed by the attribute name
+func Flatten{{.Name}}Raw(resp *{{.Name}}RawResponse) map[string]interface{} {
+	return map[string]interface{}{
+{{- range .Outputs}}
+		"{{.Name}}": {{.Value}},
+{{- end}}
+	}
+}
+{{end}}
+
+{{- define "fetch"}}
+// Fetch{{.Name}}Raw retrieves the properties of the {{.Type}} resource which are not yet supported by twilio-sdk-go
+func Fetch{{.Name}}Raw(ctx context.Context, sdkClient *client.Client, sid string) (*{{.Name}}RawResponse, error) {
+	op := client.Operation{
+		Method: http.MethodGet,
+		URI:    "{{.Path}}",
+		PathParams: map[string]string{
+			"{{.PathParameter}}": sid,
+		},
+	}
+
+	response := &{{.Name}}RawResponse{}
+	if err := sdkClient.Send(ctx, op, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+{{end}}
+
+{{- define "update"}}
+// Update{{.Name}}Raw sets the parameters of the {{.Type}} resource which are not yet supported by twilio-sdk-go
+func Update{{.Name}}Raw(ctx context.Context, sdkClient *client.Client, sid string, input *{{.Name}}RawInput) (*{{.Name}}RawResponse, error) {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "{{.Path}}",
+		ContentType: client.URLEncoded,
+		PathParams: map[string]string{
+			"{{.PathParameter}}": sid,
+		},
+	}
+
+	response := &{{.Name}}RawResponse{}
+	if err := sdkClient.Send(ctx, op, input, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+{{end}}
+`))
+
+var validatorTemplate = template.Must(template.New("validator").Parse(`
+{{- if .FirstInSection}}
+// {{.Section}}
+{{end}}
+func {{.Name}}() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile({{printf "%q" .Pattern}}), "")
+}
+`))
+
+type schemaAttribute struct {
+	Name             string
+	SchemaType       string
+	Computed         bool
+	Required         bool
+	OptionalComputed bool
+	Default          string
+	ForceNew         bool
+	ValidateFunc     string
+}
+
+type fieldValue struct {
+	Name  string
+	Field string
+	Value string
+	Type  string
+	Tag   string
+}
+
+func renderSchemaFuncs(r resource, attributes []*attribute) ([]generatedFunc, error) {
+	resourceAttributes := make([]schemaAttribute, 0)
+	dataSourceAttributes := make([]schemaAttribute, 0)
+	for _, attr := range attributes {
+		schemaType, err := attr.schemaType()
+		if err != nil {
+			return nil, err
+		}
+
+		resourceAttribute := schemaAttribute{
+			Name:       attr.Name,
+			SchemaType: schemaType,
+			Computed:   attr.isComputed(),
+		}
+		if !resourceAttribute.Computed {
+			resourceAttribute.Required = attr.Required
+			resourceAttribute.OptionalComputed = attr.isOptionalComputed()
+			resourceAttribute.Default = attr.Default
+			resourceAttribute.ForceNew = attr.isForceNew()
+			resourceAttribute.ValidateFunc = attr.validateFunc()
+		}
+		resourceAttributes = append(resourceAttributes, resourceAttribute)
+
+		if attr.InResponse {
+			dataSourceAttributes = append(dataSourceAttributes, schemaAttribute{
+				Name:       attr.Name,
+				SchemaType: schemaType,
+			})
+		}
+	}
+
+	resourceSchema, err := render(resourceSchemaTemplate, map[string]interface{}{
+		"Name":       r.Name,
+		"Type":       resourceType(r),
+		"Attributes": resourceAttributes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := []generatedFunc{
+		{Name: "resource" + r.Name + "Schema", Code: resourceSchema},
+	}
+
+	if r.SidValidation != "" {
+		dataSourceSchema, err := render(dataSourceSchemaTemplate, map[string]interface{}{
+			"Name":          r.Name,
+			"Type":          resourceType(r),
+			"SidValidation": r.SidValidation,
+			"Attributes":    dataSourceAttributes,
+		})
+		if err != nil {
+			return nil, err
+		}
+		funcs = append(funcs, generatedFunc{Name: "dataSource" + r.Name + "Schema", Code: dataSourceSchema})
+	}
+	return funcs, nil
+}
+
+func renderHelperFuncs(r resource, attributes []*attribute) ([]generatedFunc, []string, error) {
+	imports := make([]string, 0)
+	addImport := func(importPath string) {
+		for _, existing := range imports {
+			if existing == importPath {
+				return
+			}
+		}
+		imports = append(imports, importPath)
+	}
+
+	funcs := make([]generatedFunc, 0)
+	for _, operation := range []struct {
+		name      string
+		verb      string
+		inputType string
+		create    bool
+	}{
+		{name: "Create", verb: "create", inputType: r.CreateInput, create: true},
+		{name: "Update", verb: "update", inputType: r.UpdateInput, create: false},
+	} {
+		if operation.inputType == "" {
+			continue
+		}
+
+		importPath, inputType, err := sdkType(operation.inputType)
+		if err != nil {
+			return nil, nil, err
+		}
+		addImport(importPath)
+
+		fields := make([]fieldValue, 0)
+		for _, attr := range attributes {
+			if attr.Raw || (operation.create && !attr.Create) || (!operation.create && !attr.Update) {
+				continue
+			}
+			value, err := attr.expandValue(operation.create && attr.Required)
+			if err != nil {
+				return nil, nil, err
+			}
+			fields = append(fields, fieldValue{Field: attr.Field, Value: value})
+		}
+
+		code, err := render(expandTemplate, map[string]interface{}{
+			"Name":      r.Name,
+			"Operation": operation.name,
+			"Verb":      operation.verb,
+			"Type":      resourceType(r),
+			"InputType": inputType,
+			"Fields":    fields,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		funcs = append(funcs, generatedFunc{Name: "Expand" + r.Name + operation.name + "Input", Code: code})
+	}
+
+	if r.FetchResponse != "" {
+		importPath, responseType, err := sdkType(r.FetchResponse)
+		if err != nil {
+			return nil, nil, err
+		}
+		addImport(importPath)
+
+		fields := make([]fieldValue, 0)
+		for _, attr := range attributes {
+			if attr.InResponse && !attr.Raw {
+				fields = append(fields, fieldValue{Name: attr.Name, Value: attr.flattenValue()})
+			}
+		}
+
+		code, err := render(flattenTemplate, map[string]interface{}{
+			"Name":         r.Name,
+			"Type":         resourceType(r),
+			"ResponseType": responseType,
+			"Fields":       fields,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		funcs = append(funcs, generatedFunc{Name: "Flatten" + r.Name, Code: code})
+	}
+
+	rawFuncs, err := renderRaw(r, attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(funcs, rawFuncs...), imports, nil
+}
+
+// renderRaw renders the input & response structs and the client.Operation requests which manage the attributes which are not yet supported by twilio-sdk-go.
+// Unsupported parameters are only set via the update operation, so they are also sent after the resource has been created
+func renderRaw(r resource, attributes []*attribute) ([]generatedFunc, error) {
+	inputs := make([]fieldValue, 0)
+	outputs := make([]fieldValue, 0)
+	for _, attr := range attributes {
+		if !attr.Raw {
+			continue
+		}
+		if attr.Create && !attr.Update {
+			return nil, fmt.Errorf("The unsupported property (%s) cannot be updated", attr.Property)
+		}
+		if attr.Update {
+			value, err := attr.expandValue(false)
+			if err != nil {
+				return nil, err
+			}
+			inputs = append(inputs, fieldValue{Name: attr.Name, Field: attr.Field, Value: value, Type: attr.rawType(), Tag: attr.Parameter})
+		}
+		if attr.InResponse {
+			outputs = append(outputs, fieldValue{Name: attr.Name, Field: attr.Field, Value: attr.flattenValue(), Type: attr.rawType(), Tag: attr.Property})
+		}
+	}
+
+	if len(inputs) == 0 && len(outputs) == 0 {
+		return nil, nil
+	}
+	if r.UpdatePath == "" {
+		return nil, fmt.Errorf("An update path is required to manage the unsupported properties")
+	}
+
+	path, pathParameter, err := sdkPath(r.UpdatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"Name":          r.Name,
+		"Type":          resourceType(r),
+		"Path":          path,
+		"PathParameter": pathParameter,
+		"Inputs":        inputs,
+		"Outputs":       outputs,
+	}
+
+	// The attributes and types are always generated, as only functions can be overridden
+	funcs := make([]generatedFunc, 0)
+	for _, fn := range []struct {
+		template string
+		name     string
+	}{
+		{template: "types"},
+		{template: "expand", name: "Expand" + r.Name + "RawInput"},
+		{template: "flatten", name: "Flatten" + r.Name + "Raw"},
+		{template: "fetch", name: "Fetch" + r.Name + "Raw"},
+		{template: "update", name: "Update" + r.Name + "Raw"},
+	} {
+		code, err := render(rawTemplate.Lookup(fn.template), data)
+		if err != nil {
+			return nil, err
+		}
+		funcs = append(funcs, generatedFunc{Name: fn.name, Code: code})
+	}
+	return funcs, nil
+}
+
+func renderValidator(v validator, definition *spec, firstInSection bool) (string, error) {
+	component, err := definition.component(v.Component)
+	if err != nil {
+		return "", err
+	}
+
+	sid, ok := component.Properties["sid"]
+	if !ok || sid.Pattern == "" {
+		return "", fmt.Errorf("The component (%s) does not have a sid property with a pattern", v.Component)
+	}
+
+	return render(validatorTemplate, map[string]interface{}{
+		"Name":           v.Name,
+		"Section":        v.Section,
+		"FirstInSection": firstInSection,
+		"Pattern":        sid.Pattern,
+	})
+}
+
+func render(tmpl *template.Template, data interface{}) (string, error) {
+	buffer := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
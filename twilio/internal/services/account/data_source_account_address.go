@@ -26,7 +26,8 @@ func dataSourceAccountAddress() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"customer_name": {
@@ -88,7 +89,7 @@ func dataSourceAccountAddress() *schema.Resource {
 func dataSourceAccountAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Address(sid).FetchWithContext(ctx)
 	if err != nil {
@@ -96,7 +97,7 @@ func dataSourceAccountAddressRead(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("Address with sid (%s) was not found in account (%s)", sid, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to read address: %s", err.Error())
+		return utils.TranslateError("Failed to read address", err)
 	}
 
 	d.SetId(getResponse.Sid)
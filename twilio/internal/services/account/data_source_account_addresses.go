@@ -18,10 +18,11 @@ func dataSourceAccountAddresses() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("addresses", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"addresses": {
@@ -88,14 +89,14 @@ func dataSourceAccountAddresses() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceAccountAddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	paginator := client.Account(accountSid).Addresses.NewAddressesPaginator()
 	for paginator.NextWithContext(ctx) {
 	}
@@ -103,7 +104,7 @@ func dataSourceAccountAddressesRead(ctx context.Context, d *schema.ResourceData,
 	err := paginator.Error()
 	if err != nil {
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to list addresses: %s", err.Error())
+		return utils.TranslateError("Failed to list addresses", err)
 	}
 
 	d.SetId(accountSid)
@@ -135,7 +136,11 @@ func dataSourceAccountAddressesRead(ctx context.Context, d *schema.ResourceData,
 		addresses = append(addresses, addressMap)
 	}
 
-	d.Set("addresses", &addresses)
+	filteredAddresses, err := utils.FilterListItems(d, addresses)
+	if err != nil {
+		return diag.Errorf("Failed to filter addresses: %s", err.Error())
+	}
+	d.Set("addresses", &filteredAddresses)
 
 	return nil
 }
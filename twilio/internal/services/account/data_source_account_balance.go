@@ -21,7 +21,8 @@ func dataSourceAccountBalance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"balance": {
@@ -39,13 +40,13 @@ func dataSourceAccountBalance() *schema.Resource {
 func dataSourceAccountBalanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	sid := d.Get("account_sid").(string)
+	sid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	getResponse, err := client.Account(sid).Balance().FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Account balance with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read account balance: %s", err.Error())
+		return utils.TranslateError("Failed to read account balance", err)
 	}
 
 	d.SetId(getResponse.AccountSid)
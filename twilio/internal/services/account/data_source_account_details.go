@@ -66,7 +66,7 @@ func dataSourceAccountDetailsRead(ctx context.Context, d *schema.ResourceData, m
 	if v, ok := d.GetOk("sid"); ok {
 		sid = v.(string)
 	} else {
-		sid = twilioClient.AccountSid
+		sid = twilioClient.DefaultAccountSid()
 	}
 
 	getResponse, err := client.Account(sid).FetchWithContext(ctx)
@@ -74,7 +74,7 @@ func dataSourceAccountDetailsRead(ctx context.Context, d *schema.ResourceData, m
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Account with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read account details: %s", err.Error())
+		return utils.TranslateError("Failed to read account details", err)
 	}
 
 	d.SetId(getResponse.Sid)
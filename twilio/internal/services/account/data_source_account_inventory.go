@@ -0,0 +1,305 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+// inventoryResource is a resource which exists in the account, the type is the Terraform resource type which manages the resource so the inventory can be compared with the Terraform state
+type inventoryResource struct {
+	Service      string
+	Type         string
+	Sid          string
+	FriendlyName interface{}
+}
+
+type inventoryFunc func(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error)
+
+// inventoryService lists the resources of a service. Only API v2010 services support listing the resources of an account other than the
+// account which the provider credentials belong to, all other services are always listed for the credentials account
+type inventoryService struct {
+	list                  inventoryFunc
+	supportsOtherAccounts bool
+}
+
+var inventoryServices = map[string]inventoryService{
+	"iam":          {list: apiKeysInventory, supportsOtherAccounts: true},
+	"messaging":    {list: messagingInventory},
+	"phone_number": {list: phoneNumbersInventory, supportsOtherAccounts: true},
+	"serverless":   {list: serverlessInventory},
+	"sip":          {list: sipInventory, supportsOtherAccounts: true},
+	"sip_trunking": {list: sipTrunkingInventory},
+	"studio":       {list: studioInventory},
+	"taskrouter":   {list: taskRouterInventory},
+}
+
+func dataSourceAccountInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountInventoryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: utils.WithListFilters("resources", map[string]*schema.Schema{
+			"account_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inventoryServiceNames(false), false),
+				},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceAccountInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient)
+	accountSid := client.ResolveAccountSid(d)
+
+	// Listing the resources of a subaccount using the parent account credentials would return the resources of the parent account for non API v2010 services
+	otherAccount := accountSid != client.AccountSid
+
+	var diags diag.Diagnostics
+	var services []string
+	if value, ok := d.GetOk("services"); ok && value.(*schema.Set).Len() > 0 {
+		services = utils.ConvertToStringSlice(value.(*schema.Set).List())
+		sort.Strings(services)
+
+		if otherAccount {
+			for _, service := range services {
+				if !inventoryServices[service].supportsOtherAccounts {
+					return diag.Errorf("The %s service cannot be listed for account %s as only the resources of the account which the provider credentials belong to (%s) can be listed. Supported services for other accounts are %s", service, accountSid, client.AccountSid, strings.Join(inventoryServiceNames(true), ", "))
+				}
+			}
+		}
+	} else {
+		services = inventoryServiceNames(otherAccount)
+
+		if otherAccount {
+			diags = append(diags, skippedInventoryServicesWarning(accountSid, client.AccountSid))
+		}
+	}
+
+	resources := make([]interface{}, 0)
+	for _, service := range services {
+		inventory, err := inventoryServices[service].list(ctx, client, accountSid)
+		if err != nil {
+			return append(diags, utils.TranslateError(fmt.Sprintf("Failed to list %s resources", service), err)...)
+		}
+
+		for _, resource := range inventory {
+			resources = append(resources, map[string]interface{}{
+				"service":       resource.Service,
+				"type":          resource.Type,
+				"sid":           resource.Sid,
+				"friendly_name": resource.FriendlyName,
+			})
+		}
+	}
+
+	filteredResources, err := utils.FilterListItems(d, resources)
+	if err != nil {
+		return append(diags, diag.Errorf("Failed to filter resources: %s", err.Error())...)
+	}
+
+	d.SetId(accountSid)
+	d.Set("account_sid", accountSid)
+	d.Set("services", services)
+	d.Set("resources", filteredResources)
+
+	return diags
+}
+
+// skippedInventoryServicesWarning warns that the services which can only be listed for the credentials account have been excluded from the inventory,
+// so a subaccount inventory is not mistaken for a complete list of the resources in the subaccount
+func skippedInventoryServicesWarning(accountSid string, credentialsAccountSid string) diag.Diagnostic {
+	skippedServices := make([]string, 0)
+	for _, service := range inventoryServiceNames(false) {
+		if !inventoryServices[service].supportsOtherAccounts {
+			skippedServices = append(skippedServices, service)
+		}
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       "Account inventory does not include all services",
+		Detail:        fmt.Sprintf("The %s services were not listed for account %s as only the resources of the account which the provider credentials belong to (%s) can be listed for these services. Set `services` to only list the supported services (%s) and suppress this warning", strings.Join(skippedServices, ", "), accountSid, credentialsAccountSid, strings.Join(inventoryServiceNames(true), ", ")),
+		AttributePath: cty.GetAttrPath("services"),
+	}
+}
+
+// inventoryServiceNames returns the names of the services, when otherAccount is true only the services which can be listed for an account other than the credentials account are returned
+func inventoryServiceNames(otherAccount bool) []string {
+	names := make([]string, 0)
+	for name, service := range inventoryServices {
+		if otherAccount && !service.supportsOtherAccounts {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func apiKeysInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.API.Account(accountSid).Keys.NewKeysPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, key := range paginator.Keys {
+		inventory = append(inventory, inventoryResource{Service: "iam", Type: "twilio_iam_api_key", Sid: key.Sid, FriendlyName: key.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func messagingInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.Messaging.Services.NewServicesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, service := range paginator.Services {
+		inventory = append(inventory, inventoryResource{Service: "messaging", Type: "twilio_messaging_service", Sid: service.Sid, FriendlyName: service.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func phoneNumbersInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.API.Account(accountSid).IncomingPhoneNumbers.NewIncomingPhoneNumbersPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, phoneNumber := range paginator.PhoneNumbers {
+		inventory = append(inventory, inventoryResource{Service: "phone_number", Type: "twilio_phone_number", Sid: phoneNumber.Sid, FriendlyName: phoneNumber.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func serverlessInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.Serverless.Services.NewServicesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, service := range paginator.Services {
+		inventory = append(inventory, inventoryResource{Service: "serverless", Type: "twilio_serverless_service", Sid: service.Sid, FriendlyName: service.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func sipInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.API.Account(accountSid).Sip.Domains.NewDomainsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, domain := range paginator.Domains {
+		inventory = append(inventory, inventoryResource{Service: "sip", Type: "twilio_sip_domain", Sid: domain.Sid, FriendlyName: domain.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func sipTrunkingInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.SIPTrunking.Trunks.NewTrunksPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, trunk := range paginator.Trunks {
+		inventory = append(inventory, inventoryResource{Service: "sip_trunking", Type: "twilio_sip_trunking_trunk", Sid: trunk.Sid, FriendlyName: trunk.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func studioInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.Studio.Flows.NewFlowsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, flow := range paginator.Flows {
+		inventory = append(inventory, inventoryResource{Service: "studio", Type: "twilio_studio_flow", Sid: flow.Sid, FriendlyName: flow.FriendlyName})
+	}
+	return inventory, nil
+}
+
+func taskRouterInventory(ctx context.Context, client *common.TwilioClient, accountSid string) ([]inventoryResource, error) {
+	paginator := client.TaskRouter.Workspaces.NewWorkspacesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return nil, err
+	}
+
+	inventory := make([]inventoryResource, 0)
+	for _, workspace := range paginator.Workspaces {
+		inventory = append(inventory, inventoryResource{Service: "taskrouter", Type: "twilio_taskrouter_workspace", Sid: workspace.Sid, FriendlyName: workspace.FriendlyName})
+	}
+	return inventory, nil
+}
@@ -0,0 +1,24 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestSkippedInventoryServicesWarning(t *testing.T) {
+	warning := skippedInventoryServicesWarning("ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "ACbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	if warning.Severity != diag.Warning {
+		t.Errorf("Expected a warning, got severity %v", warning.Severity)
+	}
+	if !warning.AttributePath.Equals(cty.GetAttrPath("services")) {
+		t.Errorf("Expected the warning to be reported against services, got %#v", warning.AttributePath)
+	}
+
+	expectedDetail := "The messaging, serverless, sip_trunking, studio, taskrouter services were not listed for account ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa as only the resources of the account which the provider credentials belong to (ACbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb) can be listed for these services. Set `services` to only list the supported services (iam, phone_number, sip) and suppress this warning"
+	if warning.Detail != expectedDetail {
+		t.Errorf("Expected the detail %q, got %q", expectedDetail, warning.Detail)
+	}
+}
@@ -16,6 +16,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 		"twilio_account_details":   dataSourceAccountDetails(),
 		"twilio_account_address":   dataSourceAccountAddress(),
 		"twilio_account_addresses": dataSourceAccountAddresses(),
+		"twilio_account_inventory": dataSourceAccountInventory(),
 	}
 }
 
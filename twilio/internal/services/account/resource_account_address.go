@@ -53,7 +53,8 @@ func resourceAccountAddress() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -136,9 +137,9 @@ func resourceAccountAddressCreate(ctx context.Context, d *schema.ResourceData, m
 		StreetSecondary:  utils.OptionalStringWithEmptyStringOnChange(d, "street_secondary"),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Addresses.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Addresses.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create address: %s", err.Error())
+		return utils.TranslateError("Failed to create address", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -148,14 +149,14 @@ func resourceAccountAddressCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceAccountAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Address(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Address(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to read address: %s", err.Error())
+		return utils.TranslateError("Failed to read address", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -194,9 +195,9 @@ func resourceAccountAddressUpdate(ctx context.Context, d *schema.ResourceData, m
 		StreetSecondary:  utils.OptionalStringWithEmptyStringOnChange(d, "street_secondary"),
 	}
 
-	updateResp, err := client.Account(d.Get("account_sid").(string)).Address(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResp, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Address(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update address: %s", err.Error())
+		return utils.TranslateError("Failed to update address", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -206,8 +207,8 @@ func resourceAccountAddressUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceAccountAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Address(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete address: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Address(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete address", err)
 	}
 
 	d.SetId("")
@@ -35,6 +35,7 @@ func resourceAccountSubAccount() *schema.Resource {
 				}
 
 				d.Set("sid", match[1])
+				d.Set("secret_storage", utils.SecretStoragePlaintext)
 				d.SetId(match[1])
 				return []*schema.ResourceData{d}, nil
 			},
@@ -61,11 +62,25 @@ func resourceAccountSubAccount() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"pgp_key":        utils.PGPKeySchema(false),
+			"secret_storage": utils.SecretStorageSchema(false),
 			"auth_token": {
 				Type:      schema.TypeString,
 				Computed:  true,
 				Sensitive: true,
 			},
+			"auth_token_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_auth_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -101,7 +116,7 @@ func resourceAccountSubAccountCreate(ctx context.Context, d *schema.ResourceData
 
 	createResult, err := client.Accounts.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create sub account: %s", err.Error())
+		return utils.TranslateError("Failed to create sub account", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -117,7 +132,7 @@ func resourceAccountSubAccountRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read account: %s", err.Error())
+		return utils.TranslateError("Failed to read account", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -125,7 +140,9 @@ func resourceAccountSubAccountRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("friendly_name", getResponse.FriendlyName)
 	d.Set("status", getResponse.Status)
 	d.Set("type", getResponse.Type)
-	d.Set("auth_token", getResponse.AuthToken)
+	if diags := setAuthToken(d, getResponse.AuthToken); diags.HasError() {
+		return diags
+	}
 	d.Set("date_created", getResponse.DateCreated.Time.Format(time.RFC3339))
 
 	if getResponse.DateUpdated != nil {
@@ -145,7 +162,12 @@ func resourceAccountSubAccountUpdate(ctx context.Context, d *schema.ResourceData
 
 	updateResp, err := client.Account(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update account: %s", err.Error())
+		return utils.TranslateError("Failed to update account", err)
+	}
+
+	if d.HasChange("pgp_key") {
+		// The encrypted auth token is cleared so the auth token is encrypted with the new key when the account is read
+		d.Set("encrypted_auth_token", "")
 	}
 
 	d.SetId(updateResp.Sid)
@@ -153,6 +175,10 @@ func resourceAccountSubAccountUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAccountSubAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := meta.(*common.TwilioClient).CheckDeletionProtection("sub account", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	client := meta.(*common.TwilioClient).API
 
 	log.Println("[INFO] Accounts can only be closed and will be deleted after 30 days. So updating the account to close it")
@@ -162,9 +188,34 @@ func resourceAccountSubAccountDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	if _, err := client.Account(d.Id()).UpdateWithContext(ctx, updateInput); err != nil {
-		return diag.Errorf("Failed to close account: %s", err.Error())
+		return utils.TranslateError("Failed to close account", err)
 	}
 
 	d.SetId("")
 	return nil
 }
+
+// setAuthToken stores the auth token in state based on the `pgp_key` and `secret_storage` arguments.
+// The auth token is only encrypted when it has been rotated or the PGP key has changed, as the encrypted value is different every time the auth token is encrypted
+func setAuthToken(d *schema.ResourceData, authToken string) diag.Diagnostics {
+	authTokenHash := utils.SecretHashForState(d.Get("auth_token_hash").(string), authToken)
+
+	if pgpKey, ok := d.GetOk("pgp_key"); ok {
+		if authTokenHash != d.Get("auth_token_hash").(string) || d.Get("encrypted_auth_token").(string) == "" {
+			encryptedAuthToken, keyFingerprint, err := utils.EncryptSecret(pgpKey.(string), authToken)
+			if err != nil {
+				return diag.Errorf("Failed to encrypt account auth token: %s", err.Error())
+			}
+			d.Set("encrypted_auth_token", encryptedAuthToken)
+			d.Set("key_fingerprint", keyFingerprint)
+		}
+		d.Set("auth_token", "")
+	} else {
+		d.Set("encrypted_auth_token", "")
+		d.Set("key_fingerprint", "")
+		d.Set("auth_token", utils.SecretValueForState(d, "auth_token", authToken))
+	}
+
+	d.Set("auth_token_hash", authTokenHash)
+	return nil
+}
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -41,7 +40,7 @@ func TestAccDataSourceTwilioAccountDetails_complete(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.details", accountDetailsDataSourceName)
 	subAccountStateResourceName := "twilio_account_sub_account.sub_account"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	testData := acceptance.TestAccData
 
 	resource.ParallelTest(t, resource.TestCase{
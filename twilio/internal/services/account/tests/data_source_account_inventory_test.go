@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+var accountInventoryDataSourceName = "twilio_account_inventory"
+
+func TestAccDataSourceTwilioAccountInventory_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.inventory", accountInventoryDataSourceName)
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioAccountInventory_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "id", testData.AccountSid),
+					resource.TestCheckResourceAttr(stateDataSourceName, "account_sid", testData.AccountSid),
+					resource.TestCheckResourceAttr(stateDataSourceName, "services.#", "8"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioAccountInventory_services(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.inventory", accountInventoryDataSourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioAccountInventory_services(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "services.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(stateDataSourceName, "resources.*", map[string]string{
+						"service":       "messaging",
+						"type":          "twilio_messaging_service",
+						"friendly_name": friendlyName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioAccountInventory_invalidService(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioAccountInventory_invalidService(),
+				ExpectError: regexp.MustCompile(`(?s)to be one of \[iam messaging phone_number serverless sip sip_trunking studio taskrouter\], got invalid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioAccountInventory_subAccount(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.inventory", accountInventoryDataSourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioAccountInventory_subAccount(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "id", "twilio_account_sub_account.sub_account", "sid"),
+					resource.TestCheckResourceAttrPair(stateDataSourceName, "account_sid", "twilio_account_sub_account.sub_account", "sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "services.#", "3"),
+					resource.TestCheckTypeSetElemAttr(stateDataSourceName, "services.*", "iam"),
+					resource.TestCheckTypeSetElemAttr(stateDataSourceName, "services.*", "phone_number"),
+					resource.TestCheckTypeSetElemAttr(stateDataSourceName, "services.*", "sip"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "resources.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(stateDataSourceName, "resources.*", map[string]string{
+						"service":       "iam",
+						"type":          "twilio_iam_api_key",
+						"friendly_name": friendlyName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioAccountInventory_subAccountUnsupportedService(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioAccountInventory_subAccountUnsupportedService(),
+				ExpectError: regexp.MustCompile(`(?s)The messaging service cannot be listed for account AC\w+ as only the resources of\s+the account which the provider credentials belong to`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioAccountInventory_basic() string {
+	return `
+data "twilio_account_inventory" "inventory" {}
+`
+}
+
+func testAccDataSourceTwilioAccountInventory_services(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_messaging_service" "service" {
+  friendly_name = "%s"
+}
+
+data "twilio_account_inventory" "inventory" {
+  services = ["messaging"]
+
+  depends_on = [twilio_messaging_service.service]
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioAccountInventory_invalidService() string {
+	return `
+data "twilio_account_inventory" "inventory" {
+  services = ["invalid"]
+}
+`
+}
+
+func testAccDataSourceTwilioAccountInventory_subAccount(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_account_sub_account" "sub_account" {}
+
+resource "twilio_iam_api_key" "api_key" {
+  account_sid   = twilio_account_sub_account.sub_account.sid
+  friendly_name = "%s"
+}
+
+data "twilio_account_inventory" "inventory" {
+  account_sid = twilio_account_sub_account.sub_account.sid
+
+  depends_on = [twilio_iam_api_key.api_key]
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioAccountInventory_subAccountUnsupportedService() string {
+	return `
+resource "twilio_account_sub_account" "sub_account" {}
+
+data "twilio_account_inventory" "inventory" {
+  account_sid = twilio_account_sub_account.sub_account.sid
+  services    = ["iam", "messaging"]
+}
+`
+}
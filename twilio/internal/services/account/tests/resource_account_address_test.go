@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -150,7 +149,7 @@ func TestAccTwilioAccountAddress_streetSecondary(t *testing.T) {
 func TestAccTwilioAccountAddress_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.address", addressResourceName)
 	testData := acceptance.TestAccData
-	newFriendlyName := acctest.RandString(10)
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -50,7 +49,7 @@ func TestAccTwilioAccountSubAccount_basic(t *testing.T) {
 
 func TestAccTwilioAccountSubAccount_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.sub_account", subAccountResourceName)
-	newFriendlyName := acctest.RandString(10)
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
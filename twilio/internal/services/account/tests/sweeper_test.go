@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/timworks/twilio-sdk-go/service/api/v2010/account"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_account_address", &resource.Sweeper{
+		Name: "twilio_account_address",
+		F:    sweepAccountAddresses,
+	})
+	resource.AddTestSweepers("twilio_account_sub_account", &resource.Sweeper{
+		Name: "twilio_account_sub_account",
+		F:    sweepSubAccounts,
+	})
+}
+
+func sweepAccountAddresses(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).Addresses.NewAddressesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list account addresses: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Addresses {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("account address", item.Sid, item.FriendlyName)
+		if err := client.Account(accountSid).Address(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("account address", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func sweepSubAccounts(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+
+	ctx := context.Background()
+	paginator := client.Accounts.NewAccountsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list subaccounts: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Accounts {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) || item.Status == "closed" {
+			continue
+		}
+
+		acceptance.LogSweep("subaccount", item.Sid, item.FriendlyName)
+		// Subaccounts cannot be deleted, so the subaccount is closed and will be deleted by Twilio after 30 days
+		updateInput := &account.UpdateAccountInput{
+			Status: sdkUtils.String("closed"),
+		}
+		if _, err := client.Account(item.Sid).UpdateWithContext(ctx, updateInput); err != nil {
+			errs.Add("subaccount", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
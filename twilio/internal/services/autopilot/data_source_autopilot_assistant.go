@@ -109,7 +109,7 @@ func dataSourceAutopilotAssistantRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Assistant with sid/ unique name (%s) was not found", identifier)
 		}
-		return diag.Errorf("Failed to read autopilot assistant: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot assistant", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -137,7 +137,7 @@ func dataSourceAutopilotAssistantRead(ctx context.Context, d *schema.ResourceDat
 
 	getDefaultsResponse, err := client.Assistant(d.Id()).Defaults().FetchWithContext(ctx)
 	if err != nil {
-		return diag.Errorf("Failed to read autopilot assistant defaults: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot assistant defaults", err)
 	}
 	defaultsJSON, err := structure.FlattenJsonToString(getDefaultsResponse.Data)
 	if err != nil {
@@ -147,7 +147,7 @@ func dataSourceAutopilotAssistantRead(ctx context.Context, d *schema.ResourceDat
 
 	getStyleSheetResponse, err := client.Assistant(d.Id()).StyleSheet().FetchWithContext(ctx)
 	if err != nil {
-		return diag.Errorf("Failed to read autopilot assistant stylesheet: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot assistant stylesheet", err)
 	}
 	styleSheetJSON, err := structure.FlattenJsonToString(getStyleSheetResponse.Data)
 	if err != nil {
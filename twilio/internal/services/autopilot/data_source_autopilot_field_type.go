@@ -78,7 +78,7 @@ func dataSourceAutopilotFieldTypeRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Field type with sid/ unique name (%s) was not found for assistant with sid (%s)", identifier, assistantSid)
 		}
-		return diag.Errorf("Failed to read autopilot field type: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot field type", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceAutopilotFieldTypes() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("field_types", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -60,7 +60,7 @@ func dataSourceAutopilotFieldTypes() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -77,7 +77,7 @@ func dataSourceAutopilotFieldTypesRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No field types were found for assistant with sid (%s)", assistantSid)
 		}
-		return diag.Errorf("Failed to list autopilot field types: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot field types", err)
 	}
 
 	d.SetId(assistantSid)
@@ -104,7 +104,11 @@ func dataSourceAutopilotFieldTypesRead(ctx context.Context, d *schema.ResourceDa
 		fieldTypes = append(fieldTypes, fieldTypeMap)
 	}
 
-	d.Set("field_types", &fieldTypes)
+	filteredFieldTypes, err := utils.FilterListItems(d, fieldTypes)
+	if err != nil {
+		return diag.Errorf("Failed to filter field types: %s", err.Error())
+	}
+	d.Set("field_types", &filteredFieldTypes)
 
 	return nil
 }
@@ -77,7 +77,7 @@ func dataSourceAutopilotFieldValueRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Field value with sid (%s) was not found for assistant with sid (%s) and field type with sid (%s)", sid, assistantSid, fieldTypeSid)
 		}
-		return diag.Errorf("Failed to read autopilot field value: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot field value", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceAutopilotFieldValues() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("field_values", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,7 +69,7 @@ func dataSourceAutopilotFieldValues() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -87,7 +87,7 @@ func dataSourceAutopilotFieldValuesRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No field values were found for assistant with sid (%s) and field type with sid (%s)", assistantSid, fieldTypeSid)
 		}
-		return diag.Errorf("Failed to list autopilot field values: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot field values", err)
 	}
 
 	d.SetId(fieldTypeSid + "/" + fieldTypeSid)
@@ -116,7 +116,11 @@ func dataSourceAutopilotFieldValuesRead(ctx context.Context, d *schema.ResourceD
 		values = append(values, valueMap)
 	}
 
-	d.Set("field_values", &values)
+	filteredValues, err := utils.FilterListItems(d, values)
+	if err != nil {
+		return diag.Errorf("Failed to filter field values: %s", err.Error())
+	}
+	d.Set("field_values", &filteredValues)
 
 	return nil
 }
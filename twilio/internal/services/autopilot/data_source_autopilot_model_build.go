@@ -86,7 +86,7 @@ func dataSourceAutopilotModelBuildRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Model build with sid/ unique name (%s) was not found for assistant with sid (%s)", identifier, assistantSid)
 		}
-		return diag.Errorf("Failed to read autopilot model build: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot model build", err)
 	}
 
 	d.SetId(getResponse.Sid)
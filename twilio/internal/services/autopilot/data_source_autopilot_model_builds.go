@@ -18,7 +18,7 @@ func dataSourceAutopilotModelBuilds() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("model_builds", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -68,7 +68,7 @@ func dataSourceAutopilotModelBuilds() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -85,7 +85,7 @@ func dataSourceAutopilotModelBuildsRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No model builds were found for assistant with sid (%s)", assistantSid)
 		}
-		return diag.Errorf("Failed to list autopilot model builds: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot model builds", err)
 	}
 
 	d.SetId(assistantSid)
@@ -114,7 +114,11 @@ func dataSourceAutopilotModelBuildsRead(ctx context.Context, d *schema.ResourceD
 		modelBuilds = append(modelBuilds, modelBuildMap)
 	}
 
-	d.Set("model_builds", &modelBuilds)
+	filteredModelBuilds, err := utils.FilterListItems(d, modelBuilds)
+	if err != nil {
+		return diag.Errorf("Failed to filter model builds: %s", err.Error())
+	}
+	d.Set("model_builds", &filteredModelBuilds)
 
 	return nil
 }
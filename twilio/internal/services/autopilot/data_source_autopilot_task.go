@@ -87,7 +87,7 @@ func dataSourceAutopilotTaskRead(ctx context.Context, d *schema.ResourceData, me
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Task with sid/ unique name (%s) was not found for assistant with sid (%s)", identifier, assistantSid)
 		}
-		return diag.Errorf("Failed to read autopilot task: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -107,7 +107,7 @@ func dataSourceAutopilotTaskRead(ctx context.Context, d *schema.ResourceData, me
 
 	getActionsResponse, err := client.Assistant(getResponse.AssistantSid).Task(getResponse.Sid).Actions().FetchWithContext(ctx)
 	if err != nil {
-		return diag.Errorf("Failed to read autopilot task actions: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task actions", err)
 	}
 	actionsJSONString, err := structure.FlattenJsonToString(getActionsResponse.Data)
 	if err != nil {
@@ -84,7 +84,7 @@ func dataSourceAutopilotTaskFieldRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Task field with sid/ unique name (%s) was not found for assistant with sid (%s) and task with sid (%s)", identifier, assistantSid, taskSid)
 		}
-		return diag.Errorf("Failed to read autopilot task field: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task field", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceAutopilotTaskFields() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("fields", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -65,7 +65,7 @@ func dataSourceAutopilotTaskFields() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -83,7 +83,7 @@ func dataSourceAutopilotTaskFieldsRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No task fields were found for assistant with sid (%s) and task with sid (%s)", assistantSid, taskSid)
 		}
-		return diag.Errorf("Failed to list autopilot task fields: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot task fields", err)
 	}
 
 	d.SetId(assistantSid + "/" + taskSid)
@@ -111,7 +111,11 @@ func dataSourceAutopilotTaskFieldsRead(ctx context.Context, d *schema.ResourceDa
 		fields = append(fields, fieldMap)
 	}
 
-	d.Set("fields", &fields)
+	filteredFields, err := utils.FilterListItems(d, fields)
+	if err != nil {
+		return diag.Errorf("Failed to filter fields: %s", err.Error())
+	}
+	d.Set("fields", &filteredFields)
 
 	return nil
 }
@@ -78,7 +78,7 @@ func dataSourceAutopilotTaskSampleRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Task sample with sid (%s) was not found for assistant with sid (%s) and task with sid (%s)", sid, assistantSid, taskSid)
 		}
-		return diag.Errorf("Failed to read autopilot task sample: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task sample", err)
 	}
 
 	d.SetId(getResponse.Sid)
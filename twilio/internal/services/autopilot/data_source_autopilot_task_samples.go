@@ -19,7 +19,7 @@ func dataSourceAutopilotTaskSamples() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("samples", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -74,7 +74,7 @@ func dataSourceAutopilotTaskSamples() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -96,7 +96,7 @@ func dataSourceAutopilotTaskSamplesRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No samples were found for assistant with sid (%s) and task with sid (%s)", assistantSid, taskSid)
 		}
-		return diag.Errorf("Failed to list autopilot task samples: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot task samples", err)
 	}
 
 	d.SetId(assistantSid + "/" + taskSid)
@@ -125,7 +125,11 @@ func dataSourceAutopilotTaskSamplesRead(ctx context.Context, d *schema.ResourceD
 		samples = append(samples, sampleMap)
 	}
 
-	d.Set("samples", &samples)
+	filteredSamples, err := utils.FilterListItems(d, samples)
+	if err != nil {
+		return diag.Errorf("Failed to filter samples: %s", err.Error())
+	}
+	d.Set("samples", &filteredSamples)
 
 	return nil
 }
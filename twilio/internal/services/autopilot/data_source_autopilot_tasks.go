@@ -19,7 +19,7 @@ func dataSourceAutopilotTasks() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("tasks", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,7 +69,7 @@ func dataSourceAutopilotTasks() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -86,7 +86,7 @@ func dataSourceAutopilotTasksRead(ctx context.Context, d *schema.ResourceData, m
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No tasks were found for assistant with sid (%s)", assistantSid)
 		}
-		return diag.Errorf("Failed to list autopilot tasks: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot tasks", err)
 	}
 
 	d.SetId(assistantSid)
@@ -113,7 +113,7 @@ func dataSourceAutopilotTasksRead(ctx context.Context, d *schema.ResourceData, m
 
 		getActionsResponse, err := client.Assistant(task.AssistantSid).Task(task.Sid).Actions().FetchWithContext(ctx)
 		if err != nil {
-			return diag.Errorf("Failed to read autopilot task actions: %s", err.Error())
+			return utils.TranslateError("Failed to read autopilot task actions", err)
 		}
 		actionsJSONString, err := structure.FlattenJsonToString(getActionsResponse.Data)
 		if err != nil {
@@ -125,7 +125,11 @@ func dataSourceAutopilotTasksRead(ctx context.Context, d *schema.ResourceData, m
 		tasks = append(tasks, taskMap)
 	}
 
-	d.Set("tasks", &tasks)
+	filteredTasks, err := utils.FilterListItems(d, tasks)
+	if err != nil {
+		return diag.Errorf("Failed to filter tasks: %s", err.Error())
+	}
+	d.Set("tasks", &filteredTasks)
 
 	return nil
 }
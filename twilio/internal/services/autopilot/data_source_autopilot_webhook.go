@@ -90,7 +90,7 @@ func dataSourceAutopilotWebhookRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Webhook with sid/ unique name (%s) was not found for assistant with sid (%s)", identifier, assistantSid)
 		}
-		return diag.Errorf("Failed to read autopilot webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot webhook", err)
 	}
 
 	d.SetId(getResponse.Sid)
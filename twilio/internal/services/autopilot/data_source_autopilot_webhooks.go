@@ -19,7 +19,7 @@ func dataSourceAutopilotWebhooks() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("webhooks", map[string]*schema.Schema{
 			"assistant_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -72,7 +72,7 @@ func dataSourceAutopilotWebhooks() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -89,7 +89,7 @@ func dataSourceAutopilotWebhooksRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No webhooks were found for assistant with sid (%s)", assistantSid)
 		}
-		return diag.Errorf("Failed to list autopilot webhooks: %s", err.Error())
+		return utils.TranslateError("Failed to list autopilot webhooks", err)
 	}
 
 	d.SetId(assistantSid)
@@ -118,7 +118,11 @@ func dataSourceAutopilotWebhooksRead(ctx context.Context, d *schema.ResourceData
 		webhooks = append(webhooks, webhookMap)
 	}
 
-	d.Set("webhooks", &webhooks)
+	filteredWebhooks, err := utils.FilterListItems(d, webhooks)
+	if err != nil {
+		return diag.Errorf("Failed to filter webhooks: %s", err.Error())
+	}
+	d.Set("webhooks", &filteredWebhooks)
 
 	return nil
 }
@@ -148,7 +148,7 @@ func resourceAutopilotAssistantCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Assistants.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot assistant: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot assistant", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -168,7 +168,7 @@ func resourceAutopilotAssistantRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot assistant: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot assistant", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -195,7 +195,7 @@ func resourceAutopilotAssistantRead(ctx context.Context, d *schema.ResourceData,
 
 	getDefaultsResponse, err := client.Assistant(d.Id()).Defaults().FetchWithContext(ctx)
 	if err != nil {
-		return diag.Errorf("Failed to read autopilot assistant defaults: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot assistant defaults", err)
 	}
 	defaultsJSONString, err := structure.FlattenJsonToString(getDefaultsResponse.Data)
 	if err != nil {
@@ -205,7 +205,7 @@ func resourceAutopilotAssistantRead(ctx context.Context, d *schema.ResourceData,
 
 	getStyleSheetResponse, err := client.Assistant(d.Id()).StyleSheet().FetchWithContext(ctx)
 	if err != nil {
-		return diag.Errorf("Failed to read autopilot assistant stylesheet: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot assistant stylesheet", err)
 	}
 	styleSheetJSONString, err := structure.FlattenJsonToString(getStyleSheetResponse.Data)
 	if err != nil {
@@ -232,7 +232,7 @@ func resourceAutopilotAssistantUpdate(ctx context.Context, d *schema.ResourceDat
 
 	updateResp, err := client.Assistant(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update autopilot assistant: %s", err.Error())
+		return utils.TranslateError("Failed to update autopilot assistant", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -243,7 +243,7 @@ func resourceAutopilotAssistantDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot assistant: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot assistant", err)
 	}
 	d.SetId("")
 	return nil
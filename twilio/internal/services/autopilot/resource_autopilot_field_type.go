@@ -98,7 +98,7 @@ func resourceAutopilotFieldTypeCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).FieldTypes.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot field type: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot field type", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -114,7 +114,7 @@ func resourceAutopilotFieldTypeRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot field type: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot field type", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -142,7 +142,7 @@ func resourceAutopilotFieldTypeUpdate(ctx context.Context, d *schema.ResourceDat
 
 	updateResp, err := client.Assistant(d.Get("assistant_sid").(string)).FieldType(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update autopilot field type: %s", err.Error())
+		return utils.TranslateError("Failed to update autopilot field type", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -153,7 +153,7 @@ func resourceAutopilotFieldTypeDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).FieldType(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot field type: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot field type", err)
 	}
 	d.SetId("")
 	return nil
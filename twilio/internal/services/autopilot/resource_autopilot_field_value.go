@@ -109,7 +109,7 @@ func resourceAutopilotFieldValueCreate(ctx context.Context, d *schema.ResourceDa
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).FieldType(d.Get("field_type_sid").(string)).FieldValues.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot field value: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot field value", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -125,7 +125,7 @@ func resourceAutopilotFieldValueRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot field value: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot field value", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -149,7 +149,7 @@ func resourceAutopilotFieldValueDelete(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).FieldType(d.Get("field_type_sid").(string)).FieldValue(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot field value: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot field value", err)
 	}
 	d.SetId("")
 	return nil
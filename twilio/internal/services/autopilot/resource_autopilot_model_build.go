@@ -3,7 +3,6 @@ package autopilot
 import (
 	"context"
 	"fmt"
-	"log"
 	"regexp"
 	"time"
 
@@ -153,7 +152,7 @@ func resourceAutopilotModelBuildCreate(ctx context.Context, d *schema.ResourceDa
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).ModelBuilds.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot model build: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot model build", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -177,7 +176,7 @@ func resourceAutopilotModelBuildRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot model build: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot model build", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -216,7 +215,7 @@ func resourceAutopilotModelBuildUpdate(ctx context.Context, d *schema.ResourceDa
 
 	updateResp, err := client.Assistant(d.Get("assistant_sid").(string)).ModelBuild(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update autopilot model build: %s", err.Error())
+		return utils.TranslateError("Failed to update autopilot model build", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -229,7 +228,7 @@ func resourceAutopilotModelBuildDelete(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).ModelBuild(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot model build: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot model build", err)
 	}
 	d.SetId("")
 	return nil
@@ -237,23 +236,31 @@ func resourceAutopilotModelBuildDelete(ctx context.Context, d *schema.ResourceDa
 
 func poll(ctx context.Context, d *schema.ResourceData, client *common.TwilioClient, pollingConfig map[string]interface{}) diag.Diagnostics {
 	if pollingConfig["enabled"].(bool) {
-		for i := 0; i < pollingConfig["max_attempts"].(int); i++ {
-			log.Printf("[INFO] Build Polling attempt # %v", i+1)
-
-			getResponse, err := client.Autopilot.Assistant(d.Get("assistant_sid").(string)).ModelBuild(d.Id()).FetchWithContext(ctx)
-			if err != nil {
-				return diag.Errorf("Failed to poll autopilot model build: %s", err.Error())
-			}
+		waiter := &utils.Waiter{
+			Description: "autopilot model build",
+			Pending:     []string{"enqueued", "building"},
+			Target:      []string{"completed"},
+			Refresh: func(ctx context.Context) (interface{}, string, error) {
+				getResponse, err := client.Autopilot.Assistant(d.Get("assistant_sid").(string)).ModelBuild(d.Id()).FetchWithContext(ctx)
+				if err != nil {
+					return nil, "", err
+				}
+				return getResponse, getResponse.Status, nil
+			},
+			Timeout:     d.Timeout(schema.TimeoutCreate),
+			Delay:       time.Duration(pollingConfig["delay_in_ms"].(int)) * time.Millisecond,
+			MaxAttempts: pollingConfig["max_attempts"].(int),
+		}
 
-			if getResponse.Status == "failed" {
+		if _, err := waiter.WaitWithContext(ctx); err != nil {
+			if stateErr, ok := err.(*utils.UnexpectedStateError); ok && stateErr.State == "failed" {
 				return diag.Errorf("Autopilot model build failed")
 			}
-			if getResponse.Status == "completed" {
-				return nil
+			if _, ok := err.(*utils.WaiterTimeoutError); ok {
+				return diag.Errorf("Reached max polling attempts or timeout without a completed model build: %s", err.Error())
 			}
-			time.Sleep(time.Duration(pollingConfig["delay_in_ms"].(int)) * time.Millisecond)
+			return utils.TranslateError("Failed to poll autopilot model build", err)
 		}
-		return diag.Errorf("Reached max polling attempts without a completed model build")
 	}
 	return nil
 }
@@ -115,7 +115,7 @@ func resourceAutopilotTaskCreate(ctx context.Context, d *schema.ResourceData, me
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).Tasks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot task: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot task", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -131,7 +131,7 @@ func resourceAutopilotTaskRead(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot task: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -150,7 +150,7 @@ func resourceAutopilotTaskRead(ctx context.Context, d *schema.ResourceData, meta
 
 	getActionsResponse, err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Id()).Actions().FetchWithContext(ctx)
 	if err != nil {
-		return diag.Errorf("Failed to read autopilot task actions: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task actions", err)
 	}
 
 	actionsJSONString, err := structure.FlattenJsonToString(getActionsResponse.Data)
@@ -178,7 +178,7 @@ func resourceAutopilotTaskUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	updateResp, err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update autopilot task: %s", err.Error())
+		return utils.TranslateError("Failed to update autopilot task", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -189,7 +189,7 @@ func resourceAutopilotTaskDelete(ctx context.Context, d *schema.ResourceData, me
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot task: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot task", err)
 	}
 	d.SetId("")
 	return nil
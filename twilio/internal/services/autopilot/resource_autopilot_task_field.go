@@ -103,7 +103,7 @@ func resourceAutopilotTaskFieldCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Get("task_sid").(string)).Fields.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot task field: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot task field", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -119,7 +119,7 @@ func resourceAutopilotTaskFieldRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot task field: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task field", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -142,7 +142,7 @@ func resourceAutopilotTaskFieldDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Get("task_sid").(string)).Field(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot task field: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot task field", err)
 	}
 	d.SetId("")
 	return nil
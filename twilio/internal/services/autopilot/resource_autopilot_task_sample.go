@@ -118,7 +118,7 @@ func resourceAutopilotTaskSampleCreate(ctx context.Context, d *schema.ResourceDa
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Get("task_sid").(string)).Samples.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot task sample: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot task sample", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -134,7 +134,7 @@ func resourceAutopilotTaskSampleRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot task sample: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot task sample", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -165,7 +165,7 @@ func resourceAutopilotTaskSampleUpdate(ctx context.Context, d *schema.ResourceDa
 
 	updateResp, err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Get("task_sid").(string)).Sample(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update autopilot task sample: %s", err.Error())
+		return utils.TranslateError("Failed to update autopilot task sample", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -176,7 +176,7 @@ func resourceAutopilotTaskSampleDelete(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).Task(d.Get("task_sid").(string)).Sample(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot task sample: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot task sample", err)
 	}
 	d.SetId("")
 	return nil
@@ -127,7 +127,7 @@ func resourceAutopilotWebhookCreate(ctx context.Context, d *schema.ResourceData,
 
 	createResult, err := client.Assistant(d.Get("assistant_sid").(string)).Webhooks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create autopilot webhook: %s", err.Error())
+		return utils.TranslateError("Failed to create autopilot webhook", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -143,7 +143,7 @@ func resourceAutopilotWebhookRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read autopilot webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read autopilot webhook", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -175,7 +175,7 @@ func resourceAutopilotWebhookUpdate(ctx context.Context, d *schema.ResourceData,
 
 	updateResp, err := client.Assistant(d.Get("assistant_sid").(string)).Webhook(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update autopilot webhook: %s", err.Error())
+		return utils.TranslateError("Failed to update autopilot webhook", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -186,7 +186,7 @@ func resourceAutopilotWebhookDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).Autopilot
 
 	if err := client.Assistant(d.Get("assistant_sid").(string)).Webhook(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete autopilot webhook: %s", err.Error())
+		return utils.TranslateError("Failed to delete autopilot webhook", err)
 	}
 	d.SetId("")
 	return nil
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var assistantDataSourceName = "twilio_autopilot_assistant"
 
 func TestAccDataSourceTwilioAutopilotAssistant_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.assistant", assistantDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -47,7 +46,7 @@ func TestAccDataSourceTwilioAutopilotAssistant_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotAssistant_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.assistant", assistantDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -14,7 +14,7 @@ var fieldTypeDataSourceName = "twilio_autopilot_field_type"
 
 func TestAccDataSourceTwilioAutopilotFieldType_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.field_type", fieldTypeDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldTypeFriendlyName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -41,7 +41,7 @@ func TestAccDataSourceTwilioAutopilotFieldType_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotFieldType_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.field_type", fieldTypeDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldTypeFriendlyName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -14,7 +14,7 @@ var fieldTypesDataSourceName = "twilio_autopilot_field_types"
 
 func TestAccDataSourceTwilioAutopilotFieldTypes_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.field_types", fieldTypesDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldTypeFriendlyName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
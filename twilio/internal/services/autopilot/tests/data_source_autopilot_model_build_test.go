@@ -14,7 +14,7 @@ var modelBuildDataSourceName = "twilio_autopilot_model_build"
 
 func TestAccDataSourceTwilioAutopilotModelBuild_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.model_build", modelBuildDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	modelBuildUniqueNamePrefix := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -43,7 +43,7 @@ func TestAccDataSourceTwilioAutopilotModelBuild_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotModelBuild_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.model_build", modelBuildDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	modelBuildUniqueNamePrefix := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
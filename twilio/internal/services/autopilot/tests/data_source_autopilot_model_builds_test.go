@@ -14,7 +14,7 @@ var modelBuildsDataSourceName = "twilio_autopilot_model_builds"
 
 func TestAccDataSourceTwilioAutopilotModelBuilds_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.model_builds", modelBuildsDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	modelBuildUniqueNamePrefix := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var taskFieldDataSourceName = "twilio_autopilot_task_field"
 
 func TestAccDataSourceTwilioAutopilotTaskField_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_field", taskFieldDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldType := "Twilio.YES_NO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,7 +41,7 @@ func TestAccDataSourceTwilioAutopilotTaskField_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotTaskField_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_field", taskFieldDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldType := "Twilio.YES_NO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var taskSamplesDataSourceName = "twilio_autopilot_task_samples"
 
 func TestAccDataSourceTwilioAutopilotTaskSamples_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_samples", taskSamplesDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	taggedText := "test"
 
@@ -45,7 +44,7 @@ func TestAccDataSourceTwilioAutopilotTaskSamples_basic(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotTaskSamples_language(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_samples", taskSamplesDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	taggedText := "test"
 
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var taskDataSourceName = "twilio_autopilot_task"
 
 func TestAccDataSourceTwilioAutopilotTask_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task", taskDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -42,7 +41,7 @@ func TestAccDataSourceTwilioAutopilotTask_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotTask_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task", taskDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -15,7 +14,7 @@ var webhookDataSourceName = "twilio_autopilot_webhook"
 
 func TestAccDataSourceTwilioAutopilotWebhook_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.webhook", webhookDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "http://localhost/webhook"
 	events := []string{"onDialogueStart", "onDialogueEnd"}
 
@@ -47,7 +46,7 @@ func TestAccDataSourceTwilioAutopilotWebhook_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioAutopilotWebhook_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.webhook", webhookDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "http://localhost/webhook"
 	events := []string{"onDialogueStart", "onDialogueEnd"}
 
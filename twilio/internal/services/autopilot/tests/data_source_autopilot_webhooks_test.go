@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -15,7 +14,7 @@ var webhooksDataSourceName = "twilio_autopilot_webhooks"
 
 func TestAccDataSourceTwilioAutopilotWebhooks_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.webhooks", webhooksDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "http://localhost/webhook"
 	events := []string{"onDialogueStart", "onDialogueEnd"}
 
@@ -150,7 +150,7 @@ func TestAccTwilioAutopilotAssistant_friendlyName(t *testing.T) {
 
 func TestAccTwilioAutopilotAssistant_developmentStage(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.assistant", assistantResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	developmentStage := "in-production"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -170,7 +170,7 @@ func TestAccTwilioAutopilotAssistant_developmentStage(t *testing.T) {
 }
 
 func TestAccTwilioAutopilotAssistant_invalidDevelopmentStage(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	developmentStage := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -188,7 +188,7 @@ func TestAccTwilioAutopilotAssistant_invalidDevelopmentStage(t *testing.T) {
 
 func TestAccTwilioAutopilotAssistant_defaults(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.assistant", assistantResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -221,7 +221,7 @@ func TestAccTwilioAutopilotAssistant_invalidDefaults(t *testing.T) {
 
 func TestAccTwilioAutopilotAssistant_stylesheet(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.assistant", assistantResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -254,7 +254,7 @@ func TestAccTwilioAutopilotAssistant_invalidStylesheet(t *testing.T) {
 
 func TestAccTwilioAutopilotAssistant_callbackEvents(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.assistant", assistantResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	callbackURL := "http://localhost.com/callback"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -285,7 +285,7 @@ func TestAccTwilioAutopilotAssistant_callbackEvents(t *testing.T) {
 }
 
 func TestAccTwilioAutopilotAssistant_invalidCallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	callbackURL := "callbackURL"
 
 	resource.ParallelTest(t, resource.TestCase{
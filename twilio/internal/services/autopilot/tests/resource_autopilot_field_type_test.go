@@ -17,7 +17,7 @@ var fieldTypeResourceName = "twilio_autopilot_field_type"
 
 func TestAccTwilioAutopilotFieldType_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.field_type", fieldTypeResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -125,7 +125,7 @@ func TestAccTwilioAutopilotFieldType_invalidUniqueNameWith65Characters(t *testin
 
 func TestAccTwilioAutopilotFieldType_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.field_type", fieldTypeResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldTypeFriendlyName := ""
 	newFieldTypeFriendlyName := acctest.RandString(255)
 
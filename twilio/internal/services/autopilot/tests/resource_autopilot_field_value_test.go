@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var fieldValueResourceName = "twilio_autopilot_field_value"
 
 func TestAccTwilioAutopilotFieldValue_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.field_value", fieldValueResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	value := "I"
 
@@ -54,7 +53,7 @@ func TestAccTwilioAutopilotFieldValue_basic(t *testing.T) {
 }
 
 func TestAccTwilioAutopilotFieldValue_blankLanguage(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := ""
 	value := "Invalid Language"
 
@@ -71,7 +70,7 @@ func TestAccTwilioAutopilotFieldValue_blankLanguage(t *testing.T) {
 }
 
 func TestAccTwilioAutopilotFieldValue_blankValue(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	value := ""
 
@@ -90,7 +89,7 @@ func TestAccTwilioAutopilotFieldValue_blankValue(t *testing.T) {
 func TestAccTwilioAutopilotFieldValue_synonym(t *testing.T) {
 	synonymStateResourceName := fmt.Sprintf("%s.field_value_synonym", fieldValueResourceName)
 	stateResourceName := fmt.Sprintf("%s.field_value", fieldValueResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -17,7 +17,7 @@ var modelBuildResourceName = "twilio_autopilot_model_build"
 
 func TestAccTwilioAutopilotModelBuild_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.model_build", modelBuildResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	modelBuildUniqueNamePrefix := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
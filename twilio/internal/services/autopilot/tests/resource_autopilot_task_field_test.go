@@ -17,7 +17,7 @@ var taskFieldResourceName = "twilio_autopilot_task_field"
 
 func TestAccTwilioAutopilotTaskField_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_field", taskFieldResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	fieldType := "Twilio.YES_NO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var taskSampleResourceName = "twilio_autopilot_task_sample"
 
 func TestAccTwilioAutopilotTaskSample_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_sample", taskSampleResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	taggedText := "test"
 
@@ -55,7 +54,7 @@ func TestAccTwilioAutopilotTaskSample_basic(t *testing.T) {
 
 func TestAccTwilioAutopilotTaskSample_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_sample", taskSampleResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	taggedText := "test"
 	newTaggedText := "new test"
@@ -105,7 +104,7 @@ func TestAccTwilioAutopilotTaskSample_update(t *testing.T) {
 
 func TestAccTwilioAutopilotTaskSample_sourceChannel(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_sample", taskSampleResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	language := "en-US"
 	taggedText := "test"
 	sourceChannel := "chat"
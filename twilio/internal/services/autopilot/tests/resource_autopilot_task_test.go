@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -201,7 +200,7 @@ var taskResourceName = "twilio_autopilot_task"
 
 func TestAccTwilioAutopilotTask_updateActions(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task", taskResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
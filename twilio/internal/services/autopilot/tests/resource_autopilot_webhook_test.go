@@ -18,7 +18,7 @@ var webhookResourceName = "twilio_autopilot_webhook"
 
 func TestAccTwilioAutopilotWebhook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.webhook", webhookResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "http://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -210,7 +210,7 @@ func TestAccTwilioAutopilotWebhook_webhookMethod(t *testing.T) {
 }
 
 func TestAccTwilioAutopilotWebhook_invalidWebhookMethod(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	method := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -227,7 +227,7 @@ func TestAccTwilioAutopilotWebhook_invalidWebhookMethod(t *testing.T) {
 }
 
 func TestAccTwilioAutopilotWebhook_invalidWebhookURL(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "webhookURL"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_autopilot_assistant", &resource.Sweeper{
+		Name: "twilio_autopilot_assistant",
+		F:    sweepAutopilotAssistants,
+	})
+}
+
+func sweepAutopilotAssistants(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Autopilot
+
+	ctx := context.Background()
+	paginator := client.Assistants.NewAssistantsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list autopilot assistants: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Assistants {
+		if !acceptance.HasResourcePrefix(item.UniqueName) {
+			continue
+		}
+
+		acceptance.LogSweep("autopilot assistant", item.Sid, item.UniqueName)
+		if err := client.Assistant(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("autopilot assistant", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
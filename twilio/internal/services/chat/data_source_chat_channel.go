@@ -93,7 +93,7 @@ func dataSourceChatChannelRead(ctx context.Context, d *schema.ResourceData, meta
 				return diag.Errorf("Channel with sid (%s) was not found for chat service with sid (%s)", sid, serviceSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat channel: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -91,7 +91,7 @@ func dataSourceChatChannelMemberRead(ctx context.Context, d *schema.ResourceData
 				return diag.Errorf("Channel member with sid (%s) was not found for chat service with sid (%s) and channel with sid (%s)", sid, serviceSid, channelSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat channel member: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel member", err)
 	}
 
 	d.SetId(getResponse.Sid)
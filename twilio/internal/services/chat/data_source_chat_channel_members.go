@@ -21,7 +21,7 @@ func dataSourceChatChannelMembers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("members", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -80,7 +80,7 @@ func dataSourceChatChannelMembers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -101,7 +101,7 @@ func dataSourceChatChannelMembersRead(ctx context.Context, d *schema.ResourceDat
 				return diag.Errorf("No channel members were found for chat service with sid (%s) and channel with sid (%s)", serviceSid, channelSid)
 			}
 		}
-		return diag.Errorf("Failed to list chat channel members: %s", err.Error())
+		return utils.TranslateError("Failed to list chat channel members", err)
 	}
 
 	d.SetId(serviceSid + "/" + channelSid)
@@ -134,7 +134,11 @@ func dataSourceChatChannelMembersRead(ctx context.Context, d *schema.ResourceDat
 		members = append(members, memberMap)
 	}
 
-	d.Set("members", &members)
+	filteredMembers, err := utils.FilterListItems(d, members)
+	if err != nil {
+		return diag.Errorf("Failed to filter members: %s", err.Error())
+	}
+	d.Set("members", &filteredMembers)
 
 	return nil
 }
@@ -114,7 +114,7 @@ func dataSourceChatChannelWebhookRead(ctx context.Context, d *schema.ResourceDat
 				return diag.Errorf("Channel webhook with sid (%s) was not found for chat service with sid (%s) and channel with sid (%s)", sid, serviceSid, channelSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel webhook", err)
 	}
 
 	d.SetId(getResponse.Sid)
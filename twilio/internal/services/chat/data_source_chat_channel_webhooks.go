@@ -22,7 +22,7 @@ func dataSourceChatChannelWebhooks() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("webhooks", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -103,7 +103,7 @@ func dataSourceChatChannelWebhooks() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -124,7 +124,7 @@ func dataSourceChatChannelWebhooksRead(ctx context.Context, d *schema.ResourceDa
 				return diag.Errorf("No channel webhooks were found for chat service with sid (%s) and channel with sid (%s)", serviceSid, channelSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel webhook", err)
 	}
 
 	d.SetId(serviceSid + "/" + channelSid)
@@ -152,7 +152,11 @@ func dataSourceChatChannelWebhooksRead(ctx context.Context, d *schema.ResourceDa
 		webhooks = append(webhooks, webhookMap)
 	}
 
-	d.Set("webhooks", &webhooks)
+	filteredWebhooks, err := utils.FilterListItems(d, webhooks)
+	if err != nil {
+		return diag.Errorf("Failed to filter webhooks: %s", err.Error())
+	}
+	d.Set("webhooks", &filteredWebhooks)
 
 	return nil
 }
@@ -21,7 +21,7 @@ func dataSourceChatChannels() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("channels", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -83,7 +83,7 @@ func dataSourceChatChannels() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -103,7 +103,7 @@ func dataSourceChatChannelsRead(ctx context.Context, d *schema.ResourceData, met
 				return diag.Errorf("No channels were found for chat service with sid (%s)", serviceSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat channel: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel", err)
 	}
 
 	d.SetId(serviceSid)
@@ -135,7 +135,11 @@ func dataSourceChatChannelsRead(ctx context.Context, d *schema.ResourceData, met
 		channels = append(channels, channelMap)
 	}
 
-	d.Set("channels", &channels)
+	filteredChannels, err := utils.FilterListItems(d, channels)
+	if err != nil {
+		return diag.Errorf("Failed to filter channels: %s", err.Error())
+	}
+	d.Set("channels", &filteredChannels)
 
 	return nil
 }
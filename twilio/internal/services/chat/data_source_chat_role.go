@@ -80,7 +80,7 @@ func dataSourceChatRoleRead(ctx context.Context, d *schema.ResourceData, meta in
 				return diag.Errorf("Role with sid (%s) was not found for chat service with sid (%s)", sid, serviceSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat role: %s", err.Error())
+		return utils.TranslateError("Failed to read chat role", err)
 	}
 
 	d.SetId(getResponse.Sid)
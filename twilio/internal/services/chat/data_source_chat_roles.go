@@ -21,7 +21,7 @@ func dataSourceChatRoles() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("roles", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -70,7 +70,7 @@ func dataSourceChatRoles() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -90,7 +90,7 @@ func dataSourceChatRolesRead(ctx context.Context, d *schema.ResourceData, meta i
 				return diag.Errorf("No roles were found for chat service with sid (%s)", serviceSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat role: %s", err.Error())
+		return utils.TranslateError("Failed to read chat role", err)
 	}
 
 	d.SetId(serviceSid)
@@ -118,7 +118,11 @@ func dataSourceChatRolesRead(ctx context.Context, d *schema.ResourceData, meta i
 		roles = append(roles, roleMap)
 	}
 
-	d.Set("roles", &roles)
+	filteredRoles, err := utils.FilterListItems(d, roles)
+	if err != nil {
+		return diag.Errorf("Failed to filter roles: %s", err.Error())
+	}
+	d.Set("roles", &filteredRoles)
 
 	return nil
 }
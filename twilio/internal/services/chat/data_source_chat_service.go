@@ -240,7 +240,7 @@ func dataSourceChatServiceRead(ctx context.Context, d *schema.ResourceData, meta
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Chat service with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read chat service: %s", err.Error())
+		return utils.TranslateError("Failed to read chat service", err)
 	}
 
 	d.SetId(getResponse.Sid)
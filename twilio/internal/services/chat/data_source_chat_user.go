@@ -93,7 +93,7 @@ func dataSourceChatUserRead(ctx context.Context, d *schema.ResourceData, meta in
 				return diag.Errorf("User with sid (%s) was not found for chat service with sid (%s)", sid, serviceSid)
 			}
 		}
-		return diag.Errorf("Failed to read chat user: %s", err.Error())
+		return utils.TranslateError("Failed to read chat user", err)
 	}
 
 	d.SetId(getResponse.Sid)
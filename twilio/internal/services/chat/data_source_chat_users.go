@@ -21,7 +21,7 @@ func dataSourceChatUsers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("users", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -83,7 +83,7 @@ func dataSourceChatUsers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -103,7 +103,7 @@ func dataSourceChatUsersRead(ctx context.Context, d *schema.ResourceData, meta i
 				return diag.Errorf("No users were found for chat service with sid (%s)", serviceSid)
 			}
 		}
-		return diag.Errorf("Failed to list chat users: %s", err.Error())
+		return utils.TranslateError("Failed to list chat users", err)
 	}
 
 	d.SetId(serviceSid)
@@ -135,7 +135,11 @@ func dataSourceChatUsersRead(ctx context.Context, d *schema.ResourceData, meta i
 		users = append(users, userMap)
 	}
 
-	d.Set("users", &users)
+	filteredUsers, err := utils.FilterListItems(d, users)
+	if err != nil {
+		return diag.Errorf("Failed to filter users: %s", err.Error())
+	}
+	d.Set("users", &filteredUsers)
 
 	return nil
 }
@@ -127,7 +127,7 @@ func resourceChatChannelCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Channels.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat channel: %s", err.Error())
+		return utils.TranslateError("Failed to create chat channel", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -146,7 +146,7 @@ func resourceChatChannelRead(ctx context.Context, d *schema.ResourceData, meta i
 				return nil
 			}
 		}
-		return diag.Errorf("Failed to read chat channel: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -181,7 +181,7 @@ func resourceChatChannelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Channel(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat channel: %s", err.Error())
+		return utils.TranslateError("Failed to update chat channel", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -192,7 +192,7 @@ func resourceChatChannelDelete(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Get("service_sid").(string)).Channel(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat channel: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat channel", err)
 	}
 	d.SetId("")
 	return nil
@@ -126,7 +126,7 @@ func resourceChatChannelMemberCreate(ctx context.Context, d *schema.ResourceData
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Members.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat channel member: %s", err.Error())
+		return utils.TranslateError("Failed to create chat channel member", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -145,7 +145,7 @@ func resourceChatChannelMemberRead(ctx context.Context, d *schema.ResourceData,
 				return nil
 			}
 		}
-		return diag.Errorf("Failed to read chat channel member: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel member", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -180,7 +180,7 @@ func resourceChatChannelMemberUpdate(ctx context.Context, d *schema.ResourceData
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Member(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat channel member: %s", err.Error())
+		return utils.TranslateError("Failed to update chat channel member", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -191,7 +191,7 @@ func resourceChatChannelMemberDelete(ctx context.Context, d *schema.ResourceData
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Member(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat channel member: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat channel member", err)
 	}
 	d.SetId("")
 	return nil
@@ -115,7 +115,7 @@ func resourceChatChannelStudioWebhookCreate(ctx context.Context, d *schema.Resou
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Webhooks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to create chat channel webhook", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -134,7 +134,7 @@ func resourceChatChannelStudioWebhookRead(ctx context.Context, d *schema.Resourc
 				return nil
 			}
 		}
-		return diag.Errorf("Failed to read chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel webhook", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -167,7 +167,7 @@ func resourceChatChannelStudioWebhookUpdate(ctx context.Context, d *schema.Resou
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Webhook(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to update chat channel webhook", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -178,7 +178,7 @@ func resourceChatChannelStudioWebhookDelete(ctx context.Context, d *schema.Resou
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Webhook(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat channel webhook", err)
 	}
 	d.SetId("")
 	return nil
@@ -133,7 +133,7 @@ func resourceChatChannelTriggerWebhookCreate(ctx context.Context, d *schema.Reso
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Webhooks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to create chat channel webhook", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -152,7 +152,7 @@ func resourceChatChannelTriggerWebhookRead(ctx context.Context, d *schema.Resour
 				return nil
 			}
 		}
-		return diag.Errorf("Failed to read chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read chat channel webhook", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -189,7 +189,7 @@ func resourceChatChannelTriggerWebhookUpdate(ctx context.Context, d *schema.Reso
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Webhook(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to update chat channel webhook", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -200,7 +200,7 @@ func resourceChatChannelTriggerWebhookDelete(ctx context.Context, d *schema.Reso
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Get("service_sid").(string)).Channel(d.Get("channel_sid").(string)).Webhook(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat channel webhook: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat channel webhook", err)
 	}
 	d.SetId("")
 	return nil
@@ -113,7 +113,7 @@ func resourceChatRoleCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Roles.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat role: %s", err.Error())
+		return utils.TranslateError("Failed to create chat role", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -132,7 +132,7 @@ func resourceChatRoleRead(ctx context.Context, d *schema.ResourceData, meta inte
 				return nil
 			}
 		}
-		return diag.Errorf("Failed to read chat role: %s", err.Error())
+		return utils.TranslateError("Failed to read chat role", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -161,7 +161,7 @@ func resourceChatRoleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Role(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat role: %s", err.Error())
+		return utils.TranslateError("Failed to update chat role", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -172,7 +172,7 @@ func resourceChatRoleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Get("service_sid").(string)).Role(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat role: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat role", err)
 	}
 	d.SetId("")
 	return nil
@@ -303,7 +303,7 @@ func resourceChatServiceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	createResult, err := client.Services.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat service: %s", err.Error())
+		return utils.TranslateError("Failed to create chat service", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -321,7 +321,7 @@ func resourceChatServiceRead(ctx context.Context, d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read chat service: %s", err.Error())
+		return utils.TranslateError("Failed to read chat service", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -424,7 +424,7 @@ func resourceChatServiceUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	updateResp, err := client.Service(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat service: %s", err.Error())
+		return utils.TranslateError("Failed to update chat service", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -435,7 +435,7 @@ func resourceChatServiceDelete(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat service: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat service", err)
 	}
 	d.SetId("")
 	return nil
@@ -129,7 +129,7 @@ func resourceChatUserCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Users.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create chat user: %s", err.Error())
+		return utils.TranslateError("Failed to create chat user", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -148,7 +148,7 @@ func resourceChatUserRead(ctx context.Context, d *schema.ResourceData, meta inte
 				return nil
 			}
 		}
-		return diag.Errorf("Failed to read chat user: %s", err.Error())
+		return utils.TranslateError("Failed to read chat user", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -183,7 +183,7 @@ func resourceChatUserUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).User(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update chat user: %s", err.Error())
+		return utils.TranslateError("Failed to update chat user", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -194,7 +194,7 @@ func resourceChatUserDelete(ctx context.Context, d *schema.ResourceData, meta in
 	client := meta.(*common.TwilioClient).Chat
 
 	if err := client.Service(d.Get("service_sid").(string)).User(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete chat user: %s", err.Error())
+		return utils.TranslateError("Failed to delete chat user", err)
 	}
 	d.SetId("")
 	return nil
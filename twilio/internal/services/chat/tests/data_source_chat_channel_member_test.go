@@ -14,7 +14,7 @@ var channelMemberDataSourceName = "twilio_chat_channel_member"
 
 func TestAccDataSourceTwilioChatChannelMember_basic(t *testing.T) {
 	stateDataSource := fmt.Sprintf("data.%s.member", channelMemberDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -14,7 +14,7 @@ var channelMembersDataSourceName = "twilio_chat_channel_members"
 
 func TestAccDataSourceTwilioChatChannelMembers_basic(t *testing.T) {
 	stateDataSource := fmt.Sprintf("data.%s.members", channelMembersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
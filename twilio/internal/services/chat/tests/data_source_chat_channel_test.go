@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var channelDataSourceName = "twilio_chat_channel"
 
 func TestAccDataSourceTwilioChatChannel_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.channel", channelDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	channelType := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
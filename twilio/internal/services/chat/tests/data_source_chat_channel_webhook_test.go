@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var channelWebhookDataSourceName = "twilio_chat_channel_webhook"
 
 func TestAccDataSourceTwilioChatChannelWebhook_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.webhook", channelWebhookDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
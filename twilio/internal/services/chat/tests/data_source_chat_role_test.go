@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -15,7 +14,7 @@ var roleDataSourceName = "twilio_chat_role"
 
 func TestAccDataSourceTwilioChatRole_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.role", roleDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	permissions := []string{
 		"sendMessage",
 		"leaveChannel",
@@ -14,7 +14,7 @@ var userDataSourceName = "twilio_chat_user"
 
 func TestAccDataSourceTwilioChatUser_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.user", userDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -14,7 +14,7 @@ var usersDataSourceName = "twilio_chat_users"
 
 func TestAccDataSourceTwilioChatUsers_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.users", usersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,7 +17,7 @@ var channelMemberResourceName = "twilio_chat_channel_member"
 
 func TestAccTwilioChatChannelMember_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.member", channelMemberResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +56,7 @@ func TestAccTwilioChatChannelMember_basic(t *testing.T) {
 
 func TestAccTwilioChatChannelMember_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.member", channelMemberResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 	attributes := `{"test":"test"}`
 
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var channelResourceName = "twilio_chat_channel"
 
 func TestAccTwilioChatChannel_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.channel", channelResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	channelType := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,8 +56,8 @@ func TestAccTwilioChatChannel_basic(t *testing.T) {
 
 func TestAccTwilioChatChannel_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.channel", channelResourceName)
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 	channelType := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +110,7 @@ func TestAccTwilioChatChannel_update(t *testing.T) {
 }
 
 func TestAccTwilioChatChannel_invalidType(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	channelType := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var channelTriggerWebhookResourceName = "twilio_chat_channel_trigger_webhook"
 
 func TestAccTwilioChatChannelTriggerWebhook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.trigger_webhook", channelTriggerWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,7 +56,7 @@ func TestAccTwilioChatChannelTriggerWebhook_basic(t *testing.T) {
 
 func TestAccTwilioChatChannelTriggerWebhook_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.trigger_webhook", channelTriggerWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 	newWebhookURL := "https://localhost.com/new"
 
@@ -111,7 +110,7 @@ func TestAccTwilioChatChannelTriggerWebhook_update(t *testing.T) {
 }
 
 func TestAccTwilioChatChannelTriggerWebhook_invalidWebhookURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
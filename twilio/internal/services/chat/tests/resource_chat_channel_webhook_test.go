@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var channelWebhookResourceName = "twilio_chat_channel_webhook"
 
 func TestAccTwilioChatChannelWebhook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.webhook", channelWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccTwilioChatChannelWebhook_basic(t *testing.T) {
 }
 
 func TestAccTwilioChatChannelWebhook_invalidWebhookURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccTwilioChatChannelWebhook_invalidWebhookURL(t *testing.T) {
 
 func TestAccTwilioChatChannelWebhook_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.webhook", channelWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 	newWebhookURL := "https://localhost.com/new"
 
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -18,7 +17,7 @@ var roleResourceName = "twilio_chat_role"
 
 func TestAccTwilioChatRole_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.role", roleResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	permissions := []string{
 		"sendMessage",
 		"leaveChannel",
@@ -59,7 +58,7 @@ func TestAccTwilioChatRole_basic(t *testing.T) {
 }
 
 func TestAccTwilioChatRole_invalidType(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	permissions := []string{
 		"sendMessage",
 		"leaveChannel",
@@ -81,7 +80,7 @@ func TestAccTwilioChatRole_invalidType(t *testing.T) {
 
 func TestAccTwilioChatRole_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.role", roleResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	permissions := []string{
 		"sendMessage",
 		"leaveChannel",
@@ -17,7 +17,7 @@ var serviceResourceName = "twilio_chat_service"
 
 func TestAccTwilioChatService_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -190,7 +190,7 @@ func TestAccTwilioChatService_basicUpdate(t *testing.T) {
 }
 
 func TestAccTwilioChatChannelWebhook_invalidPostWebhookURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -206,7 +206,7 @@ func TestAccTwilioChatChannelWebhook_invalidPostWebhookURL(t *testing.T) {
 }
 
 func TestAccTwilioChatChannelWebhook_invalidPreWebhookURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -223,7 +223,7 @@ func TestAccTwilioChatChannelWebhook_invalidPreWebhookURL(t *testing.T) {
 
 func TestAccTwilioChatService_notifications(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	logEnabled := true
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -250,7 +250,7 @@ func TestAccTwilioChatService_notifications(t *testing.T) {
 
 func TestAccTwilioChatService_notificationsUpdate(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	logEnabled := true
 	newLogEnabled := false
 
@@ -291,7 +291,7 @@ func TestAccTwilioChatService_notificationsUpdate(t *testing.T) {
 
 func TestAccTwilioChatService_reachabilityEnabled(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -17,7 +17,7 @@ var userResourceName = "twilio_chat_user"
 
 func TestAccTwilioChatUser_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.user", userResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,7 +57,7 @@ func TestAccTwilioChatUser_basic(t *testing.T) {
 
 func TestAccTwilioChatUser_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.user", userResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	userFriendlyName := ""
 	newUserFriendlyName := acctest.RandString(256)
 	identity := acctest.RandString(10)
@@ -95,7 +95,7 @@ func TestAccTwilioChatUser_friendlyName(t *testing.T) {
 func TestAccTwilioChatUser_role(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.user", userResourceName)
 	roleStateResourceName := "twilio_chat_role.role"
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
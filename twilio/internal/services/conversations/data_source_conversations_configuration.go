@@ -55,7 +55,7 @@ func dataSourceConversationsConfigurationRead(ctx context.Context, d *schema.Res
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation configuration was not found")
 		}
-		return diag.Errorf("Failed to read conversation configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation configuration", err)
 	}
 
 	d.SetId(getResponse.AccountSid)
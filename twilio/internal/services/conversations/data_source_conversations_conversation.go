@@ -95,7 +95,7 @@ func dataSourceConversationsConversationRead(ctx context.Context, d *schema.Reso
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation with sid (%s) was not found for service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read conversations conversation: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations conversation", err)
 	}
 
 	d.SetId(getResponse.Sid)
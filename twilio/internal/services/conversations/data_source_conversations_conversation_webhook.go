@@ -107,7 +107,7 @@ func dataSourceConversationsConversationWebhookRead(ctx context.Context, d *sche
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation webhook with sid (%s) was not found for service with sid (%s) and conversation with (%s)", sid, serviceSid, conversationSid)
 		}
-		return diag.Errorf("Failed to read conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation webhook", err)
 	}
 
 	d.SetId(getResponse.Sid)
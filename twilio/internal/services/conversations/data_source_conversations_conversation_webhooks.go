@@ -18,7 +18,7 @@ func dataSourceConversationsConversationWebhooks() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("webhooks", map[string]*schema.Schema{
 			"account_sid": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -99,7 +99,7 @@ func dataSourceConversationsConversationWebhooks() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -117,7 +117,7 @@ func dataSourceConversationsConversationWebhooksRead(ctx context.Context, d *sch
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No conversation webhooks were found for service with sid (%s) and conversation with (%s)", serviceSid, conversationSid)
 		}
-		return diag.Errorf("Failed to read conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation webhook", err)
 	}
 
 	d.SetId(serviceSid + "/" + conversationSid)
@@ -155,7 +155,11 @@ func dataSourceConversationsConversationWebhooksRead(ctx context.Context, d *sch
 		webhooks = append(webhooks, webhookMap)
 	}
 
-	d.Set("webhooks", &webhooks)
+	filteredWebhooks, err := utils.FilterListItems(d, webhooks)
+	if err != nil {
+		return diag.Errorf("Failed to filter webhooks: %s", err.Error())
+	}
+	d.Set("webhooks", &filteredWebhooks)
 
 	return nil
 }
@@ -18,7 +18,7 @@ func dataSourceConversationsConversations() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("conversations", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -88,7 +88,7 @@ func dataSourceConversationsConversations() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -105,7 +105,7 @@ func dataSourceConversationsConversationsRead(ctx context.Context, d *schema.Res
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No conversations were found for conversations service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list conversations: %s", err.Error())
+		return utils.TranslateError("Failed to list conversations", err)
 	}
 
 	d.SetId(serviceSid)
@@ -149,7 +149,11 @@ func dataSourceConversationsConversationsRead(ctx context.Context, d *schema.Res
 		conversations = append(conversations, conversationMap)
 	}
 
-	d.Set("conversations", &conversations)
+	filteredConversations, err := utils.FilterListItems(d, conversations)
+	if err != nil {
+		return diag.Errorf("Failed to filter conversations: %s", err.Error())
+	}
+	d.Set("conversations", &filteredConversations)
 
 	return nil
 }
@@ -74,7 +74,7 @@ func dataSourceConversationsRoleRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation role with sid (%s) was not found for service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read conversation role: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation role", err)
 	}
 
 	d.SetId(getResponse.Sid)
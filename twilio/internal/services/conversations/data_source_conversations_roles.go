@@ -18,7 +18,7 @@ func dataSourceConversationsRoles() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("roles", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -67,7 +67,7 @@ func dataSourceConversationsRoles() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -84,7 +84,7 @@ func dataSourceConversationsRolesRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No roles were found for conversations service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list conversations roles: %s", err.Error())
+		return utils.TranslateError("Failed to list conversations roles", err)
 	}
 
 	d.SetId(serviceSid)
@@ -112,7 +112,11 @@ func dataSourceConversationsRolesRead(ctx context.Context, d *schema.ResourceDat
 		roles = append(roles, roleMap)
 	}
 
-	d.Set("roles", &roles)
+	filteredRoles, err := utils.FilterListItems(d, roles)
+	if err != nil {
+		return diag.Errorf("Failed to filter roles: %s", err.Error())
+	}
+	d.Set("roles", &filteredRoles)
 
 	return nil
 }
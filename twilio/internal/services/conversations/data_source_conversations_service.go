@@ -53,7 +53,7 @@ func dataSourceConversationsServiceRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversations service with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read conversations service: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations service", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -57,7 +57,7 @@ func dataSourceConversationsServiceConfigurationRead(ctx context.Context, d *sch
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation configuration was not found for service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read conversations service configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations service configuration", err)
 	}
 
 	d.SetId(getResponse.ChatServiceSid)
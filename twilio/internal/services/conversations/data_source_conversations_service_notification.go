@@ -114,7 +114,7 @@ func dataSourceConversationsServiceNotificationRead(ctx context.Context, d *sche
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation notification was not found for service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read conversations service notification: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations service notification", err)
 	}
 
 	d.SetId(getResponse.ChatServiceSid)
@@ -83,7 +83,7 @@ func dataSourceConversationsUserRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation user with sid (%s) was not found for service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read conversations user: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations user", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceConversationsUsers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("users", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -76,7 +76,7 @@ func dataSourceConversationsUsers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -93,7 +93,7 @@ func dataSourceConversationsUsersRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No users were found for conversations service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list conversations users: %s", err.Error())
+		return utils.TranslateError("Failed to list conversations users", err)
 	}
 
 	d.SetId(serviceSid)
@@ -124,7 +124,11 @@ func dataSourceConversationsUsersRead(ctx context.Context, d *schema.ResourceDat
 		users = append(users, userMap)
 	}
 
-	d.Set("users", &users)
+	filteredUsers, err := utils.FilterListItems(d, users)
+	if err != nil {
+		return diag.Errorf("Failed to filter users: %s", err.Error())
+	}
+	d.Set("users", &filteredUsers)
 
 	return nil
 }
@@ -62,7 +62,7 @@ func dataSourceConversationsWebhookRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Conversation webhook was not found")
 		}
-		return diag.Errorf("Failed to read conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation webhook", err)
 	}
 
 	d.SetId(getResponse.AccountSid)
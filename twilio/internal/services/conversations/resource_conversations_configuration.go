@@ -73,7 +73,7 @@ func resourceConversationsConfigurationRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations configuration", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -98,7 +98,7 @@ func resourceConversationsConfigurationUpdate(ctx context.Context, d *schema.Res
 
 	updateResp, err := client.Configuration().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations configuration: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations configuration", err)
 	}
 
 	d.SetId(updateResp.AccountSid)
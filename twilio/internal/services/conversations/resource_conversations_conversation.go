@@ -157,7 +157,7 @@ func resourceConversationsConversationCreate(ctx context.Context, d *schema.Reso
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Conversations.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversations conversation: %s", err.Error())
+		return utils.TranslateError("Failed to create conversations conversation", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -173,7 +173,7 @@ func resourceConversationsConversationRead(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations conversation: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations conversation", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -216,7 +216,7 @@ func resourceConversationsConversationUpdate(ctx context.Context, d *schema.Reso
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations conversation: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations conversation", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -227,7 +227,7 @@ func resourceConversationsConversationDelete(ctx context.Context, d *schema.Reso
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Service(d.Get("service_sid").(string)).Conversation(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversations conversation: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversations conversation", err)
 	}
 	d.SetId("")
 	return nil
@@ -110,7 +110,7 @@ func resourceConversationsConversationStudioWebhookCreate(ctx context.Context, d
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Webhooks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to create conversation webhook", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -126,7 +126,7 @@ func resourceConversationsConversationStudioWebhookRead(ctx context.Context, d *
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation webhook", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -158,7 +158,7 @@ func resourceConversationsConversationStudioWebhookUpdate(ctx context.Context, d
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Webhook(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to update conversation webhook", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -169,7 +169,7 @@ func resourceConversationsConversationStudioWebhookDelete(ctx context.Context, d
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Webhook(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversation webhook", err)
 	}
 	d.SetId("")
 	return nil
@@ -129,7 +129,7 @@ func resourceConversationsConversationWebhookCreate(ctx context.Context, d *sche
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Webhooks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to create conversation webhook", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -145,7 +145,7 @@ func resourceConversationsConversationWebhookRead(ctx context.Context, d *schema
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation webhook", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -181,7 +181,7 @@ func resourceConversationsConversationWebhookUpdate(ctx context.Context, d *sche
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Webhook(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to update conversation webhook", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -192,7 +192,7 @@ func resourceConversationsConversationWebhookDelete(ctx context.Context, d *sche
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Service(d.Get("service_sid").(string)).Conversation(d.Get("conversation_sid").(string)).Webhook(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversation webhook", err)
 	}
 	d.SetId("")
 	return nil
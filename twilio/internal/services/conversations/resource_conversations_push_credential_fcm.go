@@ -96,7 +96,7 @@ func resourceConversationsPushCredentialFCMCreate(ctx context.Context, d *schema
 
 	createResult, err := client.Credentials.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversations credential: %s", err.Error())
+		return utils.TranslateError("Failed to create conversations credential", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -112,7 +112,7 @@ func resourceConversationsPushCredentialFCMRead(ctx context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations credential: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations credential", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -140,7 +140,7 @@ func resourceConversationsPushCredentialFCMUpdate(ctx context.Context, d *schema
 
 	updateResp, err := client.Credential(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations credential: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations credential", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -151,7 +151,7 @@ func resourceConversationsPushCredentialFCMDelete(ctx context.Context, d *schema
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Credential(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversations credential: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversations credential", err)
 	}
 	d.SetId("")
 	return nil
@@ -139,7 +139,7 @@ func resourceConversationsRoleCreate(ctx context.Context, d *schema.ResourceData
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Roles.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversations role: %s", err.Error())
+		return utils.TranslateError("Failed to create conversations role", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -155,7 +155,7 @@ func resourceConversationsRoleRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations role: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations role", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -184,7 +184,7 @@ func resourceConversationsRoleUpdate(ctx context.Context, d *schema.ResourceData
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Role(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations role: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations role", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -195,7 +195,7 @@ func resourceConversationsRoleDelete(ctx context.Context, d *schema.ResourceData
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Service(d.Get("service_sid").(string)).Role(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversations role: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversations role", err)
 	}
 	d.SetId("")
 	return nil
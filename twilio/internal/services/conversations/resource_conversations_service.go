@@ -78,7 +78,7 @@ func resourceConversationsServiceCreate(ctx context.Context, d *schema.ResourceD
 
 	createResult, err := client.Services.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversations service: %s", err.Error())
+		return utils.TranslateError("Failed to create conversations service", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -94,7 +94,7 @@ func resourceConversationsServiceRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations service: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations service", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -115,7 +115,7 @@ func resourceConversationsServiceDelete(ctx context.Context, d *schema.ResourceD
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Service(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversations service: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversations service", err)
 	}
 	d.SetId("")
 	return nil
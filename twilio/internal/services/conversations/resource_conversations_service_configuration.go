@@ -76,7 +76,7 @@ func resourceConversationsServiceConfigurationRead(ctx context.Context, d *schem
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations service configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations service configuration", err)
 	}
 
 	d.Set("service_sid", getResponse.ChatServiceSid)
@@ -101,7 +101,7 @@ func resourceConversationsServiceConfigurationUpdate(ctx context.Context, d *sch
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Configuration().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations service configuration: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations service configuration", err)
 	}
 
 	d.SetId(updateResp.ChatServiceSid)
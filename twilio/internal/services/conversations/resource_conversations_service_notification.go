@@ -139,7 +139,7 @@ func resourceConversationsServiceNotificationRead(ctx context.Context, d *schema
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations service notification: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations service notification", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -187,7 +187,7 @@ func resourceConversationsServiceNotificationUpdate(ctx context.Context, d *sche
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Configuration().Notification().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations service notification: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations service notification", err)
 	}
 
 	d.SetId(updateResp.ChatServiceSid)
@@ -121,7 +121,7 @@ func resourceConversationsUserCreate(ctx context.Context, d *schema.ResourceData
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Users.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create conversations user: %s", err.Error())
+		return utils.TranslateError("Failed to create conversations user", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -137,7 +137,7 @@ func resourceConversationsUserRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversations user: %s", err.Error())
+		return utils.TranslateError("Failed to read conversations user", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -171,7 +171,7 @@ func resourceConversationsUserUpdate(ctx context.Context, d *schema.ResourceData
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).User(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations user: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations user", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -182,7 +182,7 @@ func resourceConversationsUserDelete(ctx context.Context, d *schema.ResourceData
 	client := meta.(*common.TwilioClient).Conversations
 
 	if err := client.Service(d.Get("service_sid").(string)).User(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete conversations user: %s", err.Error())
+		return utils.TranslateError("Failed to delete conversations user", err)
 	}
 	d.SetId("")
 	return nil
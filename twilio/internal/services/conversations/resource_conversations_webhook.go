@@ -115,7 +115,7 @@ func resourceConversationsWebhookRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read conversation webhook: %s", err.Error())
+		return utils.TranslateError("Failed to read conversation webhook", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -142,7 +142,7 @@ func resourceConversationsWebhookUpdate(ctx context.Context, d *schema.ResourceD
 
 	updateResp, err := client.Webhook().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update conversations webhook: %s", err.Error())
+		return utils.TranslateError("Failed to update conversations webhook", err)
 	}
 
 	d.SetId(updateResp.AccountSid)
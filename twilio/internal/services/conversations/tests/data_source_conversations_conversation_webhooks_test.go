@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var conversationWebhooksDataSourceName = "twilio_conversations_conversation_webh
 
 func TestAccDataSourceTwilioConversationsConversationWebhooks_basic(t *testing.T) {
 	stateDataSource := fmt.Sprintf("data.%s.webhooks", conversationWebhooksDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
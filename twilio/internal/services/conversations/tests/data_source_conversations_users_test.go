@@ -14,7 +14,7 @@ var usersDataSourceName = "twilio_conversations_users"
 
 func TestAccDataSourceTwilioConversationsUsers_basic(t *testing.T) {
 	stateDataSource := fmt.Sprintf("data.%s.users", usersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var conversationStudioWebhookResourceName = "twilio_conversations_conversation_s
 
 func TestAccTwilioConversationsConversationStudioWebhook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.studio_webhook", conversationStudioWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	flowSid := "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -54,7 +53,7 @@ func TestAccTwilioConversationsConversationStudioWebhook_basic(t *testing.T) {
 
 func TestAccTwilioConversationsConversationStudioWebhook_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.studio_webhook", conversationStudioWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	flowSid := "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 	newFlowSid := "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1"
 
@@ -17,7 +17,7 @@ var conversationResourceName = "twilio_conversations_conversation"
 
 func TestAccTwilioConversationsConversation_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -56,7 +56,7 @@ func TestAccTwilioConversationsConversation_basic(t *testing.T) {
 
 func TestAccTwilioConversationsConversation_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	closedTimer := "PT20M"
 	inactiveTimer := "PT15M"
@@ -110,7 +110,7 @@ func TestAccTwilioConversationsConversation_update(t *testing.T) {
 
 func TestAccTwilioConversationsConversation_attributes(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -157,9 +157,9 @@ func TestAccTwilioConversationsConversation_invalidAttributesString(t *testing.T
 
 func TestAccTwilioConversationsConversation_uniqueName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	uniqueName := ""
-	newUniqueName := acctest.RandString(10)
+	newUniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -193,7 +193,7 @@ func TestAccTwilioConversationsConversation_uniqueName(t *testing.T) {
 
 func TestAccTwilioConversationsConversation_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	conversationFriendlyName := ""
 	newConversationFriendlyName := acctest.RandString(256)
 
@@ -245,7 +245,7 @@ func TestAccTwilioConversationsConversation_invalidFriendlyNameWith257Characters
 
 func TestAccTwilioConversationsConversation_state(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.conversation", conversationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	newState := "inactive"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -279,7 +279,7 @@ func TestAccTwilioConversationsConversation_state(t *testing.T) {
 }
 
 func TestAccTwilioConversationsConversation_invalidState(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	state := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -299,7 +299,7 @@ func TestAccTwilioConversationsConversation_messagingService(t *testing.T) {
 	messagingServiceStateResourceName := "twilio_messaging_service.service"
 	configurationDataStateResourceName := "data.twilio_conversations_configuration.configuration"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var conversationTriggerWebhookResourceName = "twilio_conversations_conversation_
 
 func TestAccTwilioConversationsConversationTriggerWebhook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.trigger_webhook", conversationTriggerWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccTwilioConversationsConversationTriggerWebhook_basic(t *testing.T) {
 
 func TestAccTwilioConversationsConversationTriggerWebhook_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.trigger_webhook", conversationTriggerWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 	newWebhookURL := "https://localhost.com/new"
 
@@ -108,7 +107,7 @@ func TestAccTwilioConversationsConversationTriggerWebhook_update(t *testing.T) {
 }
 
 func TestAccTwilioConversationsConversationTriggerWebhook_invalidWebhookURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -124,7 +123,7 @@ func TestAccTwilioConversationsConversationTriggerWebhook_invalidWebhookURL(t *t
 }
 
 func TestAccTwilioConversationsConversationTriggerWebhook_invalidMethod(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	method := "DELETE"
 	webhookURL := "https://localhost.com/webhook"
 
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var conversationWebhookResourceName = "twilio_conversations_conversation_webhook
 
 func TestAccTwilioConversationsConversationWebhook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.webhook", conversationWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccTwilioConversationsConversationWebhook_basic(t *testing.T) {
 
 func TestAccTwilioConversationsConversationWebhook_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.webhook", conversationWebhookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "https://localhost.com/webhook"
 	newWebhookURL := "https://localhost.com/new"
 
@@ -108,7 +107,7 @@ func TestAccTwilioConversationsConversationWebhook_update(t *testing.T) {
 }
 
 func TestAccTwilioConversationsConversationWebhook_invalidWebhookURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	webhookURL := "webhook"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -150,7 +149,7 @@ func TestAccTwilioConversationsConversationWebhook_invalidConversationSid(t *tes
 }
 
 func TestAccTwilioConversationsConversationWebhook_invalidMethod(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	method := "DELETE"
 	webhookURL := "https://localhost.com/webhook"
 
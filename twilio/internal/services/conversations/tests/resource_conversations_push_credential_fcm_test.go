@@ -17,7 +17,7 @@ var pushCredentialsFCMResourceName = "twilio_conversations_push_credential_fcm"
 
 func TestAccTwilioConversationsPushCredentialsFCM_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.push_credential_fcm", pushCredentialsFCMResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	secret := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,7 +53,7 @@ func TestAccTwilioConversationsPushCredentialsFCM_basic(t *testing.T) {
 
 func TestAccTwilioConversationsPushCredentialsFCM_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.push_credential_fcm", pushCredentialsFCMResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	secret := acctest.RandString(10)
 	newSecret := acctest.RandString(10)
 
@@ -18,7 +18,7 @@ var roleResourceName = "twilio_conversations_role"
 
 func TestAccTwilioConversationsRole_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.role", roleResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	typeName := "conversation"
 	permissions := []string{"sendMessage"}
 
@@ -56,7 +56,7 @@ func TestAccTwilioConversationsRole_basic(t *testing.T) {
 
 func TestAccTwilioConversationsRole_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.role", roleResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	typeName := "conversation"
 	permissions := []string{"sendMessage"}
 	newPermissions := []string{"sendMediaMessage", "sendMessage"}
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -16,7 +15,7 @@ var serviceConfigurationResourceName = "twilio_conversations_service_configurati
 
 func TestAccTwilioConversationsServiceConfiguration_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_configuration", serviceConfigurationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -41,7 +40,7 @@ func TestAccTwilioConversationsServiceConfiguration_basic(t *testing.T) {
 
 func TestAccTwilioConversationsServiceConfiguration_reachabilityIndicator(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_configuration", serviceConfigurationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
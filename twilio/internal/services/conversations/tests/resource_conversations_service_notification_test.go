@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -16,7 +15,7 @@ var serviceNotificationResourceName = "twilio_conversations_service_notification
 
 func TestAccTwilioConversationsServiceNotification_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_notification", serviceNotificationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -52,7 +51,7 @@ func TestAccTwilioConversationsServiceNotification_basic(t *testing.T) {
 
 func TestAccTwilioConversationsServiceNotification_newMessage(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_notification", serviceNotificationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	enabled := true
 	template := "$${CONVERSATION}:$${PARTICIPANT}: $${MESSAGE}"
 	sound := "bell"
@@ -101,7 +100,7 @@ func TestAccTwilioConversationsServiceNotification_newMessage(t *testing.T) {
 
 func TestAccTwilioConversationsServiceNotification_addedToConversation(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_notification", serviceNotificationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	enabled := true
 	template := "You have been added to the conversation $${CONVERSATION} by $${PARTICIPANT}"
 	sound := "bell"
@@ -146,7 +145,7 @@ func TestAccTwilioConversationsServiceNotification_addedToConversation(t *testin
 
 func TestAccTwilioConversationsServiceNotification_removedFromConversation(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_notification", serviceNotificationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	enabled := true
 	template := "$${PARTICIPANT} has removed you from the conversation $${CONVERSATION}"
 	sound := "bell"
@@ -191,7 +190,7 @@ func TestAccTwilioConversationsServiceNotification_removedFromConversation(t *te
 
 func TestAccTwilioConversationsServiceNotification_logEnabled(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service_notification", serviceNotificationResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -17,7 +17,7 @@ var serviceResourceName = "twilio_conversations_service"
 
 func TestAccTwilioConversationsService_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
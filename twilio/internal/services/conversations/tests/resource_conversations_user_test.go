@@ -17,7 +17,7 @@ var userResourceName = "twilio_conversations_user"
 
 func TestAccTwilioConversationsUser_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.user", userResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,7 +57,7 @@ func TestAccTwilioConversationsUser_basic(t *testing.T) {
 func TestAccTwilioConversationsUser_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.user", userResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	userFriendlyName := ""
 	newUserFriendlyName := acctest.RandString(256)
 	identity := acctest.RandString(10)
@@ -94,7 +94,7 @@ func TestAccTwilioConversationsUser_friendlyName(t *testing.T) {
 }
 
 func TestAccTwilioConversationsUser_invalidFriendlyNameWith257Characters(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	userFriendlyName := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 	identity := acctest.RandString(10)
 
@@ -112,7 +112,7 @@ func TestAccTwilioConversationsUser_invalidFriendlyNameWith257Characters(t *test
 
 func TestAccTwilioConversationsUser_attibutes(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.user", userResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	identity := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_conversations_service", &resource.Sweeper{
+		Name: "twilio_conversations_service",
+		F:    sweepConversationsServices,
+	})
+	resource.AddTestSweepers("twilio_conversations_push_credential", &resource.Sweeper{
+		Name:         "twilio_conversations_push_credential",
+		F:            sweepConversationsPushCredentials,
+		Dependencies: []string{"twilio_conversations_service"},
+	})
+}
+
+func sweepConversationsServices(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Conversations
+
+	ctx := context.Background()
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list conversations services: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Services {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("conversations service", item.Sid, item.FriendlyName)
+		if err := client.Service(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("conversations service", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func sweepConversationsPushCredentials(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Conversations
+
+	ctx := context.Background()
+	paginator := client.Credentials.NewCredentialsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list conversations push credentials: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Credentials {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("conversations push credential", item.Sid, item.FriendlyName)
+		if err := client.Credential(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("conversations push credential", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
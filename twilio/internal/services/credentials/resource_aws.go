@@ -49,11 +49,13 @@ func resourceCredentialsAWS() *schema.Resource {
 				ForceNew: true,
 			},
 			"aws_secret_access_key": {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: utils.SecretArgumentDiffSuppressFunc,
 			},
+			"secret_storage": utils.SecretStorageSchema(false),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,18 +75,20 @@ func resourceCredentialsAWS() *schema.Resource {
 func resourceAWSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Accounts
 
+	awsSecretAccessKey := utils.SecretArgumentFromConfig(d, "aws_secret_access_key")
 	createInput := &aws_credentials.CreateAWSCredentialInput{
-		Credentials:  fmt.Sprintf("%s:%s", d.Get("aws_access_key_id").(string), d.Get("aws_secret_access_key").(string)),
+		Credentials:  fmt.Sprintf("%s:%s", d.Get("aws_access_key_id").(string), awsSecretAccessKey),
 		FriendlyName: utils.OptionalStringWithEmptyStringOnChange(d, "friendly_name"),
 		AccountSid:   utils.OptionalString(d, "account_sid"),
 	}
 
 	createResult, err := client.Credentials.AWSCredentials.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create aws credential: %s", err.Error())
+		return utils.TranslateError("Failed to create aws credential", err)
 	}
 
 	d.SetId(createResult.Sid)
+	d.Set("aws_secret_access_key", utils.SecretValueForState(d, "aws_secret_access_key", awsSecretAccessKey))
 	return resourceAWSRead(ctx, d, meta)
 }
 
@@ -97,7 +101,7 @@ func resourceAWSRead(ctx context.Context, d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read aws credential: %s", err.Error())
+		return utils.TranslateError("Failed to read aws credential", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -122,10 +126,11 @@ func resourceAWSUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	updateResp, err := client.Credentials.AWSCredential(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update aws credential: %s", err.Error())
+		return utils.TranslateError("Failed to update aws credential", err)
 	}
 
 	d.SetId(updateResp.Sid)
+	d.Set("aws_secret_access_key", utils.SecretValueForState(d, "aws_secret_access_key", utils.SecretArgumentFromConfig(d, "aws_secret_access_key")))
 	return resourceAWSRead(ctx, d, meta)
 }
 
@@ -133,7 +138,7 @@ func resourceAWSDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	client := meta.(*common.TwilioClient).Accounts
 
 	if err := client.Credentials.AWSCredential(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete aws credential: %s", err.Error())
+		return utils.TranslateError("Failed to delete aws credential", err)
 	}
 
 	d.SetId("")
@@ -74,7 +74,7 @@ func resourcePublicKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	createResult, err := client.Credentials.PublicKeys.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create public key: %s", err.Error())
+		return utils.TranslateError("Failed to create public key", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -90,7 +90,7 @@ func resourcePublicKeyRead(ctx context.Context, d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read public key: %s", err.Error())
+		return utils.TranslateError("Failed to read public key", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -115,7 +115,7 @@ func resourcePublicKeyUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	updateResp, err := client.Credentials.PublicKey(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update public key: %s", err.Error())
+		return utils.TranslateError("Failed to update public key", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -126,7 +126,7 @@ func resourcePublicKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 	client := meta.(*common.TwilioClient).Accounts
 
 	if err := client.Credentials.PublicKey(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete public key: %s", err.Error())
+		return utils.TranslateError("Failed to delete public key", err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_credentials_aws", &resource.Sweeper{
+		Name: "twilio_credentials_aws",
+		F:    sweepAWSCredentials,
+	})
+	resource.AddTestSweepers("twilio_credentials_public_key", &resource.Sweeper{
+		Name: "twilio_credentials_public_key",
+		F:    sweepPublicKeys,
+	})
+}
+
+func sweepAWSCredentials(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Accounts
+
+	ctx := context.Background()
+	paginator := client.Credentials.AWSCredentials.NewAWSCredentialsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list AWS credentials: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Credentials {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("AWS credential", item.Sid, item.FriendlyName)
+		if err := client.Credentials.AWSCredential(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("AWS credential", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func sweepPublicKeys(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Accounts
+
+	ctx := context.Background()
+	paginator := client.Credentials.PublicKeys.NewPublicKeysPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list public keys: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Credentials {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("public key", item.Sid, item.FriendlyName)
+		if err := client.Credentials.PublicKey(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("public key", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -130,7 +130,7 @@ func dataSourceFlexFlowRead(ctx context.Context, d *schema.ResourceData, meta in
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Flex flow with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read flex channel: %s", err.Error())
+		return utils.TranslateError("Failed to read flex channel", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -107,7 +107,7 @@ func dataSourceFlexPluginRead(ctx context.Context, d *schema.ResourceData, meta
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Flex plugin with sid/ unique name (%s) was not found", identifier)
 		}
-		return diag.Errorf("Failed to read flex plugin: %s", err.Error())
+		return utils.TranslateError("Failed to read flex plugin", err)
 	}
 
 	versionsPaginator := client.Plugin(getResponse.Sid).Versions.NewVersionsPaginatorWithOptions(&versions.VersionsPageOptions{
@@ -118,7 +118,7 @@ func dataSourceFlexPluginRead(ctx context.Context, d *schema.ResourceData, meta
 	versionsPaginator.Next()
 
 	if versionsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read flex plugin versions: %s", versionsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read flex plugin versions", versionsPaginator.Error())
 	}
 
 	d.SetId(getResponse.Sid)
@@ -102,7 +102,7 @@ func dataSourceFlexPluginConfigurationRead(ctx context.Context, d *schema.Resour
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Flex plugin configuration with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read flex plugin configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read flex plugin configuration", err)
 	}
 
 	paginator := client.PluginConfiguration(sid).Plugins.NewPluginsPaginator()
@@ -114,7 +114,7 @@ func dataSourceFlexPluginConfigurationRead(ctx context.Context, d *schema.Resour
 		if utils.IsNotFoundError(paginatorErr) {
 			return diag.Errorf("No flex plugins were found for plugin configuration with sid (%s)", sid)
 		}
-		return diag.Errorf("Failed to read flex plugin configuration plugins: %s", paginatorErr.Error())
+		return utils.TranslateError("Failed to read flex plugin configuration plugins", paginatorErr)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -53,7 +53,7 @@ func dataSourceFlexPluginReleaseRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Flex plugin release with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read flex plugin release: %s", err.Error())
+		return utils.TranslateError("Failed to read flex plugin release", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -203,7 +203,7 @@ func resourceFlexFlowCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	createResult, err := client.FlexFlows.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create flex flow: %s", err.Error())
+		return utils.TranslateError("Failed to create flex flow", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -219,7 +219,7 @@ func resourceFlexFlowRead(ctx context.Context, d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read flex flow: %s", err.Error())
+		return utils.TranslateError("Failed to read flex flow", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -274,7 +274,7 @@ func resourceFlexFlowUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	createResult, err := client.FlexFlow(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update flex flow: %s", err.Error())
+		return utils.TranslateError("Failed to update flex flow", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -285,7 +285,7 @@ func resourceFlexFlowDelete(ctx context.Context, d *schema.ResourceData, meta in
 	client := meta.(*common.TwilioClient).Flex
 
 	if err := client.FlexFlow(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete flex flow: %s", err.Error())
+		return utils.TranslateError("Failed to delete flex flow", err)
 	}
 	d.SetId("")
 	return nil
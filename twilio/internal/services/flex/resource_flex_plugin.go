@@ -141,7 +141,7 @@ func resourceFlexPluginCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	createResult, err := client.Plugins.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create flex plugin: %s", err.Error())
+		return utils.TranslateError("Failed to create flex plugin", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -162,7 +162,7 @@ func resourceFlexPluginRead(ctx context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read flex plugin: %s", err.Error())
+		return utils.TranslateError("Failed to read flex plugin", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -187,7 +187,7 @@ func resourceFlexPluginRead(ctx context.Context, d *schema.ResourceData, meta in
 	versionsPaginator.Next()
 
 	if versionsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read flex plugin versions: %s", versionsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read flex plugin versions", versionsPaginator.Error())
 	}
 
 	if len(versionsPaginator.Versions) > 0 {
@@ -217,7 +217,7 @@ func resourceFlexPluginUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		updateResp, err := client.Plugin(d.Id()).UpdateWithContext(ctx, updateInput)
 		if err != nil {
-			return diag.Errorf("Failed to update flex plugin: %s", err.Error())
+			return utils.TranslateError("Failed to update flex plugin", err)
 		}
 
 		d.SetId(updateResp.Sid)
@@ -236,7 +236,7 @@ func resourceFlexPluginDelete(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(*common.TwilioClient).Flex
 
 	if _, err := client.Plugin(d.Id()).ArchiveWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to archive flex plugin: %s", err.Error())
+		return utils.TranslateError("Failed to archive flex plugin", err)
 	}
 	d.SetId("")
 	return nil
@@ -251,7 +251,7 @@ func createPluginVersion(ctx context.Context, d *schema.ResourceData, client *fl
 	}
 
 	if _, err := client.Plugin(d.Id()).Versions.CreateWithContext(ctx, createInput); err != nil {
-		return diag.Errorf("Failed to create flex plugin version: %s", err.Error())
+		return utils.TranslateError("Failed to create flex plugin version", err)
 	}
 
 	return nil
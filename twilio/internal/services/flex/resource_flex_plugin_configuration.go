@@ -144,7 +144,7 @@ func resourceFlexPluginConfigurationCreate(ctx context.Context, d *schema.Resour
 
 	createResult, err := client.PluginConfigurations.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create flex plugin configuration: %s", err.Error())
+		return utils.TranslateError("Failed to create flex plugin configuration", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -160,7 +160,7 @@ func resourceFlexPluginConfigurationRead(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read flex plugin configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read flex plugin configuration", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -180,7 +180,7 @@ func resourceFlexPluginConfigurationRead(ctx context.Context, d *schema.Resource
 		if utils.IsNotFoundError(paginatorErr) {
 			return nil
 		}
-		return diag.Errorf("Failed to read flex plugin configuration plugins: %s", paginatorErr.Error())
+		return utils.TranslateError("Failed to read flex plugin configuration plugins", paginatorErr)
 	}
 
 	d.Set("plugins", helper.FlattenPlugins(paginator.Plugins))
@@ -192,7 +192,7 @@ func resourceFlexPluginConfigurationDelete(ctx context.Context, d *schema.Resour
 	client := meta.(*common.TwilioClient).Flex
 
 	if _, err := client.PluginConfiguration(d.Id()).ArchiveWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to archive flex plugin configuration: %s", err.Error())
+		return utils.TranslateError("Failed to archive flex plugin configuration", err)
 	}
 	d.SetId("")
 	return nil
@@ -74,10 +74,15 @@ func resourceFlexPluginRelease() *schema.Resource {
 func resourceFlexPluginReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	createResult, err := createRelease(ctx, d, meta, d.Get("configuration_sid").(string))
 	if err != nil {
-		return diag.Errorf("Failed to create flex plugin release: %s", err.Error())
+		return utils.TranslateError("Failed to create flex plugin release", err)
 	}
 
 	d.SetId(createResult.Sid)
+
+	if err := waitForActiveRelease(ctx, meta, createResult.Sid, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return utils.TranslateError("Failed to wait for flex plugin release to become active", err)
+	}
+
 	return resourceFlexPluginReleaseRead(ctx, d, meta)
 }
 
@@ -90,7 +95,7 @@ func resourceFlexPluginReleaseRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read flex plugin release: %s", err.Error())
+		return utils.TranslateError("Failed to read flex plugin release", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -114,7 +119,7 @@ func resourceFlexPluginReleaseDelete(ctx context.Context, d *schema.ResourceData
 	releasesPaginator.Next()
 
 	if releasesPaginator.Error() != nil {
-		return diag.Errorf("Failed to read flex plugin releases: %s", releasesPaginator.Error().Error())
+		return utils.TranslateError("Failed to read flex plugin releases", releasesPaginator.Error())
 	}
 
 	isCurrentRelease := d.Id() == releasesPaginator.Releases[0].Sid
@@ -123,11 +128,11 @@ func resourceFlexPluginReleaseDelete(ctx context.Context, d *schema.ResourceData
 
 		defaultConfigResp, err := createDefaultConfiguration(ctx, d, meta)
 		if err != nil {
-			return diag.Errorf("Failed to create default configuration during release deletion: %s", err.Error())
+			return utils.TranslateError("Failed to create default configuration during release deletion", err)
 		}
 
 		if _, err := createRelease(ctx, d, meta, defaultConfigResp.Sid); err != nil {
-			return diag.Errorf("Failed to create new flex plugin release deletion: %s", err.Error())
+			return utils.TranslateError("Failed to create new flex plugin release deletion", err)
 		}
 	}
 
@@ -153,3 +158,34 @@ func createRelease(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	return client.PluginReleases.CreateWithContext(ctx, createInput)
 }
+
+// waitForActiveRelease waits until the release is returned as the latest release, which is the release that is active on the Flex instance
+func waitForActiveRelease(ctx context.Context, meta interface{}, sid string, timeout time.Duration) error {
+	client := meta.(*common.TwilioClient).Flex
+
+	waiter := &utils.Waiter{
+		Description: "flex plugin release",
+		Pending:     []string{"pending"},
+		Target:      []string{"active"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			releasesPaginator := client.PluginReleases.NewReleasesPaginatorWithOptions(&plugin_releases.ReleasesPageOptions{
+				PageSize: sdkUtils.Int(5),
+			})
+			releasesPaginator.NextWithContext(ctx)
+			if releasesPaginator.Error() != nil {
+				return nil, "", releasesPaginator.Error()
+			}
+
+			if len(releasesPaginator.Releases) == 0 || releasesPaginator.Releases[0].Sid != sid {
+				return nil, "pending", nil
+			}
+			return releasesPaginator.Releases[0], "active", nil
+		},
+		Timeout:  timeout,
+		Delay:    1 * time.Second,
+		MaxDelay: 10 * time.Second,
+	}
+
+	_, err := waiter.WaitWithContext(ctx)
+	return err
+}
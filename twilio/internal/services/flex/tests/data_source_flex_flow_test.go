@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -15,7 +14,7 @@ var flowDataSourceName = "twilio_flex_flow"
 func TestAccDataSourceTwilioFlexFlow_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.flow", flowDataSourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	channelType := "web"
 	integrationType := "external"
 	integrationURL := "https://test.com/external"
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -15,7 +14,7 @@ var pluginDataSourceName = "twilio_flex_plugin"
 func TestAccDataSourceTwilioFlexPlugin_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.plugin", pluginDataSourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "1.0.0"
 	pluginURL := "https://example.com"
 
@@ -49,7 +48,7 @@ func TestAccDataSourceTwilioFlexPlugin_sid(t *testing.T) {
 func TestAccDataSourceTwilioFlexPlugin_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.plugin", pluginDataSourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "1.0.0"
 	pluginURL := "https://example2.com"
 
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -18,7 +17,7 @@ var flowResourceName = "twilio_flex_flow"
 func TestAccTwilioFlexFlow_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.flow", flowResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	channelType := "web"
 	integrationType := "external"
 	integrationURL := "https://test.com/external"
@@ -69,7 +68,7 @@ func TestAccTwilioFlexFlow_basic(t *testing.T) {
 }
 
 func TestAccTwilioChatChannelWebhook_invalidIntegrationURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	channelType := "web"
 	integrationType := "external"
 	integrationURL := "integrationURL"
@@ -91,8 +90,8 @@ func TestAccTwilioChatChannelWebhook_invalidIntegrationURL(t *testing.T) {
 func TestAccTwilioFlexFlow_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.flow", flowResourceName)
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 	channelType := "web"
 	integrationType := "external"
 	integrationURL := "https://test.com/external"
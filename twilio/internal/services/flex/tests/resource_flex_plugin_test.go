@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -18,7 +17,7 @@ var pluginResourceName = "twilio_flex_plugin"
 func TestAccTwilioFlexPlugin_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.plugin", pluginResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "1.0.0"
 	pluginURL := "https://example.com"
 
@@ -60,10 +59,10 @@ func TestAccTwilioFlexPlugin_basic(t *testing.T) {
 func TestAccTwilioFlexPlugin_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.plugin", pluginResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "1.0.0"
 	pluginURL := "https://example.com"
-	newFriendlyName := acctest.RandString(10)
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_flex_flow", &resource.Sweeper{
+		Name: "twilio_flex_flow",
+		F:    sweepFlexFlows,
+	})
+}
+
+func sweepFlexFlows(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Flex
+
+	ctx := context.Background()
+	paginator := client.FlexFlows.NewFlexFlowsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list flex flows: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.FlexFlows {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("flex flow", item.Sid, item.FriendlyName)
+		if err := client.FlexFlow(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("flex flow", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -34,7 +34,8 @@ func resourceIamApiKey() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -43,11 +44,25 @@ func resourceIamApiKey() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 64),
 			},
+			"pgp_key":        utils.PGPKeySchema(true),
+			"secret_storage": utils.SecretStorageSchema(true),
 			"secret": {
 				Type:      schema.TypeString,
 				Computed:  true,
 				Sensitive: true,
 			},
+			"secret_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_secret": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -67,30 +82,42 @@ func resourceApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		FriendlyName: utils.OptionalStringWithEmptyStringOnChange(d, "friendly_name"),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Keys.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Keys.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create account api key: %s", err.Error())
+		return utils.TranslateError("Failed to create account api key", err)
 	}
 
 	d.SetId(createResult.Sid)
-	d.Set("secret", createResult.Secret)
+	d.Set("secret_hash", utils.HashSecret(createResult.Secret))
+
+	// The secret is only returned when the API key is created, so the secret is encrypted or hashed before it is stored in state
+	if pgpKey, ok := d.GetOk("pgp_key"); ok {
+		encryptedSecret, keyFingerprint, err := utils.EncryptSecret(pgpKey.(string), createResult.Secret)
+		if err != nil {
+			return diag.Errorf("Failed to encrypt account api key secret: %s", err.Error())
+		}
+		d.Set("encrypted_secret", encryptedSecret)
+		d.Set("key_fingerprint", keyFingerprint)
+	} else if d.Get("secret_storage").(string) == utils.SecretStoragePlaintext {
+		d.Set("secret", createResult.Secret)
+	}
 	return resourceApiKeyRead(ctx, d, meta)
 }
 
 func resourceApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Key(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Key(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read account api key: %s", err.Error())
+		return utils.TranslateError("Failed to read account api key", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
-	d.Set("account_sid", d.Get("account_sid").(string))
+	d.Set("account_sid", meta.(*common.TwilioClient).ResolveAccountSid(d))
 	d.Set("friendly_name", getResponse.FriendlyName)
 	d.Set("date_created", getResponse.DateCreated.Time.Format(time.RFC3339))
 
@@ -108,9 +135,9 @@ func resourceApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		FriendlyName: utils.OptionalStringWithEmptyStringOnChange(d, "friendly_name"),
 	}
 
-	updateResp, err := client.Account(d.Get("account_sid").(string)).Key(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResp, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Key(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update account api key: %s", err.Error())
+		return utils.TranslateError("Failed to update account api key", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -120,8 +147,8 @@ func resourceApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Key(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete account api key: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Key(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete account api key", err)
 	}
 
 	d.SetId("")
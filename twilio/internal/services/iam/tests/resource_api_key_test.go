@@ -78,6 +78,45 @@ func TestAccTwilioIAMAPIKey_friendlyName(t *testing.T) {
 	})
 }
 
+func TestAccTwilioIAMAPIKey_secretStorageHash(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.api_key", resourceName)
+
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioAPIKey_secretStorage(testData, "hash"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioAPIKeyExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "secret_storage", "hash"),
+					resource.TestCheckResourceAttr(stateResourceName, "secret", ""),
+					resource.TestMatchResourceAttr(stateResourceName, "secret_hash", regexp.MustCompile(`^hmac-sha256:[0-9a-f]{32}:[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(stateResourceName, "encrypted_secret", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioIAMAPIKey_invalidPGPKey(t *testing.T) {
+	testData := acceptance.TestAccData
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioAPIKey_pgpKey(testData, "pgp_key"),
+				ExpectError: regexp.MustCompile(`(?s)pgp_key must be a base64 encoded PGP public key`),
+			},
+		},
+	})
+}
+
 func TestAccTwilioIAMAPIKey_invalidFriendlyNameWithLengthOf65(t *testing.T) {
 	testData := acceptance.TestAccData
 	friendlyName := "7y80krlx0npe98jtdhahyvx8jvfz09x21x226uxj8gowkun6dgl2p1xj819qjzgtt"
@@ -161,6 +200,24 @@ resource "twilio_iam_api_key" "api_key" {
 `, testData.AccountSid, friendlyName)
 }
 
+func testAccTwilioAPIKey_secretStorage(testData *acceptance.TestData, secretStorage string) string {
+	return fmt.Sprintf(`
+resource "twilio_iam_api_key" "api_key" {
+  account_sid    = "%s"
+  secret_storage = "%s"
+}
+`, testData.AccountSid, secretStorage)
+}
+
+func testAccTwilioAPIKey_pgpKey(testData *acceptance.TestData, pgpKey string) string {
+	return fmt.Sprintf(`
+resource "twilio_iam_api_key" "api_key" {
+  account_sid = "%s"
+  pgp_key     = "%s"
+}
+`, testData.AccountSid, pgpKey)
+}
+
 func testAccTwilioAPIKey_invalidAccountSid() string {
 	return `
 resource "twilio_iam_api_key" "api_key" {
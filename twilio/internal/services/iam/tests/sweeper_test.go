@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_iam_api_key", &resource.Sweeper{
+		Name: "twilio_iam_api_key",
+		F:    sweepAPIKeys,
+	})
+}
+
+func sweepAPIKeys(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).Keys.NewKeysPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list API keys: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Keys {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("API key", item.Sid, item.FriendlyName)
+		if err := client.Account(accountSid).Key(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("API key", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
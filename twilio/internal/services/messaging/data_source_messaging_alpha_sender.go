@@ -70,7 +70,7 @@ func dataSourceMessagingAlphaSenderRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Alpha sender with sid (%s) was not found for messaging service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read messaging alpha sender: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging alpha sender", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceMessagingAlphaSenders() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("alpha_senders", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -63,7 +63,7 @@ func dataSourceMessagingAlphaSenders() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -80,7 +80,7 @@ func dataSourceMessagingAlphaSendersRead(ctx context.Context, d *schema.Resource
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No alpha senders were found for messaging service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list messaging alpha senders: %s", err.Error())
+		return utils.TranslateError("Failed to list messaging alpha senders", err)
 	}
 
 	d.SetId(serviceSid)
@@ -107,7 +107,11 @@ func dataSourceMessagingAlphaSendersRead(ctx context.Context, d *schema.Resource
 		alphaSenders = append(alphaSenders, alphaSenderMap)
 	}
 
-	d.Set("alpha_senders", &alphaSenders)
+	filteredAlphaSenders, err := utils.FilterListItems(d, alphaSenders)
+	if err != nil {
+		return diag.Errorf("Failed to filter alpha senders: %s", err.Error())
+	}
+	d.Set("alpha_senders", &filteredAlphaSenders)
 
 	return nil
 }
@@ -74,7 +74,7 @@ func dataSourceMessagingPhoneNumberRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Phone number with sid (%s) was not found for messaging service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read messaging phone number: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging phone number", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceMessagingPhoneNumbers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("phone_numbers", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -67,7 +67,7 @@ func dataSourceMessagingPhoneNumbers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -84,7 +84,7 @@ func dataSourceMessagingPhoneNumbersRead(ctx context.Context, d *schema.Resource
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No phone numbers were found for messaging service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list messaging phone numbers: %s", err.Error())
+		return utils.TranslateError("Failed to list messaging phone numbers", err)
 	}
 
 	d.SetId(serviceSid)
@@ -112,7 +112,11 @@ func dataSourceMessagingPhoneNumbersRead(ctx context.Context, d *schema.Resource
 		phoneNumbers = append(phoneNumbers, phoneNumberMap)
 	}
 
-	d.Set("phone_numbers", &phoneNumbers)
+	filteredPhoneNumbers, err := utils.FilterListItems(d, phoneNumbers)
+	if err != nil {
+		return diag.Errorf("Failed to filter phone numbers: %s", err.Error())
+	}
+	d.Set("phone_numbers", &filteredPhoneNumbers)
 
 	return nil
 }
@@ -74,7 +74,7 @@ func dataSourceMessagingShortCodeRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Short code with sid (%s) was not found for messaging service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read messaging short code: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging short code", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceMessagingShortCodes() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("short_codes", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -67,7 +67,7 @@ func dataSourceMessagingShortCodes() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -84,7 +84,7 @@ func dataSourceMessagingShortCodesRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No short codes were found for messaging service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read messaging short code: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging short code", err)
 	}
 
 	d.SetId(serviceSid)
@@ -112,7 +112,11 @@ func dataSourceMessagingShortCodesRead(ctx context.Context, d *schema.ResourceDa
 		shortCodes = append(shortCodes, shortCodeMap)
 	}
 
-	d.Set("short_codes", &shortCodes)
+	filteredShortCodes, err := utils.FilterListItems(d, shortCodes)
+	if err != nil {
+		return diag.Errorf("Failed to filter short codes: %s", err.Error())
+	}
+	d.Set("short_codes", &filteredShortCodes)
 
 	return nil
 }
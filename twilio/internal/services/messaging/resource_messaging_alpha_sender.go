@@ -96,7 +96,7 @@ func resourceMessagingAlphaSenderCreate(ctx context.Context, d *schema.ResourceD
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).AlphaSenders.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create messaging alpha sender: %s", err.Error())
+		return utils.TranslateError("Failed to create messaging alpha sender", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -112,7 +112,7 @@ func resourceMessagingAlphaSenderRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read messaging alpha sender: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging alpha sender", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -135,7 +135,7 @@ func resourceMessagingAlphaSenderDelete(ctx context.Context, d *schema.ResourceD
 	client := meta.(*common.TwilioClient).Messaging
 
 	if err := client.Service(d.Get("service_sid").(string)).AlphaSender(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete messaging alpha sender: %s", err.Error())
+		return utils.TranslateError("Failed to delete messaging alpha sender", err)
 	}
 	d.SetId("")
 	return nil
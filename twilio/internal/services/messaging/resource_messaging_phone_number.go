@@ -99,7 +99,7 @@ func resourceMessagingPhoneNumberCreate(ctx context.Context, d *schema.ResourceD
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).PhoneNumbers.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create messaging phone number: %s", err.Error())
+		return utils.TranslateError("Failed to create messaging phone number", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -115,7 +115,7 @@ func resourceMessagingPhoneNumberRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read messaging phone number: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging phone number", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -139,7 +139,7 @@ func resourceMessagingPhoneNumberDelete(ctx context.Context, d *schema.ResourceD
 	client := meta.(*common.TwilioClient).Messaging
 
 	if err := client.Service(d.Get("service_sid").(string)).PhoneNumber(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete messaging phone number: %s", err.Error())
+		return utils.TranslateError("Failed to delete messaging phone number", err)
 	}
 	d.SetId("")
 	return nil
@@ -8,11 +8,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/services/messaging/helper"
 	"github.com/timworks/terraform-provider-twilio/twilio/utils"
-	"github.com/timworks/twilio-sdk-go/service/messaging/v1/service"
-	"github.com/timworks/twilio-sdk-go/service/messaging/v1/services"
 )
 
 func resourceMessagingService() *schema.Resource {
@@ -45,130 +43,28 @@ func resourceMessagingService() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
-			"sid": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"account_sid": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"friendly_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-			},
-			"area_code_geomatch": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"fallback_method": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "POST",
-				ValidateFunc: validation.StringInSlice([]string{
-					"POST",
-					"GET",
-				}, false),
-			},
-			"fallback_to_long_code": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"fallback_url": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-			},
-			"inbound_method": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "POST",
-				ValidateFunc: validation.StringInSlice([]string{
-					"POST",
-					"GET",
-				}, false),
-			},
-			"inbound_request_url": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-			},
-			"mms_converter": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"smart_encoding": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"status_callback_url": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-			},
-			"sticky_sender": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"use_inbound_webhook_on_number": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"validity_period": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      14400,
-				ValidateFunc: validation.IntBetween(1, 14400),
-			},
-			"date_created": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"date_updated": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"url": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-		},
+		Schema: resourceMessagingServiceSchema(),
 	}
 }
 
 func resourceMessagingServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Messaging
 
-	createInput := &services.CreateServiceInput{
-		FriendlyName:              d.Get("friendly_name").(string),
-		AreaCodeGeomatch:          utils.OptionalBool(d, "area_code_geomatch"),
-		FallbackMethod:            utils.OptionalString(d, "fallback_method"),
-		FallbackToLongCode:        utils.OptionalBool(d, "fallback_to_long_code"),
-		FallbackURL:               utils.OptionalStringWithEmptyStringOnChange(d, "fallback_url"),
-		InboundMethod:             utils.OptionalString(d, "inbound_method"),
-		InboundRequestURL:         utils.OptionalStringWithEmptyStringOnChange(d, "inbound_request_url"),
-		MmsConverter:              utils.OptionalBool(d, "mms_converter"),
-		SmartEncoding:             utils.OptionalBool(d, "smart_encoding"),
-		StatusCallback:            utils.OptionalStringWithEmptyStringOnChange(d, "status_callback_url"),
-		StickySender:              utils.OptionalBool(d, "sticky_sender"),
-		UseInboundWebhookOnNumber: utils.OptionalBool(d, "use_inbound_webhook_on_number"),
-		ValidityPeriod:            utils.OptionalInt(d, "validity_period"),
-	}
+	createInput := helper.ExpandMessagingServiceCreateInput(d)
 
 	createResult, err := client.Services.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create messaging service: %s", err.Error())
+		return utils.TranslateError("Failed to create messaging service", err)
 	}
 
 	d.SetId(createResult.Sid)
+
+	if d.HasChanges(helper.MessagingServiceRawAttributes...) {
+		if _, err := helper.UpdateMessagingServiceRaw(ctx, client.GetClient(), d.Id(), helper.ExpandMessagingServiceRawInput(d)); err != nil {
+			return utils.TranslateError("Failed to update messaging service", err)
+		}
+	}
+
 	return resourceMessagingServiceRead(ctx, d, meta)
 }
 
@@ -181,31 +77,21 @@ func resourceMessagingServiceRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read messaging service: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging service", err)
+	}
+
+	for key, value := range helper.FlattenMessagingService(getResponse) {
+		d.Set(key, value)
 	}
 
-	d.Set("account_sid", getResponse.AccountSid)
-	d.Set("area_code_geomatch", getResponse.AreaCodeGeomatch)
-	d.Set("fallback_method", getResponse.FallbackMethod)
-	d.Set("fallback_to_long_code", getResponse.FallbackToLongCode)
-	d.Set("fallback_url", getResponse.FallbackURL)
-	d.Set("friendly_name", getResponse.FriendlyName)
-	d.Set("inbound_method", getResponse.InboundMethod)
-	d.Set("inbound_request_url", getResponse.InboundRequestURL)
-	d.Set("mms_converter", getResponse.MmsConverter)
-	d.Set("sid", getResponse.Sid)
-	d.Set("smart_encoding", getResponse.SmartEncoding)
-	d.Set("status_callback_url", getResponse.StatusCallback)
-	d.Set("sticky_sender", getResponse.StickySender)
-	d.Set("use_inbound_webhook_on_number", getResponse.UseInboundWebhookOnNumber)
-	d.Set("validity_period", getResponse.ValidityPeriod)
-	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
-
-	if getResponse.DateUpdated != nil {
-		d.Set("date_updated", getResponse.DateUpdated.Format(time.RFC3339))
+	getRawResponse, err := helper.FetchMessagingServiceRaw(ctx, client.GetClient(), d.Id())
+	if err != nil {
+		return utils.TranslateError("Failed to read messaging service", err)
 	}
 
-	d.Set("url", getResponse.URL)
+	for key, value := range helper.FlattenMessagingServiceRaw(getRawResponse) {
+		d.Set(key, value)
+	}
 
 	return nil
 }
@@ -213,28 +99,21 @@ func resourceMessagingServiceRead(ctx context.Context, d *schema.ResourceData, m
 func resourceMessagingServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Messaging
 
-	updateInput := &service.UpdateServiceInput{
-		FriendlyName:              utils.OptionalString(d, "friendly_name"),
-		AreaCodeGeomatch:          utils.OptionalBool(d, "area_code_geomatch"),
-		FallbackMethod:            utils.OptionalString(d, "fallback_method"),
-		FallbackToLongCode:        utils.OptionalBool(d, "fallback_to_long_code"),
-		FallbackURL:               utils.OptionalStringWithEmptyStringOnChange(d, "fallback_url"),
-		InboundMethod:             utils.OptionalString(d, "inbound_method"),
-		InboundRequestURL:         utils.OptionalStringWithEmptyStringOnChange(d, "inbound_request_url"),
-		MmsConverter:              utils.OptionalBool(d, "mms_converter"),
-		SmartEncoding:             utils.OptionalBool(d, "smart_encoding"),
-		StatusCallback:            utils.OptionalStringWithEmptyStringOnChange(d, "status_callback_url"),
-		StickySender:              utils.OptionalBool(d, "sticky_sender"),
-		UseInboundWebhookOnNumber: utils.OptionalBool(d, "use_inbound_webhook_on_number"),
-		ValidityPeriod:            utils.OptionalInt(d, "validity_period"),
-	}
+	updateInput := helper.ExpandMessagingServiceUpdateInput(d)
 
 	updateResp, err := client.Service(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update messaging service: %s", err.Error())
+		return utils.TranslateError("Failed to update messaging service", err)
 	}
 
 	d.SetId(updateResp.Sid)
+
+	if d.HasChanges(helper.MessagingServiceRawAttributes...) {
+		if _, err := helper.UpdateMessagingServiceRaw(ctx, client.GetClient(), d.Id(), helper.ExpandMessagingServiceRawInput(d)); err != nil {
+			return utils.TranslateError("Failed to update messaging service", err)
+		}
+	}
+
 	return resourceMessagingServiceRead(ctx, d, meta)
 }
 
@@ -242,7 +121,7 @@ func resourceMessagingServiceDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).Messaging
 
 	if err := client.Service(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete messaging service: %s", err.Error())
+		return utils.TranslateError("Failed to delete messaging service", err)
 	}
 	d.SetId("")
 	return nil
@@ -99,7 +99,7 @@ func resourceMessagingShortCodeCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).ShortCodes.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create messaging short code: %s", err.Error())
+		return utils.TranslateError("Failed to create messaging short code", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -115,7 +115,7 @@ func resourceMessagingShortCodeRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read messaging short code: %s", err.Error())
+		return utils.TranslateError("Failed to read messaging short code", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -136,10 +136,14 @@ func resourceMessagingShortCodeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceMessagingShortCodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := meta.(*common.TwilioClient).CheckDeletionProtection("messaging short code", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	client := meta.(*common.TwilioClient).Messaging
 
 	if err := client.Service(d.Get("service_sid").(string)).ShortCode(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete messaging short code: %s", err.Error())
+		return utils.TranslateError("Failed to delete messaging short code", err)
 	}
 	d.SetId("")
 	return nil
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var phoneNumberDataSourceName = "twilio_messaging_phone_number"
 
 func TestAccDataSourceTwilioMessagingPhoneNumber_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.phone_number", phoneNumberDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	testData := acceptance.TestAccData
 
 	resource.Test(t, resource.TestCase{
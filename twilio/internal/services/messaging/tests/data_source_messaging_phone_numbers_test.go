@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var phoneNumbersDataSourceName = "twilio_messaging_phone_numbers"
 
 func TestAccDataSourceTwilioMessagingPhoneNumbers_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.phone_numbers", phoneNumbersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	testData := acceptance.TestAccData
 
 	resource.Test(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var phoneNumberResourceName = "twilio_messaging_phone_number"
 
 func TestAccTwilioMessagingPhoneNumber_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	testData := acceptance.TestAccData
 
 	resource.Test(t, resource.TestCase{
@@ -17,7 +17,7 @@ var serviceResourceName = "twilio_messaging_service"
 
 func TestAccTwilioMessagingService_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -43,6 +43,11 @@ func TestAccTwilioMessagingService_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(stateResourceName, "sticky_sender", "true"),
 					resource.TestCheckResourceAttr(stateResourceName, "use_inbound_webhook_on_number", "false"),
 					resource.TestCheckResourceAttr(stateResourceName, "validity_period", "14400"),
+					resource.TestCheckResourceAttr(stateResourceName, "scan_message_content", "inherit"),
+					resource.TestCheckResourceAttr(stateResourceName, "synchronous_validation", "false"),
+					resource.TestCheckResourceAttr(stateResourceName, "usecase", "undeclared"),
+					resource.TestCheckResourceAttr(stateResourceName, "preconfigured", "false"),
+					resource.TestCheckResourceAttr(stateResourceName, "us_app_to_person_registered", "false"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "date_updated"),
@@ -59,11 +64,55 @@ func TestAccTwilioMessagingService_basic(t *testing.T) {
 	})
 }
 
+func TestAccTwilioMessagingService_usecase(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioMessagingServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioMessagingService_usecase(friendlyName, "notifications", "disable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioMessagingServiceExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "usecase", "notifications"),
+					resource.TestCheckResourceAttr(stateResourceName, "scan_message_content", "disable"),
+				),
+			},
+			{
+				Config: testAccTwilioMessagingService_usecase(friendlyName, "marketing", "enable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioMessagingServiceExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "usecase", "marketing"),
+					resource.TestCheckResourceAttr(stateResourceName, "scan_message_content", "enable"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioMessagingService_invalidUsecase(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioMessagingService_usecase(friendlyName, "invalid", "inherit"),
+				ExpectError: regexp.MustCompile(`(?s)expected usecase to be one of \[notifications marketing verification discussion poll undeclared\], got invalid`),
+			},
+		},
+	})
+}
+
 func TestAccTwilioMessagingService_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
 	friendlyName := acctest.RandString(1)
-	newFriendlyName := acctest.RandString(10)
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -142,7 +191,7 @@ func TestAccTwilioMessagingService_blankFriendlyName(t *testing.T) {
 func TestAccTwilioMessagingService_fallback(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	fallbackMethod := "GET"
 	fallbackURL := "https://test.com/fallback"
 
@@ -171,7 +220,7 @@ func TestAccTwilioMessagingService_fallback(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidFallbackMethod(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	fallbackMethod := "test"
 	fallbackURL := "https://test.com/fallback"
 
@@ -188,7 +237,7 @@ func TestAccTwilioMessagingService_invalidFallbackMethod(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidFallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	fallbackMethod := "GET"
 	fallbackURL := "fallback"
 
@@ -207,7 +256,7 @@ func TestAccTwilioMessagingService_invalidFallbackURL(t *testing.T) {
 func TestAccTwilioMessagingService_inbound(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	inboundMethod := "GET"
 	inboundURL := "https://test.com/inbound"
 
@@ -236,7 +285,7 @@ func TestAccTwilioMessagingService_inbound(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidInboundMethod(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	inboundMethod := "test"
 	inboundURL := "https://test.com/inbound"
 
@@ -253,7 +302,7 @@ func TestAccTwilioMessagingService_invalidInboundMethod(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidInboundURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	inboundMethod := "GET"
 	inboundURL := "inbound"
 
@@ -272,7 +321,7 @@ func TestAccTwilioMessagingService_invalidInboundURL(t *testing.T) {
 func TestAccTwilioMessagingService_statusCallback(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	statusCallbackURL := "https://test.com/status"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -298,7 +347,7 @@ func TestAccTwilioMessagingService_statusCallback(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidStatusCallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	statusCallbackURL := "status"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -316,7 +365,7 @@ func TestAccTwilioMessagingService_invalidStatusCallbackURL(t *testing.T) {
 func TestAccTwilioMessagingService_validityPeriod(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	validityPeriod := 14400
 	newValidityPeriod := 1
 
@@ -350,7 +399,7 @@ func TestAccTwilioMessagingService_validityPeriod(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidValidityPeriodOf0(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	validityPeriod := 0
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -366,7 +415,7 @@ func TestAccTwilioMessagingService_invalidValidityPeriodOf0(t *testing.T) {
 }
 
 func TestAccTwilioMessagingService_invalidValidityPeriodOf14401(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	validityPeriod := 14401
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -384,7 +433,7 @@ func TestAccTwilioMessagingService_invalidValidityPeriodOf14401(t *testing.T) {
 func TestAccTwilioMessagingService_stickySender(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -411,7 +460,7 @@ func TestAccTwilioMessagingService_stickySender(t *testing.T) {
 func TestAccTwilioMessagingService_smartEncoding(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -438,7 +487,7 @@ func TestAccTwilioMessagingService_smartEncoding(t *testing.T) {
 func TestAccTwilioMessagingService_mmsConverter(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -465,7 +514,7 @@ func TestAccTwilioMessagingService_mmsConverter(t *testing.T) {
 func TestAccTwilioMessagingService_fallbackToLongCode(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -492,7 +541,7 @@ func TestAccTwilioMessagingService_fallbackToLongCode(t *testing.T) {
 func TestAccTwilioMessagingService_areaCodeGeomatch(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -519,7 +568,7 @@ func TestAccTwilioMessagingService_areaCodeGeomatch(t *testing.T) {
 func TestAccTwilioMessagingService_useInboundWebhookOnNumber(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -599,6 +648,16 @@ resource "twilio_messaging_service" "service" {
 `, friendlyName)
 }
 
+func testAccTwilioMessagingService_usecase(friendlyName string, usecase string, scanMessageContent string) string {
+	return fmt.Sprintf(`
+resource "twilio_messaging_service" "service" {
+  friendly_name        = "%s"
+  usecase              = "%s"
+  scan_message_content = "%s"
+}
+`, friendlyName, usecase, scanMessageContent)
+}
+
 func testAccTwilioMessagingService_fallback(friendlyName string, method string, url string) string {
 	return fmt.Sprintf(`
 resource "twilio_messaging_service" "service" {
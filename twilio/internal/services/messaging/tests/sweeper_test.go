@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_messaging_service", &resource.Sweeper{
+		Name: "twilio_messaging_service",
+		F:    sweepMessagingServices,
+	})
+}
+
+func sweepMessagingServices(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Messaging
+
+	ctx := context.Background()
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list messaging services: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Services {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("messaging service", item.Sid, item.FriendlyName)
+		if err := client.Service(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("messaging service", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -27,7 +27,8 @@ func dataSourcePhoneNumber() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"friendly_name": {
@@ -213,7 +214,7 @@ func dataSourcePhoneNumber() *schema.Resource {
 func dataSourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).IncomingPhoneNumber(sid).FetchWithContext(ctx)
 	if err != nil {
@@ -221,7 +222,7 @@ func dataSourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta
 			return diag.Errorf("Phone number with sid (%s) was not found in account (%s)", sid, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to read phone number: %s", err.Error())
+		return utils.TranslateError("Failed to read phone number", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -19,10 +19,11 @@ func dataSourcePhoneNumbers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("phone_numbers", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"phone_numbers": {
@@ -213,14 +214,14 @@ func dataSourcePhoneNumbers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourcePhoneNumbersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	paginator := client.Account(accountSid).IncomingPhoneNumbers.NewIncomingPhoneNumbersPaginator()
 	for paginator.NextWithContext(ctx) {
 	}
@@ -228,7 +229,7 @@ func dataSourcePhoneNumbersRead(ctx context.Context, d *schema.ResourceData, met
 	err := paginator.Error()
 	if err != nil {
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to list phone numbers: %s", err.Error())
+		return utils.TranslateError("Failed to list phone numbers", err)
 	}
 
 	d.SetId(accountSid)
@@ -306,7 +307,11 @@ func dataSourcePhoneNumbersRead(ctx context.Context, d *schema.ResourceData, met
 		phoneNumbers = append(phoneNumbers, phoneNumberMap)
 	}
 
-	d.Set("phone_numbers", &phoneNumbers)
+	filteredPhoneNumbers, err := utils.FilterListItems(d, phoneNumbers)
+	if err != nil {
+		return diag.Errorf("Failed to filter phone numbers: %s", err.Error())
+	}
+	d.Set("phone_numbers", &filteredPhoneNumbers)
 
 	return nil
 }
@@ -25,7 +25,8 @@ func dataSourcePhoneNumberAvailableLocalNumbers() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"iso_country": {
@@ -250,7 +251,7 @@ func dataSourcePhoneNumberAvailableLocalNumbersRead(ctx context.Context, d *sche
 		options.InLocality = utils.OptionalString(d, "location.0.in_locality")
 	}
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	countryCode := d.Get("iso_country").(string)
 	pageResponse, err := client.Account(accountSid).AvailablePhoneNumber(countryCode).Local.PageWithContext(ctx, options)
 	if err != nil {
@@ -258,7 +259,7 @@ func dataSourcePhoneNumberAvailableLocalNumbersRead(ctx context.Context, d *sche
 			return diag.Errorf("No local phone numbers were found for country (%s) in account (%s)", countryCode, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to list available local phone numbers: %s", err.Error())
+		return utils.TranslateError("Failed to list available local phone numbers", err)
 	}
 
 	d.SetId(accountSid + "/" + countryCode)
@@ -3,6 +3,8 @@ package phone_number
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"regexp"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/services/phone_number/helper"
 	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	"github.com/timworks/twilio-sdk-go/client"
 	"github.com/timworks/twilio-sdk-go/service/api/v2010/account/available_phone_number/local"
 	"github.com/timworks/twilio-sdk-go/service/api/v2010/account/available_phone_number/mobile"
 	"github.com/timworks/twilio-sdk-go/service/api/v2010/account/available_phone_number/toll_free"
@@ -39,6 +42,7 @@ func resourcePhoneNumber() *schema.Resource {
 
 				d.Set("account_sid", match[1])
 				d.Set("sid", match[2])
+				d.Set("release_on_destroy", true)
 				d.SetId(match[2])
 				return []*schema.ResourceData{d}, nil
 			},
@@ -51,6 +55,16 @@ func resourcePhoneNumber() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				// Phone numbers which were created before `release_on_destroy` was added do not have a value in state, the phone number must still be released when it is destroyed
+				Type:    resourcePhoneNumberV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: utils.DefaultAttributeValueStateUpgradeFunc("release_on_destroy", true),
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"sid": {
 				Type:     schema.TypeString,
@@ -58,7 +72,8 @@ func resourcePhoneNumber() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -452,6 +467,16 @@ func resourcePhoneNumber() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"release_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"parking_account_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.AccountSidValidation(),
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -522,9 +547,9 @@ func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta
 		createInput.VoiceURL = utils.OptionalStringWithEmptyStringOnChange(d, "fax.0.url")
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).IncomingPhoneNumbers.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).IncomingPhoneNumbers.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create phone number %s", err.Error())
+		return utils.TranslateError("Failed to create phone number", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -534,13 +559,13 @@ func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).IncomingPhoneNumber(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).IncomingPhoneNumber(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read phone number: %s", err.Error())
+		return utils.TranslateError("Failed to read phone number", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -621,9 +646,9 @@ func resourcePhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta
 		updateInput.VoiceURL = utils.OptionalStringWithEmptyStringOnChange(d, "fax.0.url")
 	}
 
-	updateResp, err := client.Account(d.Get("account_sid").(string)).IncomingPhoneNumber(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResp, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).IncomingPhoneNumber(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update phone number: %s", err.Error())
+		return utils.TranslateError("Failed to update phone number", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -633,8 +658,28 @@ func resourcePhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourcePhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).IncomingPhoneNumber(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete phone number: %s", err.Error())
+	if !d.Get("release_on_destroy").(bool) {
+		// The phone number is retained, so the number is either moved to the parking account or only removed from state
+		if parkingAccountSid, ok := d.GetOk("parking_account_sid"); ok {
+			log.Printf("[INFO] Moving phone number (%s) to parking account (%s) instead of releasing the phone number", d.Id(), parkingAccountSid.(string))
+
+			if err := movePhoneNumber(ctx, client.GetClient(), meta.(*common.TwilioClient).ResolveAccountSid(d), d.Id(), parkingAccountSid.(string)); err != nil {
+				return utils.TranslateError("Failed to move phone number to parking account", err)
+			}
+		} else {
+			log.Printf("[INFO] Removing phone number (%s) from state without releasing the phone number", d.Id())
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	if err := meta.(*common.TwilioClient).CheckDeletionProtection("phone number", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).IncomingPhoneNumber(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete phone number", err)
 	}
 
 	d.SetId("")
@@ -679,7 +724,7 @@ func searchForLocalNumber(ctx context.Context, d *schema.ResourceData, meta inte
 		InLocality:                    pageOptions.InLocality,
 	}
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	countryCode := d.Get("search_criteria.0.iso_country").(string)
 	pageResponse, err := client.Account(accountSid).AvailablePhoneNumber(countryCode).Local.PageWithContext(ctx, options)
 	if err != nil {
@@ -687,7 +732,7 @@ func searchForLocalNumber(ctx context.Context, d *schema.ResourceData, meta inte
 			return nil, diag.Errorf("No local phone numbers were found for country (%s) in account (%s)", countryCode, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return nil, diag.Errorf("Failed to list available local phone numbers: %s", err.Error())
+		return nil, utils.TranslateError("Failed to list available local phone numbers", err)
 	}
 
 	availableNumbers := pageResponse.AvailablePhoneNumbers
@@ -723,7 +768,7 @@ func searchForMobileNumber(ctx context.Context, d *schema.ResourceData, meta int
 		InLocality:                    pageOptions.InLocality,
 	}
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	countryCode := d.Get("search_criteria.0.iso_country").(string)
 	pageResponse, err := client.Account(accountSid).AvailablePhoneNumber(countryCode).Mobile.PageWithContext(ctx, options)
 	if err != nil {
@@ -731,7 +776,7 @@ func searchForMobileNumber(ctx context.Context, d *schema.ResourceData, meta int
 			return nil, diag.Errorf("No mobile phone numbers were found for country (%s) in account (%s)", countryCode, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return nil, diag.Errorf("Failed to list available mobile phone numbers: %s", err.Error())
+		return nil, utils.TranslateError("Failed to list available mobile phone numbers", err)
 	}
 
 	availableNumbers := pageResponse.AvailablePhoneNumbers
@@ -767,7 +812,7 @@ func searchForTollFreeNumber(ctx context.Context, d *schema.ResourceData, meta i
 		InLocality:                    pageOptions.InLocality,
 	}
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	countryCode := d.Get("search_criteria.0.iso_country").(string)
 	pageResponse, err := client.Account(accountSid).AvailablePhoneNumber(countryCode).TollFree.PageWithContext(ctx, options)
 	if err != nil {
@@ -775,7 +820,7 @@ func searchForTollFreeNumber(ctx context.Context, d *schema.ResourceData, meta i
 			return nil, diag.Errorf("No toll free phone numbers were found for country (%s) in account (%s)", countryCode, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return nil, diag.Errorf("Failed to list available toll free phone numbers: %s", err.Error())
+		return nil, utils.TranslateError("Failed to list available toll free phone numbers", err)
 	}
 
 	availableNumbers := pageResponse.AvailablePhoneNumbers
@@ -840,3 +885,22 @@ func populateAvailablePhoneNumberPageOptions(d *schema.ResourceData) *AvailableP
 	}
 	return options
 }
+
+// movePhoneNumberInput is used to transfer a phone number to another account, the `AccountSid` parameter is not yet supported by the update input in twilio-sdk-go
+type movePhoneNumberInput struct {
+	AccountSid string `form:"AccountSid"`
+}
+
+func movePhoneNumber(ctx context.Context, sdkClient *client.Client, accountSid string, sid string, targetAccountSid string) error {
+	op := client.Operation{
+		Method:      http.MethodPost,
+		URI:         "/Accounts/{accountSid}/IncomingPhoneNumbers/{sid}.json",
+		ContentType: client.URLEncoded,
+		PathParams: map[string]string{
+			"accountSid": accountSid,
+			"sid":        sid,
+		},
+	}
+
+	return sdkClient.Send(ctx, op, &movePhoneNumberInput{AccountSid: targetAccountSid}, &incoming_phone_number.UpdateIncomingPhoneNumberResponse{})
+}
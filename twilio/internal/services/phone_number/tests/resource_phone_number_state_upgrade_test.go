@@ -0,0 +1,96 @@
+//go:build high_value
+// +build high_value
+
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	"github.com/timworks/twilio-sdk-go/service/api/v2010/account/incoming_phone_numbers"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+func testPhoneNumberV0State(accountSid string, sid string, phoneNumber string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                     sid,
+		"sid":                    sid,
+		"account_sid":            accountSid,
+		"phone_number":           phoneNumber,
+		"friendly_name":          phoneNumber,
+		"status_callback_method": "POST",
+	}
+}
+
+func TestTwilioPhoneNumber_stateUpgradeV0(t *testing.T) {
+	v0State := testPhoneNumberV0State("ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "PNaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "+15005551000")
+
+	expected := testPhoneNumberV0State("ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "PNaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "+15005551000")
+	expected["release_on_destroy"] = true
+
+	acceptance.CheckUpgradedState(t, phoneNumberResourceName, 0, v0State, expected)
+}
+
+func TestTwilioPhoneNumber_stateUpgradeV0RetainsConfiguredValue(t *testing.T) {
+	v0State := testPhoneNumberV0State("ACaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "PNaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "+15005551000")
+	v0State["release_on_destroy"] = false
+
+	acceptance.CheckUpgradedState(t, phoneNumberResourceName, 0, v0State, v0State)
+}
+
+func TestAccTwilioPhoneNumber_destroyUpgradedV0State(t *testing.T) {
+	if !acceptance.IsOffline() {
+		t.Skip("The phone number is purchased outside of Terraform, so the test is only run against the fake server")
+	}
+
+	provider := acceptance.ConfigureProvider(t, map[string]interface{}{"deletion_protection": false})
+	client := provider.Meta().(*common.TwilioClient).API
+	accountSid := acceptance.TestAccData.AccountSid
+
+	createResult, err := client.Account(accountSid).IncomingPhoneNumbers.Create(&incoming_phone_numbers.CreateIncomingPhoneNumberInput{
+		PhoneNumber: sdkUtils.String(acceptance.TestAccData.PurchasablePhoneNumber),
+	})
+	if err != nil {
+		t.Fatalf("Failed to purchase phone number: %s", err.Error())
+	}
+
+	state := acceptance.UpgradeState(t, phoneNumberResourceName, 0, testPhoneNumberV0State(accountSid, createResult.Sid, createResult.PhoneNumber))
+	if diags := acceptance.DestroyState(t, provider, phoneNumberResourceName, state); diags.HasError() {
+		t.Fatalf("Failed to destroy phone number: %v", diags)
+	}
+
+	if _, err := client.Account(accountSid).IncomingPhoneNumber(createResult.Sid).Fetch(); err == nil || !utils.IsNotFoundError(err) {
+		t.Errorf("Expected phone number %s to be released when the upgraded state was destroyed", createResult.Sid)
+	}
+}
+
+func TestAccTwilioPhoneNumber_destroyUpgradedV0StateWithDeletionProtection(t *testing.T) {
+	if !acceptance.IsOffline() {
+		t.Skip("The phone number is purchased outside of Terraform, so the test is only run against the fake server")
+	}
+
+	provider := acceptance.ConfigureProvider(t, map[string]interface{}{"deletion_protection": true})
+	client := provider.Meta().(*common.TwilioClient).API
+	accountSid := acceptance.TestAccData.AccountSid
+
+	createResult, err := client.Account(accountSid).IncomingPhoneNumbers.Create(&incoming_phone_numbers.CreateIncomingPhoneNumberInput{
+		PhoneNumber: sdkUtils.String(acceptance.TestAccData.PurchasablePhoneNumber),
+	})
+	if err != nil {
+		t.Fatalf("Failed to purchase phone number: %s", err.Error())
+	}
+	defer client.Account(accountSid).IncomingPhoneNumber(createResult.Sid).Delete()
+
+	state := acceptance.UpgradeState(t, phoneNumberResourceName, 0, testPhoneNumberV0State(accountSid, createResult.Sid, createResult.PhoneNumber))
+	diags := acceptance.DestroyState(t, provider, phoneNumberResourceName, state)
+	if !diags.HasError() || !regexp.MustCompile(`Deletion protection is enabled on the provider, so the phone number \(PN\w+\) cannot be deleted`).MatchString(diags[0].Summary) {
+		t.Fatalf("Expected the deletion protection error, got %v", diags)
+	}
+
+	if _, err := client.Account(accountSid).IncomingPhoneNumber(createResult.Sid).Fetch(); err != nil {
+		t.Errorf("Expected phone number %s to be retained, error occurred when retrieving phone number %s", createResult.Sid, err.Error())
+	}
+}
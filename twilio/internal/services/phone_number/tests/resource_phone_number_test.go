@@ -1,9 +1,11 @@
+//go:build high_value
 // +build high_value
 
 package tests
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,6 +20,7 @@ var phoneNumberResourceName = "twilio_phone_number"
 func TestAccTwilioPhoneNumber_complete(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
 	testData := acceptance.TestAccData
+	friendlyName := acceptance.RandomName()
 	url := "https://demo.twilio.com/welcome/voice/"
 	newUrl := "https://demo.twilio.com/welcome/sms/reply"
 
@@ -27,13 +30,13 @@ func TestAccTwilioPhoneNumber_complete(t *testing.T) {
 		CheckDestroy:      testAccCheckTwilioPhoneNumberDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTwilioPhoneNumber_complete(testData, url),
+				Config: testAccTwilioPhoneNumber_complete(testData, friendlyName, url),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTwilioPhoneNumberExists(stateResourceName),
 					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
-					resource.TestCheckResourceAttrSet(stateResourceName, "friendly_name"),
+					resource.TestCheckResourceAttr(stateResourceName, "friendly_name", friendlyName),
 					resource.TestCheckResourceAttrSet(stateResourceName, "phone_number"),
 					resource.TestCheckResourceAttr(stateResourceName, "search_criteria.#", "1"),
 					resource.TestCheckResourceAttr(stateResourceName, "search_criteria.0.type", "mobile"),
@@ -66,6 +69,7 @@ func TestAccTwilioPhoneNumber_complete(t *testing.T) {
 					resource.TestCheckResourceAttrSet(stateResourceName, "status"),
 					resource.TestCheckResourceAttr(stateResourceName, "status_callback_url", ""),
 					resource.TestCheckResourceAttr(stateResourceName, "status_callback_method", "POST"),
+					resource.TestCheckResourceAttr(stateResourceName, "release_on_destroy", "true"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "origin"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "date_created"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "date_updated"),
@@ -79,13 +83,13 @@ func TestAccTwilioPhoneNumber_complete(t *testing.T) {
 				ImportStateVerifyIgnore: []string{"search_criteria.#", "search_criteria.0"},
 			},
 			{
-				Config: testAccTwilioPhoneNumber_complete(testData, newUrl),
+				Config: testAccTwilioPhoneNumber_complete(testData, friendlyName, newUrl),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTwilioPhoneNumberExists(stateResourceName),
 					resource.TestCheckResourceAttrSet(stateResourceName, "id"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "account_sid"),
 					resource.TestCheckResourceAttrSet(stateResourceName, "sid"),
-					resource.TestCheckResourceAttrSet(stateResourceName, "friendly_name"),
+					resource.TestCheckResourceAttr(stateResourceName, "friendly_name", friendlyName),
 					resource.TestCheckResourceAttrSet(stateResourceName, "phone_number"),
 					resource.TestCheckResourceAttr(stateResourceName, "search_criteria.#", "1"),
 					resource.TestCheckResourceAttr(stateResourceName, "search_criteria.0.type", "mobile"),
@@ -127,6 +131,91 @@ func TestAccTwilioPhoneNumber_complete(t *testing.T) {
 	})
 }
 
+func TestAccTwilioPhoneNumber_retainOnDestroy(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
+	testData := acceptance.TestAccData
+	friendlyName := acceptance.RandomName()
+	var phoneNumberSid string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioPhoneNumberRetained(testData.AccountSid, &phoneNumberSid),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioPhoneNumber_retainOnDestroy(testData, friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberExists(stateResourceName),
+					testAccGetTwilioPhoneNumberSid(stateResourceName, &phoneNumberSid),
+					resource.TestCheckResourceAttr(stateResourceName, "release_on_destroy", "false"),
+					resource.TestCheckResourceAttr(stateResourceName, "parking_account_sid", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioPhoneNumber_parkingAccount(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
+	parkingAccountResourceName := "twilio_account_sub_account.parking"
+	testData := acceptance.TestAccData
+	friendlyName := acceptance.RandomName()
+	var phoneNumberSid string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioPhoneNumber_parkingAccount(testData, friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberExists(stateResourceName),
+					testAccGetTwilioPhoneNumberSid(stateResourceName, &phoneNumberSid),
+					resource.TestCheckResourceAttr(stateResourceName, "release_on_destroy", "false"),
+					resource.TestCheckResourceAttrPair(stateResourceName, "parking_account_sid", parkingAccountResourceName, "sid"),
+				),
+			},
+			{
+				Config: testAccTwilioPhoneNumber_parkingAccountOnly(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberMoved(testData.AccountSid, parkingAccountResourceName, &phoneNumberSid),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioPhoneNumber_deletionProtection(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.phone_number", phoneNumberResourceName)
+	testData := acceptance.TestAccData
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioPhoneNumber_deletionProtection(testData, friendlyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberExists(stateResourceName),
+				),
+			},
+			{
+				Config:      testAccTwilioPhoneNumber_deletionProtectionWithoutPhoneNumber(),
+				ExpectError: regexp.MustCompile(`(?s)Deletion protection is enabled on the provider, so the phone number \(PN\w+\) cannot be deleted`),
+			},
+			{
+				Config: testAccTwilioPhoneNumber_deletionProtection(testData, friendlyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioPhoneNumberExists(stateResourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTwilioPhoneNumberDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).API
 
@@ -164,6 +253,54 @@ func testAccCheckTwilioPhoneNumberExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccGetTwilioPhoneNumberSid(name string, sid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*sid = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckTwilioPhoneNumberRetained checks the phone number was not released when the resource was destroyed, the phone number is then released so it is not leaked
+func testAccCheckTwilioPhoneNumberRetained(accountSid string, sid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).API
+
+		if _, err := client.Account(accountSid).IncomingPhoneNumber(*sid).Fetch(); err != nil {
+			return fmt.Errorf("Expected phone number %s to be retained, error occurred when retrieving phone number %s", *sid, err.Error())
+		}
+
+		if err := client.Account(accountSid).IncomingPhoneNumber(*sid).Delete(); err != nil {
+			return fmt.Errorf("Error occurred when releasing phone number %s", err.Error())
+		}
+		return nil
+	}
+}
+
+func testAccCheckTwilioPhoneNumberMoved(accountSid string, parkingAccountName string, sid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).API
+
+		rs, ok := s.RootModule().Resources[parkingAccountName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", parkingAccountName)
+		}
+
+		if _, err := client.Account(rs.Primary.ID).IncomingPhoneNumber(*sid).Fetch(); err != nil {
+			return fmt.Errorf("Expected phone number %s to be moved to the parking account, error occurred when retrieving phone number %s", *sid, err.Error())
+		}
+
+		if _, err := client.Account(accountSid).IncomingPhoneNumber(*sid).Fetch(); err == nil || !utils.IsNotFoundError(err) {
+			return fmt.Errorf("Expected phone number %s to no longer belong to account %s", *sid, accountSid)
+		}
+		return nil
+	}
+}
+
 func testAccTwilioPhoneNumberImportStateIdFunc(name string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[name]
@@ -175,10 +312,11 @@ func testAccTwilioPhoneNumberImportStateIdFunc(name string) resource.ImportState
 	}
 }
 
-func testAccTwilioPhoneNumber_complete(testData *acceptance.TestData, url string) string {
+func testAccTwilioPhoneNumber_complete(testData *acceptance.TestData, friendlyName string, url string) string {
 	return fmt.Sprintf(`
 resource "twilio_phone_number" "phone_number" {
-  account_sid = "%s"
+  account_sid   = "%s"
+  friendly_name = "%s"
 
   search_criteria {
     type        = "mobile"
@@ -193,5 +331,88 @@ resource "twilio_phone_number" "phone_number" {
     url = "%s"
   }
 }
-`, testData.AccountSid, url)
+`, testData.AccountSid, friendlyName, url)
+}
+
+func testAccTwilioPhoneNumber_retainOnDestroy(testData *acceptance.TestData, friendlyName string) string {
+	return fmt.Sprintf(`
+provider "twilio" {
+  deletion_protection = true
+}
+
+resource "twilio_phone_number" "phone_number" {
+  account_sid        = "%s"
+  friendly_name      = "%s"
+  release_on_destroy = false
+
+  search_criteria {
+    type        = "mobile"
+    iso_country = "GB"
+
+    exclude_address_requirements {
+      all = true
+    }
+  }
+}
+`, testData.AccountSid, friendlyName)
+}
+
+func testAccTwilioPhoneNumber_parkingAccount(testData *acceptance.TestData, friendlyName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "twilio_phone_number" "phone_number" {
+  account_sid         = "%s"
+  friendly_name       = "%s"
+  release_on_destroy  = false
+  parking_account_sid = twilio_account_sub_account.parking.sid
+
+  search_criteria {
+    type        = "mobile"
+    iso_country = "GB"
+
+    exclude_address_requirements {
+      all = true
+    }
+  }
+}
+`, testAccTwilioPhoneNumber_parkingAccountOnly(friendlyName), testData.AccountSid, friendlyName)
+}
+
+func testAccTwilioPhoneNumber_parkingAccountOnly(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_account_sub_account" "parking" {
+  friendly_name = "%s"
+}
+`, friendlyName)
+}
+
+func testAccTwilioPhoneNumber_deletionProtection(testData *acceptance.TestData, friendlyName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+provider "twilio" {
+  deletion_protection = %t
+}
+
+resource "twilio_phone_number" "phone_number" {
+  account_sid   = "%s"
+  friendly_name = "%s"
+
+  search_criteria {
+    type        = "mobile"
+    iso_country = "GB"
+
+    exclude_address_requirements {
+      all = true
+    }
+  }
+}
+`, deletionProtection, testData.AccountSid, friendlyName)
+}
+
+func testAccTwilioPhoneNumber_deletionProtectionWithoutPhoneNumber() string {
+	return `
+provider "twilio" {
+  deletion_protection = true
+}
+`
 }
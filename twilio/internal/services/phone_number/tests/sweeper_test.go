@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_phone_number", &resource.Sweeper{
+		Name: "twilio_phone_number",
+		F:    sweepPhoneNumbers,
+	})
+}
+
+// sweepPhoneNumbers releases the phone numbers purchased by the acceptance tests, including the phone numbers which were retained on destroy.
+// Phone numbers which have been moved to a parking account are not swept, as they are closed along with the parking subaccount
+func sweepPhoneNumbers(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).IncomingPhoneNumbers.NewIncomingPhoneNumbersPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list phone numbers: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.PhoneNumbers {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("phone number", item.Sid, item.FriendlyName)
+		if err := client.Account(accountSid).IncomingPhoneNumber(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("phone number", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -140,7 +140,7 @@ func dataSourceProxyPhoneNumberRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Phone number with sid (%s) was not found for proxy service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read proxy phone number resource: %s", err.Error())
+		return utils.TranslateError("Failed to read proxy phone number resource", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -19,7 +19,7 @@ func dataSourceProxyPhoneNumbers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("phone_numbers", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -133,7 +133,7 @@ func dataSourceProxyPhoneNumbers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -150,7 +150,7 @@ func dataSourceProxyPhoneNumbersRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No phone numbers were found for proxy service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list proxy phone numbers resource: %s", err.Error())
+		return utils.TranslateError("Failed to list proxy phone numbers resource", err)
 	}
 
 	d.SetId(serviceSid)
@@ -181,7 +181,11 @@ func dataSourceProxyPhoneNumbersRead(ctx context.Context, d *schema.ResourceData
 		phoneNumbers = append(phoneNumbers, phoneNumberMap)
 	}
 
-	d.Set("phone_numbers", &phoneNumbers)
+	filteredPhoneNumbers, err := utils.FilterListItems(d, phoneNumbers)
+	if err != nil {
+		return diag.Errorf("Failed to filter phone numbers: %s", err.Error())
+	}
+	d.Set("phone_numbers", &filteredPhoneNumbers)
 
 	return nil
 }
@@ -85,7 +85,7 @@ func dataSourceProxyServiceRead(ctx context.Context, d *schema.ResourceData, met
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Proxy service with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read proxy service: %s", err.Error())
+		return utils.TranslateError("Failed to read proxy service", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -132,7 +132,7 @@ func dataSourceProxyShortCodeRead(ctx context.Context, d *schema.ResourceData, m
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Short code with sid (%s) was not found for proxy service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read proxy short code resource: %s", err.Error())
+		return utils.TranslateError("Failed to read proxy short code resource", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -19,7 +19,7 @@ func dataSourceProxyShortCodes() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("short_codes", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -125,7 +125,7 @@ func dataSourceProxyShortCodes() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -142,7 +142,7 @@ func dataSourceProxyShortCodesRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No short codes were found for proxy service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to list proxy short codes resource: %s", err.Error())
+		return utils.TranslateError("Failed to list proxy short codes resource", err)
 	}
 
 	d.SetId(serviceSid)
@@ -171,7 +171,11 @@ func dataSourceProxyShortCodesRead(ctx context.Context, d *schema.ResourceData,
 		shortCodes = append(shortCodes, shortCodeMap)
 	}
 
-	d.Set("short_codes", &shortCodes)
+	filteredShortCodes, err := utils.FilterListItems(d, shortCodes)
+	if err != nil {
+		return diag.Errorf("Failed to filter short codes: %s", err.Error())
+	}
+	d.Set("short_codes", &filteredShortCodes)
 
 	return nil
 }
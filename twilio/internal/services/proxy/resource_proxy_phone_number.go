@@ -176,7 +176,7 @@ func resourceProxyPhoneNumberCreate(ctx context.Context, d *schema.ResourceData,
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).PhoneNumbers.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create proxy phone number resource: %s", err.Error())
+		return utils.TranslateError("Failed to create proxy phone number resource", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -192,7 +192,7 @@ func resourceProxyPhoneNumberRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read proxy phone number resource: %s", err.Error())
+		return utils.TranslateError("Failed to read proxy phone number resource", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -224,7 +224,7 @@ func resourceProxyPhoneNumberUpdate(ctx context.Context, d *schema.ResourceData,
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).PhoneNumber(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update proxy phone number resource: %s", err.Error())
+		return utils.TranslateError("Failed to update proxy phone number resource", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -235,7 +235,7 @@ func resourceProxyPhoneNumberDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).Proxy
 
 	if err := client.Service(d.Get("service_sid").(string)).PhoneNumber(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete proxy phone number resource: %s", err.Error())
+		return utils.TranslateError("Failed to delete proxy phone number resource", err)
 	}
 	d.SetId("")
 	return nil
@@ -135,7 +135,7 @@ func resourceProxyServiceCreate(ctx context.Context, d *schema.ResourceData, met
 
 	createResult, err := client.Services.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create proxy service: %s", err.Error())
+		return utils.TranslateError("Failed to create proxy service", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -151,7 +151,7 @@ func resourceProxyServiceRead(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read proxy service: %s", err.Error())
+		return utils.TranslateError("Failed to read proxy service", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -191,7 +191,7 @@ func resourceProxyServiceUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	updateResp, err := client.Service(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update proxy service: %s", err.Error())
+		return utils.TranslateError("Failed to update proxy service", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -202,7 +202,7 @@ func resourceProxyServiceDelete(ctx context.Context, d *schema.ResourceData, met
 	client := meta.(*common.TwilioClient).Proxy
 
 	if err := client.Service(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete proxy service: %s", err.Error())
+		return utils.TranslateError("Failed to delete proxy service", err)
 	}
 	d.SetId("")
 	return nil
@@ -161,7 +161,7 @@ func resourceProxyShortCodeCreate(ctx context.Context, d *schema.ResourceData, m
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).ShortCodes.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create proxy short code resource: %s", err.Error())
+		return utils.TranslateError("Failed to create proxy short code resource", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -182,7 +182,7 @@ func resourceProxyShortCodeRead(ctx context.Context, d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read proxy short code resource: %s", err.Error())
+		return utils.TranslateError("Failed to read proxy short code resource", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -212,7 +212,7 @@ func resourceProxyShortCodeUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).ShortCode(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update proxy short code resource: %s", err.Error())
+		return utils.TranslateError("Failed to update proxy short code resource", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -220,10 +220,14 @@ func resourceProxyShortCodeUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceProxyShortCodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := meta.(*common.TwilioClient).CheckDeletionProtection("proxy short code", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	client := meta.(*common.TwilioClient).Proxy
 
 	if err := client.Service(d.Get("service_sid").(string)).ShortCode(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete proxy short code resource: %s", err.Error())
+		return utils.TranslateError("Failed to delete proxy short code resource", err)
 	}
 	d.SetId("")
 	return nil
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -18,7 +17,7 @@ func TestAccDataSourceTwilioProxyPhoneNumber_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.phone_number", proxyPhoneNumberDataSourceName)
 
 	testData := acceptance.TestAccData
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	isReserved := true
 
 	resource.Test(t, resource.TestCase{
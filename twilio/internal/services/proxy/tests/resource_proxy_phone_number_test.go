@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -21,7 +20,7 @@ func TestAccTwilioProxyPhoneNumber_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.phone_number", proxyPhoneNumberResourceName)
 
 	testData := acceptance.TestAccData
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	isReserved := true
 
 	resource.Test(t, resource.TestCase{
@@ -75,7 +74,7 @@ func TestAccTwilioProxyPhoneNumber_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.phone_number", proxyPhoneNumberResourceName)
 
 	testData := acceptance.TestAccData
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	isReserved := true
 	newIsReserved := false
 
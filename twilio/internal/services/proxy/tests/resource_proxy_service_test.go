@@ -17,7 +17,7 @@ var proxyServiceResourceName = "twilio_proxy_service"
 
 func TestAccTwilioProxyService_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", proxyServiceResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -57,7 +57,7 @@ func TestAccTwilioProxyService_basic(t *testing.T) {
 func TestAccTwilioProxyService_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", proxyServiceResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	defaultTTL := 10
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -110,7 +110,7 @@ func TestAccTwilioProxyService_update(t *testing.T) {
 func TestAccTwilioProxyService_callbacks(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", proxyServiceResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "https://test.com/callbackURL"
 	interceptURL := "https://test.com/interceptURL"
 	outOfSessionURL := "https://test.com/outOfSessionURL"
@@ -144,7 +144,7 @@ func TestAccTwilioProxyService_callbacks(t *testing.T) {
 }
 
 func TestAccTwilioProxyService_invalidCallbackURL(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "callbackURL"
 	interceptURL := "https://test.com/interceptURL"
 	outOfSessionURL := "https://test.com/outOfSessionURL"
@@ -161,7 +161,7 @@ func TestAccTwilioProxyService_invalidCallbackURL(t *testing.T) {
 }
 
 func TestAccTwilioProxyService_invalidInterceptCallbackURL(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "https://test.com/callbackURL"
 	interceptURL := "interceptURL"
 	outOfSessionURL := "https://test.com/outOfSessionURL"
@@ -179,7 +179,7 @@ func TestAccTwilioProxyService_invalidInterceptCallbackURL(t *testing.T) {
 }
 
 func TestAccTwilioProxyService_invalidOutOfSessionCallbackURL(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	url := "https://test.com/callbackURL"
 	interceptURL := "https://test.com/interceptURL"
 	outOfSessionURL := "outOfSessionURL"
@@ -199,7 +199,7 @@ func TestAccTwilioProxyService_invalidOutOfSessionCallbackURL(t *testing.T) {
 func TestAccTwilioProxyService_geoMatchLevel(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", proxyServiceResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	geoMatchLevel := "area-code"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -226,7 +226,7 @@ func TestAccTwilioProxyService_geoMatchLevel(t *testing.T) {
 }
 
 func TestAccTwilioProxyService_invalidGeoMatchLevel(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	geoMatchLevel := "geo_match_level"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -244,7 +244,7 @@ func TestAccTwilioProxyService_invalidGeoMatchLevel(t *testing.T) {
 func TestAccTwilioProxyService_numberSelectionBehaviour(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", proxyServiceResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	numberSelectionBehavior := "avoid-sticky"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -271,7 +271,7 @@ func TestAccTwilioProxyService_numberSelectionBehaviour(t *testing.T) {
 }
 
 func TestAccTwilioProxyService_invalidNumberSelectionBehaviour(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	numberSelectionBehavior := "number_selection_behavior"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -348,7 +348,7 @@ func TestAccTwilioProxyService_invalidUniqueNameWith192Characters(t *testing.T)
 func TestAccTwilioProxyService_chatInstanceSid(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", proxyServiceResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	chatInstanceSid := "ISaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 
 	resource.ParallelTest(t, resource.TestCase{
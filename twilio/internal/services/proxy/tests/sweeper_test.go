@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_proxy_service", &resource.Sweeper{
+		Name: "twilio_proxy_service",
+		F:    sweepProxyServices,
+	})
+}
+
+func sweepProxyServices(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Proxy
+
+	ctx := context.Background()
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list proxy services: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Services {
+		if !acceptance.HasResourcePrefix(item.UniqueName) {
+			continue
+		}
+
+		acceptance.LogSweep("proxy service", item.Sid, item.UniqueName)
+		if err := client.Service(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("proxy service", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
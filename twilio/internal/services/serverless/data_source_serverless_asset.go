@@ -80,7 +80,7 @@ func dataSourceServerlessAssetRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Asset with sid (%s) was not found for serverless service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless asset: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless asset", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -104,7 +104,7 @@ func dataSourceServerlessAssetRead(ctx context.Context, d *schema.ResourceData,
 	versionsPaginator.Next()
 
 	if versionsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read serverless asset versions: %s", versionsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read serverless asset versions", versionsPaginator.Error())
 	}
 
 	if len(versionsPaginator.Versions) > 0 {
@@ -21,7 +21,7 @@ func dataSourceServerlessAssets() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("assets", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -71,7 +71,7 @@ func dataSourceServerlessAssets() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -88,7 +88,7 @@ func dataSourceServerlessAssetsRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No assets were found for serverless service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless asset: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless asset", err)
 	}
 
 	d.SetId(serviceSid)
@@ -119,7 +119,7 @@ func dataSourceServerlessAssetsRead(ctx context.Context, d *schema.ResourceData,
 		versionsPaginator.Next()
 
 		if versionsPaginator.Error() != nil {
-			return diag.Errorf("Failed to read serverless asset versions: %s", versionsPaginator.Error().Error())
+			return utils.TranslateError("Failed to read serverless asset versions", versionsPaginator.Error())
 		}
 
 		if len(versionsPaginator.Versions) > 0 {
@@ -135,7 +135,11 @@ func dataSourceServerlessAssetsRead(ctx context.Context, d *schema.ResourceData,
 		assets = append(assets, assetMap)
 	}
 
-	d.Set("assets", &assets)
+	filteredAssets, err := utils.FilterListItems(d, assets)
+	if err != nil {
+		return diag.Errorf("Failed to filter assets: %s", err.Error())
+	}
+	d.Set("assets", &filteredAssets)
 
 	return nil
 }
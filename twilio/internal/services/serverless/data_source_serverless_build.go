@@ -147,7 +147,7 @@ func dataSourceServerlessBuildRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Build with sid (%s) was not found for serverless service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless build: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless build", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -20,7 +20,7 @@ func dataSourceServerlessBuilds() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("builds", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -141,7 +141,7 @@ func dataSourceServerlessBuilds() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -158,7 +158,7 @@ func dataSourceServerlessBuildsRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No builds were found for serverless service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless build: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless build", err)
 	}
 
 	d.SetId(serviceSid)
@@ -188,7 +188,11 @@ func dataSourceServerlessBuildsRead(ctx context.Context, d *schema.ResourceData,
 		builds = append(builds, buildMap)
 	}
 
-	d.Set("builds", &builds)
+	filteredBuilds, err := utils.FilterListItems(d, builds)
+	if err != nil {
+		return diag.Errorf("Failed to filter builds: %s", err.Error())
+	}
+	d.Set("builds", &filteredBuilds)
 
 	return nil
 }
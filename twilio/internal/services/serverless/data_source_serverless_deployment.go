@@ -69,7 +69,7 @@ func dataSourceServerlessDeploymentRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Deployment with sid (%s) was not found for serverless service with sid (%s) and environment with sid (%s)", sid, serviceSid, environmentSid)
 		}
-		return diag.Errorf("Failed to read serverless deployment: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless deployment", err)
 	}
 
 	d.SetId(getResponse.Sid)
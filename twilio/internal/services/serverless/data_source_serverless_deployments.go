@@ -18,7 +18,7 @@ func dataSourceServerlessDeployments() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("deployments", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -61,7 +61,7 @@ func dataSourceServerlessDeployments() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -79,7 +79,7 @@ func dataSourceServerlessDeploymentsRead(ctx context.Context, d *schema.Resource
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No deployments were found for serverless service with sid (%s) and environment with sid (%s)", serviceSid, environmentSid)
 		}
-		return diag.Errorf("Failed to read serverless deployment: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless deployment", err)
 	}
 
 	d.SetId(serviceSid + "/" + environmentSid)
@@ -106,7 +106,11 @@ func dataSourceServerlessDeploymentsRead(ctx context.Context, d *schema.Resource
 		deployments = append(deployments, deploymentMap)
 	}
 
-	d.Set("deployments", &deployments)
+	filteredDeployments, err := utils.FilterListItems(d, deployments)
+	if err != nil {
+		return diag.Errorf("Failed to filter deployments: %s", err.Error())
+	}
+	d.Set("deployments", &filteredDeployments)
 
 	return nil
 }
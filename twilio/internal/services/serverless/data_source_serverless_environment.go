@@ -75,7 +75,7 @@ func dataSourceServerlessEnvironmentRead(ctx context.Context, d *schema.Resource
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Environment with sid (%s) was not found for serverless service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless environment: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless environment", err)
 	}
 
 	d.SetId(getResponse.Sid)
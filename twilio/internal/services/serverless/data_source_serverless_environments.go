@@ -18,7 +18,7 @@ func dataSourceServerlessEnvironments() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("environments", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -68,7 +68,7 @@ func dataSourceServerlessEnvironments() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -85,7 +85,7 @@ func dataSourceServerlessEnvironmentsRead(ctx context.Context, d *schema.Resourc
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No environments were found for serverless service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless environment: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless environment", err)
 	}
 
 	d.SetId(serviceSid)
@@ -114,7 +114,11 @@ func dataSourceServerlessEnvironmentsRead(ctx context.Context, d *schema.Resourc
 		environments = append(environments, environmentMap)
 	}
 
-	d.Set("environments", &environments)
+	filteredEnvironments, err := utils.FilterListItems(d, environments)
+	if err != nil {
+		return diag.Errorf("Failed to filter environments: %s", err.Error())
+	}
+	d.Set("environments", &filteredEnvironments)
 
 	return nil
 }
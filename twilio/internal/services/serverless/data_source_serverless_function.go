@@ -84,7 +84,7 @@ func dataSourceServerlessFunctionRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Function with sid (%s) was not found for serverless service with sid (%s)", sid, serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless function: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless function", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -108,7 +108,7 @@ func dataSourceServerlessFunctionRead(ctx context.Context, d *schema.ResourceDat
 	versionsPaginator.Next()
 
 	if versionsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read serverless function versions: %s", versionsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read serverless function versions", versionsPaginator.Error())
 	}
 
 	if len(versionsPaginator.Versions) > 0 {
@@ -123,7 +123,7 @@ func dataSourceServerlessFunctionRead(ctx context.Context, d *schema.ResourceDat
 			if utils.IsNotFoundError(contentErr) {
 				return diag.Errorf("Function version with sid (%s) was not found for serverless service with sid (%s) and function with sid (%s)", latestVersion.Sid, serviceSid, sid)
 			}
-			return diag.Errorf("Failed to read serverless function version content: %s", err.Error())
+			return utils.TranslateError("Failed to read serverless function version content", err)
 		}
 
 		d.Set("content", contentGetResponse.Content)
@@ -21,7 +21,7 @@ func dataSourceServerlessFunctions() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("functions", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -75,7 +75,7 @@ func dataSourceServerlessFunctions() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -92,7 +92,7 @@ func dataSourceServerlessFunctionsRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No functions were found for serverless service with sid (%s)", serviceSid)
 		}
-		return diag.Errorf("Failed to read serverless function: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless function", err)
 	}
 
 	d.SetId(serviceSid)
@@ -123,7 +123,7 @@ func dataSourceServerlessFunctionsRead(ctx context.Context, d *schema.ResourceDa
 		versionsPaginator.Next()
 
 		if versionsPaginator.Error() != nil {
-			return diag.Errorf("Failed to read serverless function versions: %s", versionsPaginator.Error().Error())
+			return utils.TranslateError("Failed to read serverless function versions", versionsPaginator.Error())
 		}
 
 		if len(versionsPaginator.Versions) > 0 {
@@ -138,7 +138,7 @@ func dataSourceServerlessFunctionsRead(ctx context.Context, d *schema.ResourceDa
 				if utils.IsNotFoundError(contentErr) {
 					return diag.Errorf("Function version with sid (%s) was not found for serverless service with sid (%s) and function with sid (%s)", latestVersion.Sid, serviceSid, function.Sid)
 				}
-				return diag.Errorf("Failed to read serverless function version content: %s", err.Error())
+				return utils.TranslateError("Failed to read serverless function version content", err)
 			}
 
 			functionMap["content"] = contentGetResponse.Content
@@ -149,7 +149,11 @@ func dataSourceServerlessFunctionsRead(ctx context.Context, d *schema.ResourceDa
 		functions = append(functions, functionMap)
 	}
 
-	d.Set("functions", &functions)
+	filteredFunctions, err := utils.FilterListItems(d, functions)
+	if err != nil {
+		return diag.Errorf("Failed to filter functions: %s", err.Error())
+	}
+	d.Set("functions", &filteredFunctions)
 
 	return nil
 }
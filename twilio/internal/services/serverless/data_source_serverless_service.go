@@ -80,7 +80,7 @@ func dataSourceServerlessServiceRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Serverless service with sid/ unique name (%s) was not found", identifier)
 		}
-		return diag.Errorf("Failed to read serverless service: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless service", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -73,7 +73,7 @@ func dataSourceServerlessVariableRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Variable with sid (%s) was not found for serverless service with sid (%s) and environment with sid (%s)", sid, serviceSid, environmentSid)
 		}
-		return diag.Errorf("Failed to read serverless variable: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless variable", err)
 	}
 
 	d.SetId(getResponse.Sid)
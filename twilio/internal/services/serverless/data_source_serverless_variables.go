@@ -18,7 +18,7 @@ func dataSourceServerlessVariables() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("variables", map[string]*schema.Schema{
 			"service_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -65,7 +65,7 @@ func dataSourceServerlessVariables() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -83,7 +83,7 @@ func dataSourceServerlessVariablesRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No variables were found for serverless service with sid (%s) and environment with sid (%s)", serviceSid, environmentSid)
 		}
-		return diag.Errorf("Failed to read serverless variable: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless variable", err)
 	}
 
 	d.SetId(serviceSid + "/" + environmentSid)
@@ -111,7 +111,11 @@ func dataSourceServerlessVariablesRead(ctx context.Context, d *schema.ResourceDa
 		variables = append(variables, variableMap)
 	}
 
-	d.Set("variables", &variables)
+	filteredVariables, err := utils.FilterListItems(d, variables)
+	if err != nil {
+		return diag.Errorf("Failed to filter variables: %s", err.Error())
+	}
+	d.Set("variables", &filteredVariables)
 
 	return nil
 }
@@ -0,0 +1,133 @@
+package serverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+const namedImportIDFormat = "serverless:<service>[/functions/<function>|/assets/<asset>|/environments/<environment>[/variables/<key>]]"
+
+// resolveImportID converts an import ID which identifies the resource by name, i.e. `serverless:<service unique_name>/functions/<function friendly_name>`, into the SID based import ID.
+// The names are resolved using the list endpoints. Import IDs which do not start with `serverless:` are returned unchanged
+func resolveImportID(ctx context.Context, meta interface{}, id string) (string, error) {
+	segments, ok := utils.NamedImportIDSegments("serverless", id)
+	if !ok {
+		return id, nil
+	}
+	if utils.HasEmptySegment(segments) || len(segments)%2 == 0 {
+		return "", fmt.Errorf("The imported ID (%s) does not match the format (%s)", id, namedImportIDFormat)
+	}
+
+	client := meta.(*common.TwilioClient).Serverless
+
+	servicesPaginator := client.Services.NewServicesPaginator()
+	for servicesPaginator.NextWithContext(ctx) {
+	}
+	if err := servicesPaginator.Error(); err != nil {
+		return "", fmt.Errorf("Failed to list serverless services: %s", err.Error())
+	}
+
+	serviceCandidates := make([]utils.NameCandidate, 0)
+	for _, service := range servicesPaginator.Services {
+		serviceCandidates = append(serviceCandidates, utils.NameCandidate{Sid: service.Sid, Names: []interface{}{service.UniqueName, service.FriendlyName}})
+	}
+	serviceSid, err := utils.FindSidByName("serverless service", segments[0], serviceCandidates)
+	if err != nil {
+		return "", err
+	}
+
+	if len(segments) == 1 {
+		return fmt.Sprintf("/Services/%s", serviceSid), nil
+	}
+
+	serviceClient := client.Service(serviceSid)
+	switch segments[1] {
+	case "functions":
+		if len(segments) != 3 {
+			break
+		}
+
+		functionsPaginator := serviceClient.Functions.NewFunctionsPaginator()
+		for functionsPaginator.NextWithContext(ctx) {
+		}
+		if err := functionsPaginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list serverless functions: %s", err.Error())
+		}
+
+		candidates := make([]utils.NameCandidate, 0)
+		for _, function := range functionsPaginator.Functions {
+			candidates = append(candidates, utils.NameCandidate{Sid: function.Sid, Names: []interface{}{function.FriendlyName}})
+		}
+		functionSid, err := utils.FindSidByName("serverless function", segments[2], candidates)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("/Services/%s/Functions/%s", serviceSid, functionSid), nil
+	case "assets":
+		if len(segments) != 3 {
+			break
+		}
+
+		assetsPaginator := serviceClient.Assets.NewAssetsPaginator()
+		for assetsPaginator.NextWithContext(ctx) {
+		}
+		if err := assetsPaginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list serverless assets: %s", err.Error())
+		}
+
+		candidates := make([]utils.NameCandidate, 0)
+		for _, asset := range assetsPaginator.Assets {
+			candidates = append(candidates, utils.NameCandidate{Sid: asset.Sid, Names: []interface{}{asset.FriendlyName}})
+		}
+		assetSid, err := utils.FindSidByName("serverless asset", segments[2], candidates)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("/Services/%s/Assets/%s", serviceSid, assetSid), nil
+	case "environments":
+		environmentsPaginator := serviceClient.Environments.NewEnvironmentsPaginator()
+		for environmentsPaginator.NextWithContext(ctx) {
+		}
+		if err := environmentsPaginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list serverless environments: %s", err.Error())
+		}
+
+		candidates := make([]utils.NameCandidate, 0)
+		for _, environment := range environmentsPaginator.Environments {
+			candidates = append(candidates, utils.NameCandidate{Sid: environment.Sid, Names: []interface{}{environment.UniqueName}})
+		}
+		environmentSid, err := utils.FindSidByName("serverless environment", segments[2], candidates)
+		if err != nil {
+			return "", err
+		}
+
+		if len(segments) == 3 {
+			return fmt.Sprintf("/Services/%s/Environments/%s", serviceSid, environmentSid), nil
+		}
+		if segments[3] != "variables" {
+			break
+		}
+
+		variablesPaginator := serviceClient.Environment(environmentSid).Variables.NewVariablesPaginator()
+		for variablesPaginator.NextWithContext(ctx) {
+		}
+		if err := variablesPaginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list serverless variables: %s", err.Error())
+		}
+
+		variableCandidates := make([]utils.NameCandidate, 0)
+		for _, variable := range variablesPaginator.Variables {
+			variableCandidates = append(variableCandidates, utils.NameCandidate{Sid: variable.Sid, Names: []interface{}{variable.Key}})
+		}
+		variableSid, err := utils.FindSidByName("serverless variable", segments[4], variableCandidates)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("/Services/%s/Environments/%s/Variables/%s", serviceSid, environmentSid, variableSid), nil
+	}
+
+	return "", fmt.Errorf("The imported ID (%s) does not match the format (%s)", id, namedImportIDFormat)
+}
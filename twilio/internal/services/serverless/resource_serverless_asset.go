@@ -32,10 +32,15 @@ func resourceServerlessAsset() *schema.Resource {
 		DeleteContext: resourceServerlessAssetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Services/(.*)/Assets/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -154,7 +159,7 @@ func resourceServerlessAssetCreate(ctx context.Context, d *schema.ResourceData,
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Assets.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create serverless asset: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless asset", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -177,7 +182,7 @@ func resourceServerlessAssetRead(ctx context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless asset: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless asset", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -200,7 +205,7 @@ func resourceServerlessAssetRead(ctx context.Context, d *schema.ResourceData, me
 	versionsPaginator.Next()
 
 	if versionsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read serverless asset versions: %s", versionsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read serverless asset versions", versionsPaginator.Error())
 	}
 
 	if len(versionsPaginator.Versions) > 0 {
@@ -226,7 +231,7 @@ func resourceServerlessAssetUpdate(ctx context.Context, d *schema.ResourceData,
 
 		updateResp, err := client.Service(d.Get("service_sid").(string)).Asset(d.Id()).UpdateWithContext(ctx, updateInput)
 		if err != nil {
-			return diag.Errorf("Failed to update serverless asset: %s", err.Error())
+			return utils.TranslateError("Failed to update serverless asset", err)
 		}
 
 		d.SetId(updateResp.Sid)
@@ -245,7 +250,7 @@ func resourceServerlessAssetDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).Serverless
 
 	if err := client.Service(d.Get("service_sid").(string)).Asset(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete serverless asset: %s", err.Error())
+		return utils.TranslateError("Failed to delete serverless asset", err)
 	}
 	d.SetId("")
 	return nil
@@ -297,7 +302,7 @@ func createAssetVersion(ctx context.Context, d *schema.ResourceData, client *ser
 	}
 
 	if _, err := client.Service(d.Get("service_sid").(string)).Asset(d.Id()).Versions.CreateWithContext(ctx, createInput); err != nil {
-		return diag.Errorf("Failed to create serverless asset version: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless asset version", err)
 	}
 
 	return nil
@@ -260,7 +260,7 @@ func resourceServerlessBuildCreate(ctx context.Context, d *schema.ResourceData,
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Builds.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create serverless build: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless build", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -284,7 +284,7 @@ func resourceServerlessBuildRead(ctx context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless build: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless build", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -316,7 +316,7 @@ func resourceServerlessBuildDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).Serverless
 
 	if err := client.Service(d.Get("service_sid").(string)).Build(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete serverless build: %s", err.Error())
+		return utils.TranslateError("Failed to delete serverless build", err)
 	}
 
 	d.SetId("")
@@ -334,23 +334,31 @@ func expandVersionSids(input []interface{}) *[]string {
 
 func poll(ctx context.Context, d *schema.ResourceData, client *common.TwilioClient, pollingConfig map[string]interface{}) diag.Diagnostics {
 	if pollingConfig["enabled"].(bool) {
-		for i := 0; i < pollingConfig["max_attempts"].(int); i++ {
-			log.Printf("[INFO] Build Polling attempt # %v", i+1)
-
-			getResponse, err := client.Serverless.Service(d.Get("service_sid").(string)).Build(d.Id()).Status().FetchWithContext(ctx)
-			if err != nil {
-				return diag.Errorf("Failed to poll serverless build: %s", err.Error())
-			}
+		waiter := &utils.Waiter{
+			Description: "serverless build",
+			Pending:     []string{"building"},
+			Target:      []string{"completed"},
+			Refresh: func(ctx context.Context) (interface{}, string, error) {
+				getResponse, err := client.Serverless.Service(d.Get("service_sid").(string)).Build(d.Id()).Status().FetchWithContext(ctx)
+				if err != nil {
+					return nil, "", err
+				}
+				return getResponse, getResponse.Status, nil
+			},
+			Timeout:     d.Timeout(schema.TimeoutCreate),
+			Delay:       time.Duration(pollingConfig["delay_in_ms"].(int)) * time.Millisecond,
+			MaxAttempts: pollingConfig["max_attempts"].(int),
+		}
 
-			if getResponse.Status == "failed" {
+		if _, err := waiter.WaitWithContext(ctx); err != nil {
+			if stateErr, ok := err.(*utils.UnexpectedStateError); ok && stateErr.State == "failed" {
 				return diag.Errorf("Serverless build failed")
 			}
-			if getResponse.Status == "completed" {
-				return nil
+			if _, ok := err.(*utils.WaiterTimeoutError); ok {
+				return diag.Errorf("Reached max polling attempts or timeout without a completed build: %s", err.Error())
 			}
-			time.Sleep(time.Duration(pollingConfig["delay_in_ms"].(int)) * time.Millisecond)
+			return utils.TranslateError("Failed to poll serverless build", err)
 		}
-		return diag.Errorf("Reached max polling attempts without a completed build")
 	}
 	return nil
 }
@@ -103,10 +103,15 @@ func resourceServerlessDeployment() *schema.Resource {
 func resourceServerlessDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	createResult, err := createServerlessDeployment(ctx, d, meta, utils.OptionalString(d, "build_sid"))
 	if err != nil {
-		return diag.Errorf("Failed to create serverless deployment: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless deployment", err)
 	}
 
 	d.SetId(createResult.Sid)
+
+	if err := waitForDeployment(ctx, d, meta, d.Get("build_sid").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return utils.TranslateError("Failed to wait for serverless deployment to complete", err)
+	}
+
 	return resourceServerlessDeploymentRead(ctx, d, meta)
 }
 
@@ -121,7 +126,7 @@ func resourceServerlessDeploymentRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless deployment: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless deployment", err)
 	}
 
 	deploymentsPaginator := environmentsClient.Deployments.NewDeploymentsPaginatorWithOptions(&deployments.DeploymentsPageOptions{
@@ -132,7 +137,7 @@ func resourceServerlessDeploymentRead(ctx context.Context, d *schema.ResourceDat
 	deploymentsPaginator.Next()
 
 	if deploymentsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read serverless deployments: %s", deploymentsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read serverless deployments", deploymentsPaginator.Error())
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -162,7 +167,11 @@ func resourceServerlessDeploymentDelete(ctx context.Context, d *schema.ResourceD
 		log.Printf("[INFO] Serverless deployments cannot be deleted. So a new deployment will be created without a build sid as this will supersede the current deployment")
 
 		if _, err := createServerlessDeployment(ctx, d, meta, nil); err != nil {
-			return diag.Errorf("Failed to create deployment without build sid: %s", err.Error())
+			return utils.TranslateError("Failed to create deployment without build sid", err)
+		}
+
+		if err := waitForDeployment(ctx, d, meta, "", d.Timeout(schema.TimeoutDelete)); err != nil {
+			return utils.TranslateError("Failed to wait for deployment without build sid to complete", err)
 		}
 	}
 
@@ -190,3 +199,35 @@ func doesBuildNeedsRemoving(ctx context.Context, d *schema.ResourceData, meta in
 
 	return sdkUtils.Bool(resp.BuildSid != nil && *resp.BuildSid == d.Get("build_sid").(string)), nil
 }
+
+// waitForDeployment waits until the build is active on the environment. When no build sid is supplied the waiter waits until the environment no longer has a build
+func waitForDeployment(ctx context.Context, d *schema.ResourceData, meta interface{}, buildSid string, timeout time.Duration) error {
+	client := meta.(*common.TwilioClient).Serverless
+
+	waiter := &utils.Waiter{
+		Description: "serverless deployment",
+		Pending:     []string{"deploying"},
+		Target:      []string{"deployed"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			resp, err := client.Service(d.Get("service_sid").(string)).Environment(d.Get("environment_sid").(string)).FetchWithContext(ctx)
+			if err != nil {
+				return nil, "", err
+			}
+
+			currentBuildSid := ""
+			if resp.BuildSid != nil {
+				currentBuildSid = *resp.BuildSid
+			}
+			if currentBuildSid != buildSid {
+				return resp, "deploying", nil
+			}
+			return resp, "deployed", nil
+		},
+		Timeout:  timeout,
+		Delay:    1 * time.Second,
+		MaxDelay: 10 * time.Second,
+	}
+
+	_, err := waiter.WaitWithContext(ctx)
+	return err
+}
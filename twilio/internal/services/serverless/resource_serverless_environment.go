@@ -21,10 +21,15 @@ func resourceServerlessEnvironment() *schema.Resource {
 		DeleteContext: resourceServerlessEnvironmentDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Services/(.*)/Environments/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -104,7 +109,7 @@ func resourceServerlessEnvironmentCreate(ctx context.Context, d *schema.Resource
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Environments.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create serverless environment: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless environment", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -120,7 +125,7 @@ func resourceServerlessEnvironmentRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless environment: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless environment", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -145,7 +150,7 @@ func resourceServerlessEnvironmentDelete(ctx context.Context, d *schema.Resource
 	client := meta.(*common.TwilioClient).Serverless
 
 	if err := client.Service(d.Get("service_sid").(string)).Environment(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete serverless service: %s", err.Error())
+		return utils.TranslateError("Failed to delete serverless service", err)
 	}
 	d.SetId("")
 	return nil
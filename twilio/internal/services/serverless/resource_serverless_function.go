@@ -32,10 +32,15 @@ func resourceServerlessFunction() *schema.Resource {
 		DeleteContext: resourceServerlessFunctionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Services/(.*)/Functions/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -155,7 +160,7 @@ func resourceServerlessFunctionCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Functions.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create serverless function: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless function", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -178,7 +183,7 @@ func resourceServerlessFunctionRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless function: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless function", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -201,7 +206,7 @@ func resourceServerlessFunctionRead(ctx context.Context, d *schema.ResourceData,
 	versionsPaginator.Next()
 
 	if versionsPaginator.Error() != nil {
-		return diag.Errorf("Failed to read serverless function versions: %s", versionsPaginator.Error().Error())
+		return utils.TranslateError("Failed to read serverless function versions", versionsPaginator.Error())
 	}
 
 	if len(versionsPaginator.Versions) > 0 {
@@ -217,7 +222,7 @@ func resourceServerlessFunctionRead(ctx context.Context, d *schema.ResourceData,
 				d.SetId("")
 				return nil
 			}
-			return diag.Errorf("Failed to read serverless function version content: %s", err.Error())
+			return utils.TranslateError("Failed to read serverless function version content", err)
 		}
 
 		d.Set("content", contentGetResponse.Content)
@@ -238,7 +243,7 @@ func resourceServerlessFunctionUpdate(ctx context.Context, d *schema.ResourceDat
 
 		updateResp, err := client.Service(d.Get("service_sid").(string)).Function(d.Id()).UpdateWithContext(ctx, updateInput)
 		if err != nil {
-			return diag.Errorf("Failed to update serverless function: %s", err.Error())
+			return utils.TranslateError("Failed to update serverless function", err)
 		}
 
 		d.SetId(updateResp.Sid)
@@ -257,7 +262,7 @@ func resourceServerlessFunctionDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).Serverless
 
 	if err := client.Service(d.Get("service_sid").(string)).Function(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete serverless function: %s", err.Error())
+		return utils.TranslateError("Failed to delete serverless function", err)
 	}
 	d.SetId("")
 	return nil
@@ -309,7 +314,7 @@ func createFunctionVersion(ctx context.Context, d *schema.ResourceData, client *
 	}
 
 	if _, err := client.Service(d.Get("service_sid").(string)).Function(d.Id()).Versions.CreateWithContext(ctx, createInput); err != nil {
-		return diag.Errorf("Failed to create serverless function version: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless function version", err)
 	}
 
 	return nil
@@ -23,10 +23,15 @@ func resourceServerlessService() *schema.Resource {
 		DeleteContext: resourceServerlessServiceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Services/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 2 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -103,7 +108,7 @@ func resourceServerlessServiceCreate(ctx context.Context, d *schema.ResourceData
 
 	createResult, err := client.Services.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create serverless service: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless service", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -119,7 +124,7 @@ func resourceServerlessServiceRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless service: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless service", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -150,7 +155,7 @@ func resourceServerlessServiceUpdate(ctx context.Context, d *schema.ResourceData
 
 	updateResp, err := client.Service(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update serverless service: %s", err.Error())
+		return utils.TranslateError("Failed to update serverless service", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -161,7 +166,7 @@ func resourceServerlessServiceDelete(ctx context.Context, d *schema.ResourceData
 	client := meta.(*common.TwilioClient).Serverless
 
 	if err := client.Service(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete serverless service: %s", err.Error())
+		return utils.TranslateError("Failed to delete serverless service", err)
 	}
 	d.SetId("")
 	return nil
@@ -23,10 +23,15 @@ func resourceServerlessVariable() *schema.Resource {
 		DeleteContext: resourceServerlessVariableDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Services/(.*)/Environments/(.*)/Variables/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 4 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -104,7 +109,7 @@ func resourceServerlessVariableCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Service(d.Get("service_sid").(string)).Environment(d.Get("environment_sid").(string)).Variables.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create serverless variable: %s", err.Error())
+		return utils.TranslateError("Failed to create serverless variable", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -120,7 +125,7 @@ func resourceServerlessVariableRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read serverless variable: %s", err.Error())
+		return utils.TranslateError("Failed to read serverless variable", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -150,7 +155,7 @@ func resourceServerlessVariableUpdate(ctx context.Context, d *schema.ResourceDat
 
 	updateResp, err := client.Service(d.Get("service_sid").(string)).Environment(d.Get("environment_sid").(string)).Variable(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update serverless variable: %s", err.Error())
+		return utils.TranslateError("Failed to update serverless variable", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -161,7 +166,7 @@ func resourceServerlessVariableDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).Serverless
 
 	if err := client.Service(d.Get("service_sid").(string)).Environment(d.Get("environment_sid").(string)).Variable(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete serverless variable: %s", err.Error())
+		return utils.TranslateError("Failed to delete serverless variable", err)
 	}
 	d.SetId("")
 	return nil
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var buildDataSourceName = "twilio_serverless_build"
 
 func TestAccDataSourceTwilioServerlessBuild_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.build", buildDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "3.6.2"
 
 	resource.ParallelTest(t, resource.TestCase{
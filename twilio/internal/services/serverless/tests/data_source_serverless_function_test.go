@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,8 +13,8 @@ var functionDataSourceName = "twilio_serverless_function"
 
 func TestAccDataSourceTwilioServerlessFunction_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.function", functionDataSourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,8 +13,8 @@ var functionsDataSourceName = "twilio_serverless_functions"
 
 func TestAccDataSourceTwilioServerlessFunctions_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.functions", functionsDataSourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -44,6 +43,65 @@ func TestAccDataSourceTwilioServerlessFunctions_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceTwilioServerlessFunctions_filter(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.functions", functionsDataSourceName)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioServerlessFunctions_filter(uniqueName, friendlyName, "/second.*", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "functions.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "functions.0.friendly_name", friendlyName+"-second"),
+				),
+			},
+			{
+				Config: testAccDataSourceTwilioServerlessFunctions_filter(uniqueName, friendlyName, "/second.*", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "functions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioServerlessFunctions_maxResults(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.functions", functionsDataSourceName)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioServerlessFunctions_maxResults(uniqueName, friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "functions.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "functions.0.friendly_name", friendlyName+"-first"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioServerlessFunctions_invalidFilterName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioServerlessFunctions_invalidFilterName(),
+				ExpectError: regexp.MustCompile(`(?s)expected filter.0.name to be one of`),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceTwilioServerlessFunctions_invalidServiceSid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -80,6 +138,80 @@ data "twilio_serverless_functions" "functions" {
 `, uniqueName, friendlyName, visibility)
 }
 
+func testAccDataSourceTwilioServerlessFunctions_multipleFunctions(uniqueName string, friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_serverless_service" "service" {
+  unique_name   = "service-%s"
+  friendly_name = "test"
+}
+
+resource "twilio_serverless_function" "first" {
+  service_sid       = twilio_serverless_service.service.sid
+  friendly_name     = "%s-first"
+  content           = "ZXhwb3J0cy5oYW5kbGVyID0gZnVuY3Rpb24gKGNvbnRleHQsIGV2ZW50LCBjYWxsYmFjaykgewogIGNhbGxiYWNrKG51bGwsICJIZWxsbyBXb3JsZCIpOwp9Owo="
+  content_type      = "application/javascript"
+  content_file_name = "helloWorld.js"
+  path              = "/first-function"
+  visibility        = "private"
+}
+
+resource "twilio_serverless_function" "second" {
+  service_sid       = twilio_serverless_service.service.sid
+  friendly_name     = "%s-second"
+  content           = "ZXhwb3J0cy5oYW5kbGVyID0gZnVuY3Rpb24gKGNvbnRleHQsIGV2ZW50LCBjYWxsYmFjaykgewogIGNhbGxiYWNrKG51bGwsICJIZWxsbyBXb3JsZCIpOwp9Owo="
+  content_type      = "application/javascript"
+  content_file_name = "helloWorld.js"
+  path              = "/second-function"
+  visibility        = "private"
+}
+`, uniqueName, friendlyName, friendlyName)
+}
+
+func testAccDataSourceTwilioServerlessFunctions_filter(uniqueName string, friendlyName string, path string, regex bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "twilio_serverless_functions" "functions" {
+  service_sid = twilio_serverless_service.service.sid
+
+  filter {
+    name   = "path"
+    values = ["%s"]
+    regex  = %t
+  }
+
+  depends_on = [twilio_serverless_function.first, twilio_serverless_function.second]
+}
+`, testAccDataSourceTwilioServerlessFunctions_multipleFunctions(uniqueName, friendlyName), path, regex)
+}
+
+func testAccDataSourceTwilioServerlessFunctions_maxResults(uniqueName string, friendlyName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "twilio_serverless_functions" "functions" {
+  service_sid = twilio_serverless_service.service.sid
+  sort_by     = "path"
+  max_results = 1
+
+  depends_on = [twilio_serverless_function.first, twilio_serverless_function.second]
+}
+`, testAccDataSourceTwilioServerlessFunctions_multipleFunctions(uniqueName, friendlyName))
+}
+
+func testAccDataSourceTwilioServerlessFunctions_invalidFilterName() string {
+	return `
+data "twilio_serverless_functions" "functions" {
+  service_sid = "ZSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+  filter {
+    name   = "invalid"
+    values = ["value"]
+  }
+}
+`
+}
+
 func testAccDataSourceTwilioServerlessFunctions_invalidServiceSid() string {
 	return `
 data "twilio_serverless_functions" "functions" {
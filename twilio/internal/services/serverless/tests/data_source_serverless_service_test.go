@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,8 +13,8 @@ var serviceDataSourceName = "twilio_serverless_service"
 
 func TestAccDataSourceTwilioServerlessService_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("%s.service", serviceDataSourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -42,8 +41,8 @@ func TestAccDataSourceTwilioServerlessService_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioServerlessService_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("%s.service", serviceDataSourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
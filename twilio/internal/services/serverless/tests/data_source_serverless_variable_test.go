@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var variableDataSourceName = "twilio_serverless_variable"
 
 func TestAccDataSourceTwilioServerlessVariable_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.variable", variableDataSourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := "test-key"
 	value := "test-value"
 
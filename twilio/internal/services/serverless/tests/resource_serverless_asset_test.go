@@ -17,8 +17,8 @@ var assetResourceName = "twilio_serverless_asset"
 
 func TestAccTwilioServerlessAsset_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.asset", assetResourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -60,8 +60,8 @@ func TestAccTwilioServerlessAsset_basic(t *testing.T) {
 func TestAccTwilioServerlessAsset_multipleAssets(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.asset", assetResourceName)
 	stateResourceName2 := fmt.Sprintf("%s.asset2", assetResourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,8 +81,8 @@ func TestAccTwilioServerlessAsset_multipleAssets(t *testing.T) {
 }
 
 func TestAccTwilioServerlessAssetVersion_invalidVisibility(t *testing.T) {
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -100,9 +100,9 @@ func TestAccTwilioServerlessAssetVersion_invalidVisibility(t *testing.T) {
 func TestAccTwilioServerlessAsset_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.asset", assetResourceName)
 
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 	visibility := "private"
 	newVisibility := "protected"
 
@@ -169,7 +169,7 @@ func TestAccTwilioServerlessAsset_invalidServiceSid(t *testing.T) {
 func TestAccTwilioServerlessAsset_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.asset", assetResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := acctest.RandString(1)
 	newFriendlyName := acctest.RandString(255)
 	visibility := "private"
@@ -230,7 +230,7 @@ func TestAccTwilioServerlessAsset_invalidFriendlyNameWith256Characters(t *testin
 func TestAccTwilioServerlessAsset_path(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.asset", assetResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := acctest.RandString(1)
 	visibility := "private"
 	path := "/a"
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioServerlessBuild_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.build", buildResourceName)
 	assetResourceName := "twilio_serverless_asset.asset"
 	functionResourceName := "twilio_serverless_function.function"
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -59,7 +58,7 @@ func TestAccTwilioServerlessBuild_basic(t *testing.T) {
 
 func TestAccTwilioServerlessBuild_functions(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.build", buildResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -102,7 +101,7 @@ func TestAccTwilioServerlessBuild_functions(t *testing.T) {
 
 func TestAccTwilioServerlessBuild_assets(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.build", buildResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -145,7 +144,7 @@ func TestAccTwilioServerlessBuild_assets(t *testing.T) {
 
 func TestAccTwilioServerlessBuild_dependenciesAndRuntime(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.build", buildResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "3.6.2"
 	runtime := "node14"
 
@@ -168,7 +167,7 @@ func TestAccTwilioServerlessBuild_dependenciesAndRuntime(t *testing.T) {
 }
 
 func TestAccTwilioServerlessBuild_invalidRuntime(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	version := "3.6.2"
 	runtime := "python2"
 
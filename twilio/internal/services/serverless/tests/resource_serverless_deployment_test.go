@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var deploymentResourceName = "twilio_serverless_deployment"
 
 func TestAccTwilioServerlessDeployment_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.deployment", deploymentResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	// Run tests in parallel as I got rate limited when they ran in parallel
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccTwilioServerlessDeployment_basic(t *testing.T) {
 
 func TestAccTwilioServerlessDeployment_createBeforeDestroy(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.deployment", deploymentResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -105,7 +104,7 @@ func TestAccTwilioServerlessDeployment_createBeforeDestroy(t *testing.T) {
 
 func TestAccTwilioServerlessDeployment_removeBuildAndDeployment(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.deployment", deploymentResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -17,7 +17,7 @@ var environmentResourceName = "twilio_serverless_environment"
 
 func TestAccTwilioServerlessEnvironment_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.environment", environmentResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -52,7 +52,7 @@ func TestAccTwilioServerlessEnvironment_basic(t *testing.T) {
 
 func TestAccTwilioServerlessEnvironment_domainSuffix(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.environment", environmentResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	domainSuffix := acctest.RandString(1)
 	newDomainSuffix := acctest.RandString(16)
 
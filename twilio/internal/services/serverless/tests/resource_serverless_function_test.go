@@ -17,8 +17,8 @@ var functionResourceName = "twilio_serverless_function"
 
 func TestAccTwilioServerlessFunction_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.function", functionResourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,6 +53,13 @@ func TestAccTwilioServerlessFunction_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"content_file_name", "content_type", "source_hash"},
 			},
+			{
+				ResourceName:            stateResourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("serverless:%s/functions/%s", uniqueName, friendlyName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_file_name", "content_type", "source_hash"},
+			},
 		},
 	})
 }
@@ -60,8 +67,8 @@ func TestAccTwilioServerlessFunction_basic(t *testing.T) {
 func TestAccTwilioServerlessFunction_multipleFunctions(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.function", functionResourceName)
 	stateResourceName2 := fmt.Sprintf("%s.function2", functionResourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "private"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,8 +88,8 @@ func TestAccTwilioServerlessFunction_multipleFunctions(t *testing.T) {
 }
 
 func TestAccTwilioServerlessFunctionFunction_invalidVisibility(t *testing.T) {
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 	visibility := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -100,9 +107,9 @@ func TestAccTwilioServerlessFunctionFunction_invalidVisibility(t *testing.T) {
 func TestAccTwilioServerlessFunction_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.function", functionResourceName)
 
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 	visibility := "private"
 	newVisibility := "protected"
 
@@ -171,7 +178,7 @@ func TestAccTwilioServerlessFunction_invalidServiceSid(t *testing.T) {
 func TestAccTwilioServerlessFunction_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.function", functionResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := acctest.RandString(1)
 	newFriendlyName := acctest.RandString(255)
 	visibility := "private"
@@ -232,7 +239,7 @@ func TestAccTwilioServerlessFunction_invalidFriendlyNameWith256Characters(t *tes
 func TestAccTwilioServerlessFunction_path(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.function", functionResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := acctest.RandString(1)
 	visibility := "private"
 	path := "/a"
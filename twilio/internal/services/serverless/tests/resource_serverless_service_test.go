@@ -17,8 +17,8 @@ var serviceResourceName = "twilio_serverless_service"
 
 func TestAccTwilioServerlessService_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -54,9 +54,9 @@ func TestAccTwilioServerlessService_basic(t *testing.T) {
 func TestAccTwilioServerlessService_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -102,8 +102,8 @@ func TestAccTwilioServerlessService_update(t *testing.T) {
 func TestAccTwilioServerlessService_uiEditable(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -138,8 +138,8 @@ func TestAccTwilioServerlessService_uiEditable(t *testing.T) {
 func TestAccTwilioServerlessService_includeCredentials(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	uniqueName := acctest.RandString(10)
-	friendlyName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -176,7 +176,7 @@ func TestAccTwilioServerlessService_uniqueName(t *testing.T) {
 
 	uniqueName := acctest.RandString(1)
 	newUniqueName := acctest.RandString(50)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -203,7 +203,7 @@ func TestAccTwilioServerlessService_uniqueName(t *testing.T) {
 
 func TestAccTwilioServerlessService_invalidUniqueNameWith0Characters(t *testing.T) {
 	uniqueName := ""
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -219,7 +219,7 @@ func TestAccTwilioServerlessService_invalidUniqueNameWith0Characters(t *testing.
 
 func TestAccTwilioServerlessService_invalidUniqueNameWith51Characters(t *testing.T) {
 	uniqueName := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -236,7 +236,7 @@ func TestAccTwilioServerlessService_invalidUniqueNameWith51Characters(t *testing
 func TestAccTwilioServerlessService_friendlyName(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.service", serviceResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := acctest.RandString(1)
 	newFriendlyName := acctest.RandString(255)
 
@@ -264,7 +264,7 @@ func TestAccTwilioServerlessService_friendlyName(t *testing.T) {
 }
 
 func TestAccTwilioServerlessService_invalidFriendlyNameWith0Characters(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := ""
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -280,7 +280,7 @@ func TestAccTwilioServerlessService_invalidFriendlyNameWith0Characters(t *testin
 }
 
 func TestAccTwilioServerlessService_invalidFriendlyNameWith256Characters(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	friendlyName := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,7 +17,7 @@ var variableResourceName = "twilio_serverless_variable"
 
 func TestAccTwilioServerlessVariable_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.variable", variableResourceName)
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := "test-key"
 	value := "test-value"
 
@@ -55,7 +55,7 @@ func TestAccTwilioServerlessVariable_basic(t *testing.T) {
 func TestAccTwilioServerlessVariable_key(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.variable", variableResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := acctest.RandString(1)
 	newKey := acctest.RandString(128)
 	value := "test-value"
@@ -84,7 +84,7 @@ func TestAccTwilioServerlessVariable_key(t *testing.T) {
 }
 
 func TestAccTwilioServerlessVariable_invalidKeyWith0Characters(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := ""
 	value := "test-value"
 
@@ -101,7 +101,7 @@ func TestAccTwilioServerlessVariable_invalidKeyWith0Characters(t *testing.T) {
 }
 
 func TestAccTwilioServerlessVariable_invalidKeyWith129Characters(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 	value := "test-value"
 
@@ -120,7 +120,7 @@ func TestAccTwilioServerlessVariable_invalidKeyWith129Characters(t *testing.T) {
 func TestAccTwilioServerlessVariable_value(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.variable", variableResourceName)
 
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := "test-key"
 	value := acctest.RandString(1)
 	newValue := acctest.RandString(10)
@@ -149,7 +149,7 @@ func TestAccTwilioServerlessVariable_value(t *testing.T) {
 }
 
 func TestAccTwilioServerlessFunction_invalidValue(t *testing.T) {
-	uniqueName := acctest.RandString(10)
+	uniqueName := acceptance.RandomName()
 	key := "test-key"
 	value := ""
 
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_serverless_service", &resource.Sweeper{
+		Name: "twilio_serverless_service",
+		F:    sweepServerlessServices,
+	})
+}
+
+func sweepServerlessServices(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Serverless
+
+	ctx := context.Background()
+	paginator := client.Services.NewServicesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list serverless services: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Services {
+		if !acceptance.HasResourcePrefix(item.UniqueName) {
+			continue
+		}
+
+		acceptance.LogSweep("serverless service", item.Sid, item.UniqueName)
+		if err := client.Service(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("serverless service", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -26,7 +26,8 @@ func dataSourceSIPCredential() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"credential_list_sid": {
@@ -53,7 +54,7 @@ func dataSourceSIPCredential() *schema.Resource {
 func dataSourceSIPCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	credentialListSid := d.Get("credential_list_sid").(string)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Sip.CredentialList(credentialListSid).Credential(sid).FetchWithContext(ctx)
@@ -61,7 +62,7 @@ func dataSourceSIPCredentialRead(ctx context.Context, d *schema.ResourceData, me
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP credential with sid (%s) was not found for account with sid (%s) and credential list with sid (%s)", sid, accountSid, credentialListSid)
 		}
-		return diag.Errorf("Failed to read SIP credential: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP credential", err)
 	}
 
 	d.SetId(getResponse.Sid)
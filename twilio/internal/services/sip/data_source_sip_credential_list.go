@@ -26,7 +26,8 @@ func dataSourceSIPCredentialList() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"friendly_name": {
@@ -48,14 +49,14 @@ func dataSourceSIPCredentialList() *schema.Resource {
 func dataSourceSIPCredentialListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Sip.CredentialList(sid).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP credential list with sid (%s) was not found for account with sid (%s)", sid, accountSid)
 		}
-		return diag.Errorf("Failed to read SIP credential list: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP credential list", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,10 +18,11 @@ func dataSourceSIPCredentials() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("credentials", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"credential_list_sid": {
@@ -53,14 +54,14 @@ func dataSourceSIPCredentials() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceSIPCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	credentialListSid := d.Get("credential_list_sid").(string)
 	paginator := client.Account(accountSid).Sip.CredentialList(credentialListSid).Credentials.NewCredentialsPaginator()
 	for paginator.NextWithContext(ctx) {
@@ -71,7 +72,7 @@ func dataSourceSIPCredentialsRead(ctx context.Context, d *schema.ResourceData, m
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No SIP credentials were found for account with sid (%s) and credential list with sid (%s)", accountSid, credentialListSid)
 		}
-		return diag.Errorf("Failed to list SIP credentials: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP credentials", err)
 	}
 
 	d.SetId(accountSid + "/" + credentialListSid)
@@ -94,7 +95,11 @@ func dataSourceSIPCredentialsRead(ctx context.Context, d *schema.ResourceData, m
 		credentials = append(credentials, credentialMap)
 	}
 
-	d.Set("credentials", &credentials)
+	filteredCredentials, err := utils.FilterListItems(d, credentials)
+	if err != nil {
+		return diag.Errorf("Failed to filter credentials: %s", err.Error())
+	}
+	d.Set("credentials", &filteredCredentials)
 
 	return nil
 }
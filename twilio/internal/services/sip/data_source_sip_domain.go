@@ -27,7 +27,8 @@ func dataSourceSIPDomain() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"domain_name": {
@@ -117,14 +118,14 @@ func dataSourceSIPDomain() *schema.Resource {
 func dataSourceSIPDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Sip.Domain(sid).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP domain with sid (%s) was not found for account with sid (%s)", sid, accountSid)
 		}
-		return diag.Errorf("Failed to read SIP domain: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP domain", err)
 	}
 
 	d.SetId(getResponse.Sid)
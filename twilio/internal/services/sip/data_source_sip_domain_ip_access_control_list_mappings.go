@@ -18,10 +18,11 @@ func dataSourceSIPDomainIPAccessControlListMappings() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("ip_access_control_list_mappings", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"domain_sid": {
@@ -53,14 +54,14 @@ func dataSourceSIPDomainIPAccessControlListMappings() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceSIPDomainIPAccessControlListMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	domainSid := d.Get("domain_sid").(string)
 	paginator := client.Account(accountSid).Sip.Domain(domainSid).Auth.Calls.IpAccessControlListMappings.NewIpAccessControlListMappingsPaginator()
 	for paginator.NextWithContext(ctx) {
@@ -71,7 +72,7 @@ func dataSourceSIPDomainIPAccessControlListMappingsRead(ctx context.Context, d *
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No SIP domain IP access control list mappings were found for account with sid (%s) and domain with sid (%s)", accountSid, domainSid)
 		}
-		return diag.Errorf("Failed to list SIP domain IP access control list mappings: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP domain IP access control list mappings", err)
 	}
 
 	d.SetId(accountSid + "/" + domainSid)
@@ -94,7 +95,11 @@ func dataSourceSIPDomainIPAccessControlListMappingsRead(ctx context.Context, d *
 		ipAccessControlListMappings = append(ipAccessControlListMappings, ipAccessControlListMappingMap)
 	}
 
-	d.Set("ip_access_control_list_mappings", &ipAccessControlListMappings)
+	filteredIpAccessControlListMappings, err := utils.FilterListItems(d, ipAccessControlListMappings)
+	if err != nil {
+		return diag.Errorf("Failed to filter ip access control list mappings: %s", err.Error())
+	}
+	d.Set("ip_access_control_list_mappings", &filteredIpAccessControlListMappings)
 
 	return nil
 }
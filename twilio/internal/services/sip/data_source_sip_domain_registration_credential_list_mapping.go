@@ -26,7 +26,8 @@ func dataSourceSIPDomainRegistrationCredentialListMapping() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"domain_sid": {
@@ -53,7 +54,7 @@ func dataSourceSIPDomainRegistrationCredentialListMapping() *schema.Resource {
 func dataSourceSIPDomainRegistrationCredentialListMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	domainSid := d.Get("domain_sid").(string)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Sip.Domain(domainSid).Auth.Registrations.CredentialListMapping(sid).FetchWithContext(ctx)
@@ -61,7 +62,7 @@ func dataSourceSIPDomainRegistrationCredentialListMappingRead(ctx context.Contex
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP domain regsitration credential list mapping with sid (%s) was not found for account with sid (%s) and domain with sid (%s)", sid, accountSid, domainSid)
 		}
-		return diag.Errorf("Failed to read SIP domain regsitration credential list mapping: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP domain regsitration credential list mapping", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,10 +18,11 @@ func dataSourceSIPDomainRegistrationCredentialListMappings() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("credential_list_mappings", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"domain_sid": {
@@ -53,14 +54,14 @@ func dataSourceSIPDomainRegistrationCredentialListMappings() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceSIPDomainRegistrationCredentialListMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	domainSid := d.Get("domain_sid").(string)
 	paginator := client.Account(accountSid).Sip.Domain(domainSid).Auth.Registrations.CredentialListMappings.NewCredentialListMappingsPaginator()
 	for paginator.NextWithContext(ctx) {
@@ -71,7 +72,7 @@ func dataSourceSIPDomainRegistrationCredentialListMappingsRead(ctx context.Conte
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No SIP domain registration credential list mappings were found for account with sid (%s) and domain with sid (%s)", accountSid, domainSid)
 		}
-		return diag.Errorf("Failed to list SIP domain registration credential list mappings: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP domain registration credential list mappings", err)
 	}
 
 	d.SetId(accountSid + "/" + domainSid)
@@ -94,7 +95,11 @@ func dataSourceSIPDomainRegistrationCredentialListMappingsRead(ctx context.Conte
 		credentialListMappings = append(credentialListMappings, credentialListMappingMap)
 	}
 
-	d.Set("credential_list_mappings", &credentialListMappings)
+	filteredCredentialListMappings, err := utils.FilterListItems(d, credentialListMappings)
+	if err != nil {
+		return diag.Errorf("Failed to filter credential list mappings: %s", err.Error())
+	}
+	d.Set("credential_list_mappings", &filteredCredentialListMappings)
 
 	return nil
 }
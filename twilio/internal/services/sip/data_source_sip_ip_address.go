@@ -26,7 +26,8 @@ func dataSourceSIPIPAddress() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"ip_access_control_list_sid": {
@@ -61,7 +62,7 @@ func dataSourceSIPIPAddress() *schema.Resource {
 func dataSourceSIPIPAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	ipAccessControlListSid := d.Get("ip_access_control_list_sid").(string)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Sip.IpAccessControlList(ipAccessControlListSid).IpAddress(sid).FetchWithContext(ctx)
@@ -69,7 +70,7 @@ func dataSourceSIPIPAddressRead(ctx context.Context, d *schema.ResourceData, met
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP IP address with sid (%s) was not found for account with sid (%s) and IP access control list with sid (%s)", sid, accountSid, ipAccessControlListSid)
 		}
-		return diag.Errorf("Failed to read SIP IP address: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP IP address", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,10 +18,11 @@ func dataSourceSIPIPAddresses() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("ip_addresses", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"ip_access_control_list_sid": {
@@ -61,14 +62,14 @@ func dataSourceSIPIPAddresses() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceSIPIPAddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	ipAccessControlListSid := d.Get("ip_access_control_list_sid").(string)
 	paginator := client.Account(accountSid).Sip.IpAccessControlList(ipAccessControlListSid).IpAddresses.NewIpAddressesPaginator()
 	for paginator.NextWithContext(ctx) {
@@ -79,7 +80,7 @@ func dataSourceSIPIPAddressesRead(ctx context.Context, d *schema.ResourceData, m
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No SIP IP addresses were found for account with sid (%s) and IP access control list with sid (%s)", accountSid, ipAccessControlListSid)
 		}
-		return diag.Errorf("Failed to list SIP IP addresses: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP IP addresses", err)
 	}
 
 	d.SetId(accountSid + "/" + ipAccessControlListSid)
@@ -104,7 +105,11 @@ func dataSourceSIPIPAddressesRead(ctx context.Context, d *schema.ResourceData, m
 		ipAddresses = append(ipAddresses, ipAddressMap)
 	}
 
-	d.Set("ip_addresses", &ipAddresses)
+	filteredIpAddresses, err := utils.FilterListItems(d, ipAddresses)
+	if err != nil {
+		return diag.Errorf("Failed to filter ip addresses: %s", err.Error())
+	}
+	d.Set("ip_addresses", &filteredIpAddresses)
 
 	return nil
 }
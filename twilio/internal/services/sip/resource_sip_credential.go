@@ -35,6 +35,7 @@ func resourceSIPCredential() *schema.Resource {
 				d.Set("account_sid", match[1])
 				d.Set("credential_list_sid", match[2])
 				d.Set("sid", match[3])
+				d.Set("secret_storage", utils.SecretStoragePlaintext)
 				d.SetId(match[3])
 				return []*schema.ResourceData{d}, nil
 			},
@@ -54,7 +55,8 @@ func resourceSIPCredential() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -71,9 +73,10 @@ func resourceSIPCredential() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 32),
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: utils.SecretArgumentDiffSuppressFunc,
 				ValidateFunc: validation.All(
 					validation.StringMatch(regexp.MustCompile("^.{12,}$"), "Must contain at least 12 characters"),
 					validation.StringMatch(regexp.MustCompile("^.*[A-Z].*$"), "Must contain a uppercase letter"),
@@ -81,6 +84,7 @@ func resourceSIPCredential() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile("^.*[0-9].*$"), "Must contain a number"),
 				),
 			},
+			"secret_storage": utils.SecretStorageSchema(false),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -98,28 +102,29 @@ func resourceSIPCredentialCreate(ctx context.Context, d *schema.ResourceData, me
 
 	createInput := &credentials.CreateCredentialInput{
 		Username: d.Get("username").(string),
-		Password: d.Get("password").(string),
+		Password: utils.SecretArgumentFromConfig(d, "password"),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credentials.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credentials.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP credential: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP credential", err)
 	}
 
 	d.SetId(createResult.Sid)
+	d.Set("password", utils.SecretValueForState(d, "password", createInput.Password))
 	return resourceSIPCredentialRead(ctx, d, meta)
 }
 
 func resourceSIPCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credential(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credential(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP credential: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP credential", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -138,24 +143,28 @@ func resourceSIPCredentialRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceSIPCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	updateInput := &credential.UpdateCredentialInput{
-		Password: d.Get("password").(string),
-	}
+	password := utils.SecretArgumentFromConfig(d, "password")
 
-	updateResult, err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credential(d.Id()).UpdateWithContext(ctx, updateInput)
-	if err != nil {
-		return diag.Errorf("Failed to update SIP credential: %s", err.Error())
+	// The password is only sent to Twilio when it has changed, changing the storage mode only changes the value stored in state
+	if d.HasChange("password") {
+		updateInput := &credential.UpdateCredentialInput{
+			Password: password,
+		}
+
+		if _, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credential(d.Id()).UpdateWithContext(ctx, updateInput); err != nil {
+			return utils.TranslateError("Failed to update SIP credential", err)
+		}
 	}
 
-	d.SetId(updateResult.Sid)
+	d.Set("password", utils.SecretValueForState(d, "password", password))
 	return resourceSIPCredentialRead(ctx, d, meta)
 }
 
 func resourceSIPCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credential(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP credential: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Get("credential_list_sid").(string)).Credential(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete SIP credential", err)
 	}
 	d.SetId("")
 	return nil
@@ -53,7 +53,8 @@ func resourceSIPCredentialList() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -81,9 +82,9 @@ func resourceSIPCredentialListCreate(ctx context.Context, d *schema.ResourceData
 		FriendlyName: d.Get("friendly_name").(string),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Sip.CredentialLists.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialLists.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP credential list: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP credential list", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -93,13 +94,13 @@ func resourceSIPCredentialListCreate(ctx context.Context, d *schema.ResourceData
 func resourceSIPCredentialListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP credential list: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP credential list", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -121,9 +122,9 @@ func resourceSIPCredentialListUpdate(ctx context.Context, d *schema.ResourceData
 		FriendlyName: d.Get("friendly_name").(string),
 	}
 
-	updateResult, err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP credential list: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP credential list", err)
 	}
 
 	d.SetId(updateResult.Sid)
@@ -133,8 +134,8 @@ func resourceSIPCredentialListUpdate(ctx context.Context, d *schema.ResourceData
 func resourceSIPCredentialListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Sip.CredentialList(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP credential list: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.CredentialList(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete SIP credential list", err)
 	}
 	d.SetId("")
 	return nil
@@ -54,7 +54,8 @@ func resourceSIPDomain() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -195,9 +196,9 @@ func resourceSIPDomainCreate(ctx context.Context, d *schema.ResourceData, meta i
 		createInput.EmergencyCallingEnabled = utils.OptionalBool(d, "emergency.0.calling_enabled")
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Sip.Domains.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domains.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP domain: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP domain", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -207,13 +208,13 @@ func resourceSIPDomainCreate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceSIPDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Sip.Domain(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domain(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP domain: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP domain", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -260,9 +261,9 @@ func resourceSIPDomainUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		updateInput.EmergencyCallingEnabled = utils.OptionalBool(d, "emergency.0.calling_enabled")
 	}
 
-	updateResult, err := client.Account(d.Get("account_sid").(string)).Sip.Domain(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domain(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP domain: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP domain", err)
 	}
 
 	d.SetId(updateResult.Sid)
@@ -272,8 +273,8 @@ func resourceSIPDomainUpdate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceSIPDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Sip.Domain(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP domain: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domain(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete SIP domain", err)
 	}
 	d.SetId("")
 	return nil
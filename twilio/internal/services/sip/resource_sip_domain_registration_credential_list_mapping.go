@@ -50,7 +50,8 @@ func resourceSIPDomainRegistrationCredentialListMapping() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -89,9 +90,9 @@ func resourceSIPDomainRegistrationCredentialListMappingCreate(ctx context.Contex
 		CredentialListSid: d.Get("credential_list_sid").(string),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Sip.Domain(d.Get("domain_sid").(string)).Auth.Registrations.CredentialListMappings.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domain(d.Get("domain_sid").(string)).Auth.Registrations.CredentialListMappings.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP domain registration credential list mapping: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP domain registration credential list mapping", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -101,13 +102,13 @@ func resourceSIPDomainRegistrationCredentialListMappingCreate(ctx context.Contex
 func resourceSIPDomainRegistrationCredentialListMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Sip.Domain(d.Get("domain_sid").(string)).Auth.Registrations.CredentialListMapping(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domain(d.Get("domain_sid").(string)).Auth.Registrations.CredentialListMapping(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP domain registration credential list mapping: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP domain registration credential list mapping", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -126,8 +127,8 @@ func resourceSIPDomainRegistrationCredentialListMappingRead(ctx context.Context,
 func resourceSIPDomainRegistrationCredentialListMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Sip.Domain(d.Get("domain_sid").(string)).Auth.Registrations.CredentialListMapping(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP domain registration credential list mapping: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.Domain(d.Get("domain_sid").(string)).Auth.Registrations.CredentialListMapping(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete SIP domain registration credential list mapping", err)
 	}
 	d.SetId("")
 	return nil
@@ -53,7 +53,8 @@ func resourceSIPIPAccessControlList() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -81,9 +82,9 @@ func resourceSIPIPAccessControlListCreate(ctx context.Context, d *schema.Resourc
 		FriendlyName: d.Get("friendly_name").(string),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlLists.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlLists.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP IP access control list", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -93,13 +94,13 @@ func resourceSIPIPAccessControlListCreate(ctx context.Context, d *schema.Resourc
 func resourceSIPIPAccessControlListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP IP access control list", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -121,9 +122,9 @@ func resourceSIPIPAccessControlListUpdate(ctx context.Context, d *schema.Resourc
 		FriendlyName: d.Get("friendly_name").(string),
 	}
 
-	updateResult, err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP IP access control list", err)
 	}
 
 	d.SetId(updateResult.Sid)
@@ -133,8 +134,8 @@ func resourceSIPIPAccessControlListUpdate(ctx context.Context, d *schema.Resourc
 func resourceSIPIPAccessControlListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP IP access control list: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete SIP IP access control list", err)
 	}
 	d.SetId("")
 	return nil
@@ -54,7 +54,8 @@ func resourceSIPIPAddress() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -100,9 +101,9 @@ func resourceSIPIPAddressCreate(ctx context.Context, d *schema.ResourceData, met
 		CidrPrefixLength: utils.OptionalInt(d, "cidr_length_prefix"),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddresses.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddresses.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP IP address resource: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP IP address resource", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -112,13 +113,13 @@ func resourceSIPIPAddressCreate(ctx context.Context, d *schema.ResourceData, met
 func resourceSIPIPAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddress(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddress(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP IP address: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP IP address", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -145,9 +146,9 @@ func resourceSIPIPAddressUpdate(ctx context.Context, d *schema.ResourceData, met
 		CidrPrefixLength: utils.OptionalInt(d, "cidr_length_prefix"),
 	}
 
-	updateResult, err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddress(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddress(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP IP address: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP IP address", err)
 	}
 
 	d.SetId(updateResult.Sid)
@@ -157,8 +158,8 @@ func resourceSIPIPAddressUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceSIPIPAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddress(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP IP address: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Sip.IpAccessControlList(d.Get("ip_access_control_list_sid").(string)).IpAddress(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete SIP IP address", err)
 	}
 	d.SetId("")
 	return nil
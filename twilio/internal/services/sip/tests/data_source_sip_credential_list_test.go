@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -16,7 +15,7 @@ func TestAccDataSourceTwilioSIPCredentialList_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.credential_list", credentialListDataSourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
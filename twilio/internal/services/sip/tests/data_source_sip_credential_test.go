@@ -16,7 +16,7 @@ func TestAccDataSourceTwilioSIPCredential_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.credential", credentialDataSourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(10)
 	password := "A1" + acctest.RandString(12)
 
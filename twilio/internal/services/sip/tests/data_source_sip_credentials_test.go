@@ -16,7 +16,7 @@ func TestAccDataSourceTwilioSIPCredentials_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.credentials", credentialsDataSourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(10)
 	password := "A1" + acctest.RandString(12)
 
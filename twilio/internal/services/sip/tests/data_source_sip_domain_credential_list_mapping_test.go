@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -16,8 +15,8 @@ func TestAccDataSourceTwilioSIPDomainCredentialListMapping_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.credential_list_mapping", domainCredentialListMappingDataSourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	friendlyName := acceptance.RandomName()
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
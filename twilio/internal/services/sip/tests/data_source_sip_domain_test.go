@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -16,7 +15,7 @@ func TestAccDataSourceTwilioSIPDomain_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("%s.domain", domainDataSourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
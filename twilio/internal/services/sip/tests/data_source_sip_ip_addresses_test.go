@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -16,7 +15,7 @@ func TestAccDataSourceTwilioSIPIPAddresses_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.ip_addresses", ipAddressesDataSourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	ipAddress := "127.0.0.1"
 
 	resource.ParallelTest(t, resource.TestCase{
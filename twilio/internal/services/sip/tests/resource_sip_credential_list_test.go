@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioSIPCredentialList_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.credential_list", credentialListResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -52,8 +51,8 @@ func TestAccTwilioSIPCredentialList_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.credential_list", credentialListResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
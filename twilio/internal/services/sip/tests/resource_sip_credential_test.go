@@ -20,7 +20,7 @@ func TestAccTwilioSIPCredential_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.credential", credentialResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(10)
 	password := "A1" + acctest.RandString(10)
 
@@ -58,7 +58,7 @@ func TestAccTwilioSIPCredential_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.credential", credentialResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(10)
 	password := "A1" + acctest.RandString(12)
 	newPassword := "B2" + acctest.RandString(12)
@@ -104,7 +104,7 @@ func TestAccTwilioSIPCredential_username(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.credential", credentialResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(1)
 	newUsername := acctest.RandString(32)
 	password := "A1" + acctest.RandString(12)
@@ -134,7 +134,7 @@ func TestAccTwilioSIPCredential_username(t *testing.T) {
 
 func TestAccTwilioSIPCredential_invalidUsernameWithLengthOf0(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := ""
 	password := "A1" + acctest.RandString(12)
 
@@ -152,7 +152,7 @@ func TestAccTwilioSIPCredential_invalidUsernameWithLengthOf0(t *testing.T) {
 
 func TestAccTwilioSIPCredential_invalidUsernameWithLengthOf33(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 	password := "A1" + acctest.RandString(12)
 
@@ -172,7 +172,7 @@ func TestAccTwilioSIPCredential_password(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.credential", credentialResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(1)
 	password := "A1" + acctest.RandString(10)
 
@@ -192,9 +192,50 @@ func TestAccTwilioSIPCredential_password(t *testing.T) {
 	})
 }
 
+func TestAccTwilioSIPCredential_secretStorage(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.credential", credentialResourceName)
+
+	testData := acceptance.TestAccData
+	friendlyName := acceptance.RandomName()
+	username := acctest.RandString(10)
+	password := "A1" + acctest.RandString(12)
+	newPassword := "B2" + acctest.RandString(12)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioSIPCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioSIPCredential_secretStorage(testData, friendlyName, username, password, "hash"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioSIPCredentialExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "secret_storage", "hash"),
+					resource.TestCheckResourceAttrWith(stateResourceName, "password", testAccCheckSecretHash(password)),
+				),
+			},
+			{
+				Config: testAccTwilioSIPCredential_secretStorage(testData, friendlyName, username, newPassword, "hash"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioSIPCredentialExists(stateResourceName),
+					resource.TestCheckResourceAttrWith(stateResourceName, "password", testAccCheckSecretHash(newPassword)),
+				),
+			},
+			{
+				Config: testAccTwilioSIPCredential_secretStorage(testData, friendlyName, username, newPassword, "plaintext"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioSIPCredentialExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "secret_storage", "plaintext"),
+					resource.TestCheckResourceAttr(stateResourceName, "password", newPassword),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTwilioSIPCredential_invalidPasswordWith11Characters(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(1)
 	password := "A1" + acctest.RandString(9)
 
@@ -212,7 +253,7 @@ func TestAccTwilioSIPCredential_invalidPasswordWith11Characters(t *testing.T) {
 
 func TestAccTwilioSIPCredential_invalidPasswordWithNoUppercaseCharacter(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(1)
 	password := strings.ToLower("1" + acctest.RandString(11))
 
@@ -230,7 +271,7 @@ func TestAccTwilioSIPCredential_invalidPasswordWithNoUppercaseCharacter(t *testi
 
 func TestAccTwilioSIPCredential_invalidPasswordWithNoLowercaseCharacter(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(1)
 	password := strings.ToUpper("1" + acctest.RandString(11))
 
@@ -248,7 +289,7 @@ func TestAccTwilioSIPCredential_invalidPasswordWithNoLowercaseCharacter(t *testi
 
 func TestAccTwilioSIPCredential_invalidPasswordWithNoNumber(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	username := acctest.RandString(1)
 	password := "A" + acctest.RandStringFromCharSet(11, acctest.CharSetAlpha)
 
@@ -354,6 +395,23 @@ resource "twilio_sip_credential" "credential" {
 `, testData.AccountSid, friendlyName, username, password)
 }
 
+func testAccTwilioSIPCredential_secretStorage(testData *acceptance.TestData, friendlyName string, username string, password string, secretStorage string) string {
+	return fmt.Sprintf(`
+resource "twilio_sip_credential_list" "credential_list" {
+  account_sid   = "%s"
+  friendly_name = "%s"
+}
+
+resource "twilio_sip_credential" "credential" {
+  account_sid         = twilio_sip_credential_list.credential_list.account_sid
+  credential_list_sid = twilio_sip_credential_list.credential_list.sid
+  username            = "%s"
+  password            = "%s"
+  secret_storage      = "%s"
+}
+`, testData.AccountSid, friendlyName, username, password, secretStorage)
+}
+
 func testAccTwilioSIPCredential_invalidAccountSid() string {
 	password := "A1" + acctest.RandString(12)
 
@@ -379,3 +437,12 @@ resource "twilio_sip_credential" "credential" {
 }
 `, password)
 }
+
+func testAccCheckSecretHash(secret string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		if !utils.VerifySecretHash(value, secret) {
+			return fmt.Errorf("Expected %s to be a hash of the secret", value)
+		}
+		return nil
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioSIPDomain_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -67,8 +66,8 @@ func TestAccTwilioSIPDomain_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
-	newDomainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
+	newDomainName := acceptance.RandomName() + ".sip.twilio.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -137,7 +136,7 @@ func TestAccTwilioSIPDomain_voiceURLAndMethod(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "https://demo.twilio.com/welcome/voice/"
 	method := "GET"
 
@@ -179,7 +178,7 @@ func TestAccTwilioSIPDomain_voiceURLAndMethod(t *testing.T) {
 
 func TestAccTwilioSIPDomain_invalidVoiceURL(t *testing.T) {
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "test"
 	method := "POST"
 
@@ -198,7 +197,7 @@ func TestAccTwilioSIPDomain_invalidVoiceURL(t *testing.T) {
 
 func TestAccTwilioSIPDomain_invalidVoiceMethod(t *testing.T) {
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "https://demo.twilio.com/welcome/voice/"
 	method := "test"
 
@@ -219,7 +218,7 @@ func TestAccTwilioSIPDomain_voiceFallbackURLAndMethod(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "https://demo.twilio.com/welcome/voice/"
 	method := "GET"
 
@@ -261,7 +260,7 @@ func TestAccTwilioSIPDomain_voiceFallbackURLAndMethod(t *testing.T) {
 
 func TestAccTwilioSIPDomain_invalidVoiceFallbackURL(t *testing.T) {
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "test"
 	method := "POST"
 
@@ -280,7 +279,7 @@ func TestAccTwilioSIPDomain_invalidVoiceFallbackURL(t *testing.T) {
 
 func TestAccTwilioSIPDomain_invalidVoiceFallbackMethod(t *testing.T) {
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "https://demo.twilio.com/welcome/voice/"
 	method := "test"
 
@@ -301,7 +300,7 @@ func TestAccTwilioSIPDomain_voiceStatusCallbackURLAndMethod(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "https://demo.twilio.com/welcome/voice/"
 	method := "GET"
 
@@ -343,7 +342,7 @@ func TestAccTwilioSIPDomain_voiceStatusCallbackURLAndMethod(t *testing.T) {
 
 func TestAccTwilioSIPDomain_invalidVoiceStatusCallbackURL(t *testing.T) {
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "test"
 	method := "POST"
 
@@ -362,7 +361,7 @@ func TestAccTwilioSIPDomain_invalidVoiceStatusCallbackURL(t *testing.T) {
 
 func TestAccTwilioSIPDomain_invalidVoiceStatusCallbackMethod(t *testing.T) {
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	url := "https://demo.twilio.com/welcome/voice/"
 	method := "test"
 
@@ -383,7 +382,7 @@ func TestAccTwilioSIPDomain_emergency(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 	emergencyCallingEnabled := false
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -434,7 +433,7 @@ func TestAccTwilioSIPDomain_secure(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -470,7 +469,7 @@ func TestAccTwilioSIPDomain_sipRegistration(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.domain", domainResourceName)
 
 	testData := acceptance.TestAccData
-	domainName := acctest.RandString(10) + ".sip.twilio.com"
+	domainName := acceptance.RandomName() + ".sip.twilio.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
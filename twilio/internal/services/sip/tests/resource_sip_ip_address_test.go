@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioSIPIPAddress_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.ip_address", ipAddressResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	ipAddress := "127.0.0.1"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccTwilioSIPIPAddress_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.ip_address", ipAddressResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	ipAddress := "127.0.0.1"
 	newIPAddress := "0.0.0.0"
 
@@ -103,7 +102,7 @@ func TestAccTwilioSIPIPAddress_cidrLengthPrefix(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.ip_address", ipAddressResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	ipAddress := "127.0.0.1"
 	newCidrLengthPrefix := 8
 
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_sip_domain", &resource.Sweeper{
+		Name: "twilio_sip_domain",
+		F:    sweepSIPDomains,
+	})
+	resource.AddTestSweepers("twilio_sip_credential_list", &resource.Sweeper{
+		Name:         "twilio_sip_credential_list",
+		F:            sweepSIPCredentialLists,
+		Dependencies: []string{"twilio_sip_domain"},
+	})
+	resource.AddTestSweepers("twilio_sip_ip_access_control_list", &resource.Sweeper{
+		Name:         "twilio_sip_ip_access_control_list",
+		F:            sweepSIPIpAccessControlLists,
+		Dependencies: []string{"twilio_sip_domain"},
+	})
+}
+
+func sweepSIPDomains(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).Sip.Domains.NewDomainsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP domains: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Domains {
+		if !acceptance.HasResourcePrefix(item.DomainName) {
+			continue
+		}
+
+		acceptance.LogSweep("SIP domain", item.Sid, item.DomainName)
+		if err := client.Account(accountSid).Sip.Domain(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("SIP domain", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func sweepSIPCredentialLists(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).Sip.CredentialLists.NewCredentialListsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP credential lists: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.CredentialLists {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("SIP credential list", item.Sid, item.FriendlyName)
+		if err := client.Account(accountSid).Sip.CredentialList(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("SIP credential list", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func sweepSIPIpAccessControlLists(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).Sip.IpAccessControlLists.NewIpAccessControlListsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP IP access control lists: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.IpAccessControlLists {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("SIP IP access control list", item.Sid, item.FriendlyName)
+		if err := client.Account(accountSid).Sip.IpAccessControlList(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("SIP IP access control list", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
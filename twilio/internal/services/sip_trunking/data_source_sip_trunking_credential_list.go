@@ -63,7 +63,7 @@ func dataSourceSIPTrunkingCredentialListRead(ctx context.Context, d *schema.Reso
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP trunk credential list with sid (%s) was not found for SIP trunk with sid (%s)", sid, trunkSid)
 		}
-		return diag.Errorf("Failed to read SIP trunk credential list: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk credential list", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceSIPTrunkingCredentialLists() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("credential_lists", map[string]*schema.Schema{
 			"trunk_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -56,7 +56,7 @@ func dataSourceSIPTrunkingCredentialLists() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -73,7 +73,7 @@ func dataSourceSIPTrunkingCredentialListsRead(ctx context.Context, d *schema.Res
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No credential lists were found for SIP trunk with sid (%s)", trunkSid)
 		}
-		return diag.Errorf("Failed to list SIP trunk credential lists: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP trunk credential lists", err)
 	}
 
 	d.SetId(trunkSid)
@@ -99,7 +99,11 @@ func dataSourceSIPTrunkingCredentialListsRead(ctx context.Context, d *schema.Res
 		credentialLists = append(credentialLists, credentialListMap)
 	}
 
-	d.Set("credential_lists", &credentialLists)
+	filteredCredentialLists, err := utils.FilterListItems(d, credentialLists)
+	if err != nil {
+		return diag.Errorf("Failed to filter credential lists: %s", err.Error())
+	}
+	d.Set("credential_lists", &filteredCredentialLists)
 
 	return nil
 }
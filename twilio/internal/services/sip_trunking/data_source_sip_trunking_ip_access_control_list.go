@@ -63,7 +63,7 @@ func dataSourceSIPTrunkingIPAccessControlListRead(ctx context.Context, d *schema
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP trunk IP access control list with sid (%s) was not found for SIP trunk with sid (%s)", sid, trunkSid)
 		}
-		return diag.Errorf("Failed to read SIP trunk IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk IP access control list", err)
 	}
 
 	d.SetId(getResponse.Sid)
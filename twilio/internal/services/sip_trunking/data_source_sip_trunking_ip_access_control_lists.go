@@ -18,7 +18,7 @@ func dataSourceSIPTrunkingIPAccessControlLists() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("ip_access_control_lists", map[string]*schema.Schema{
 			"trunk_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -56,7 +56,7 @@ func dataSourceSIPTrunkingIPAccessControlLists() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -73,7 +73,7 @@ func dataSourceSIPTrunkingIPAccessControlListsRead(ctx context.Context, d *schem
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No IP access control lists were found for SIP trunk with sid (%s)", trunkSid)
 		}
-		return diag.Errorf("Failed to list SIP trunk IP access control lists: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP trunk IP access control lists", err)
 	}
 
 	d.SetId(trunkSid)
@@ -99,7 +99,11 @@ func dataSourceSIPTrunkingIPAccessControlListsRead(ctx context.Context, d *schem
 		IpAccessControlLists = append(IpAccessControlLists, IpAccessControlListMap)
 	}
 
-	d.Set("ip_access_control_lists", &IpAccessControlLists)
+	filteredIpAccessControlLists, err := utils.FilterListItems(d, IpAccessControlLists)
+	if err != nil {
+		return diag.Errorf("Failed to filter ip access control lists: %s", err.Error())
+	}
+	d.Set("ip_access_control_lists", &filteredIpAccessControlLists)
 
 	return nil
 }
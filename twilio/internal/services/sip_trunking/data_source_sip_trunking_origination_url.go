@@ -79,7 +79,7 @@ func dataSourceSIPTrunkingOriginationURLRead(ctx context.Context, d *schema.Reso
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP trunk origination url with sid (%s) was not found for SIP trunk with sid (%s)", sid, trunkSid)
 		}
-		return diag.Errorf("Failed to read SIP trunk origination url: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk origination url", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceSIPTrunkingOriginationURLs() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("origination_urls", map[string]*schema.Schema{
 			"trunk_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -72,7 +72,7 @@ func dataSourceSIPTrunkingOriginationURLs() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -89,7 +89,7 @@ func dataSourceSIPTrunkingOriginationURLsRead(ctx context.Context, d *schema.Res
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No origination urls were found for SIP trunk with sid (%s)", trunkSid)
 		}
-		return diag.Errorf("Failed to list SIP trunk origination urls: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP trunk origination urls", err)
 	}
 
 	d.SetId(trunkSid)
@@ -119,7 +119,11 @@ func dataSourceSIPTrunkingOriginationURLsRead(ctx context.Context, d *schema.Res
 		originationURLs = append(originationURLs, originationURLMap)
 	}
 
-	d.Set("origination_urls", &originationURLs)
+	filteredOriginationURLs, err := utils.FilterListItems(d, originationURLs)
+	if err != nil {
+		return diag.Errorf("Failed to filter origination urls: %s", err.Error())
+	}
+	d.Set("origination_urls", &filteredOriginationURLs)
 
 	return nil
 }
@@ -196,7 +196,7 @@ func dataSourceSIPTrunkingPhoneNumberRead(ctx context.Context, d *schema.Resourc
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP trunk phone number with sid (%s) was not found for SIP trunk with sid (%s)", sid, trunkSid)
 		}
-		return diag.Errorf("Failed to read SIP trunk phone number: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk phone number", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -19,7 +19,7 @@ func dataSourceSIPTrunkingPhoneNumbers() *schema.Resource {
 			Read: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("phone_numbers", map[string]*schema.Schema{
 			"trunk_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -189,7 +189,7 @@ func dataSourceSIPTrunkingPhoneNumbers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -206,7 +206,7 @@ func dataSourceSIPTrunkingPhoneNumbersRead(ctx context.Context, d *schema.Resour
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No phone numbers were found for SIP trunk with sid (%s)", trunkSid)
 		}
-		return diag.Errorf("Failed to list SIP trunk phone numbers: %s", err.Error())
+		return utils.TranslateError("Failed to list SIP trunk phone numbers", err)
 	}
 
 	d.SetId(trunkSid)
@@ -279,7 +279,11 @@ func dataSourceSIPTrunkingPhoneNumbersRead(ctx context.Context, d *schema.Resour
 		phoneNumbers = append(phoneNumbers, phoneNumbersMap)
 	}
 
-	d.Set("phone_numbers", &phoneNumbers)
+	filteredPhoneNumbers, err := utils.FilterListItems(d, phoneNumbers)
+	if err != nil {
+		return diag.Errorf("Failed to filter phone numbers: %s", err.Error())
+	}
+	d.Set("phone_numbers", &filteredPhoneNumbers)
 
 	return nil
 }
@@ -109,7 +109,7 @@ func dataSourceSIPTrunkingTrunkRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("SIP trunk with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read SIP trunk: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -92,7 +92,7 @@ func resourceSIPTrunkingCredentialListCreate(ctx context.Context, d *schema.Reso
 
 	createResult, err := client.Trunk(d.Get("trunk_sid").(string)).CredentialLists.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP trunk credential list: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP trunk credential list", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -108,7 +108,7 @@ func resourceSIPTrunkingCredentialListRead(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP trunk credential list: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk credential list", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -130,7 +130,7 @@ func resourceSIPTrunkingCredentialListDelete(ctx context.Context, d *schema.Reso
 	client := meta.(*common.TwilioClient).SIPTrunking
 
 	if err := client.Trunk(d.Get("trunk_sid").(string)).CredentialList(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP trunk credential list: %s", err.Error())
+		return utils.TranslateError("Failed to delete SIP trunk credential list", err)
 	}
 	d.SetId("")
 	return nil
@@ -92,7 +92,7 @@ func resourceSIPTrunkingIPAccessControlListCreate(ctx context.Context, d *schema
 
 	createResult, err := client.Trunk(d.Get("trunk_sid").(string)).IpAccessControlLists.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to create IP access control list", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -108,7 +108,7 @@ func resourceSIPTrunkingIPAccessControlListRead(ctx context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to read IP access control list", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -130,7 +130,7 @@ func resourceSIPTrunkingIPAccessControlListDelete(ctx context.Context, d *schema
 	client := meta.(*common.TwilioClient).SIPTrunking
 
 	if err := client.Trunk(d.Get("trunk_sid").(string)).IpAccessControlList(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete IP access control list: %s", err.Error())
+		return utils.TranslateError("Failed to delete IP access control list", err)
 	}
 	d.SetId("")
 	return nil
@@ -114,7 +114,7 @@ func resourceSIPTrunkingOriginationURLCreate(ctx context.Context, d *schema.Reso
 
 	createResult, err := client.Trunk(d.Get("trunk_sid").(string)).OriginationURLs.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP trunk origination url: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP trunk origination url", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -130,7 +130,7 @@ func resourceSIPTrunkingOriginationURLRead(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP trunk origination url: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk origination url", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -164,7 +164,7 @@ func resourceSIPTrunkingOriginationURLUpdate(ctx context.Context, d *schema.Reso
 
 	updateResult, err := client.Trunk(d.Get("trunk_sid").(string)).OriginationURL(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP trunk origination url: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP trunk origination url", err)
 	}
 
 	d.SetId(updateResult.Sid)
@@ -175,7 +175,7 @@ func resourceSIPTrunkingOriginationURLDelete(ctx context.Context, d *schema.Reso
 	client := meta.(*common.TwilioClient).SIPTrunking
 
 	if err := client.Trunk(d.Get("trunk_sid").(string)).OriginationURL(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP trunk origination url: %s", err.Error())
+		return utils.TranslateError("Failed to delete SIP trunk origination url", err)
 	}
 	d.SetId("")
 	return nil
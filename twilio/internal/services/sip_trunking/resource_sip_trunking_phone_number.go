@@ -225,7 +225,7 @@ func resourceSIPTrunkingPhoneNumberCreate(ctx context.Context, d *schema.Resourc
 
 	createResult, err := client.Trunk(d.Get("trunk_sid").(string)).PhoneNumbers.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP trunk phone number: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP trunk phone number", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -241,7 +241,7 @@ func resourceSIPTrunkingPhoneNumberRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP trunk phone number: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk phone number", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -279,7 +279,7 @@ func resourceSIPTrunkingPhoneNumberDelete(ctx context.Context, d *schema.Resourc
 	client := meta.(*common.TwilioClient).SIPTrunking
 
 	if err := client.Trunk(d.Get("trunk_sid").(string)).PhoneNumber(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP trunk phone number: %s", err.Error())
+		return utils.TranslateError("Failed to delete SIP trunk phone number", err)
 	}
 	d.SetId("")
 	return nil
@@ -171,7 +171,7 @@ func resourceSIPTrunkingTrunkCreate(ctx context.Context, d *schema.ResourceData,
 
 	createResult, err := client.Trunks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create SIP trunk: %s", err.Error())
+		return utils.TranslateError("Failed to create SIP trunk", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -192,7 +192,7 @@ func resourceSIPTrunkingTrunkRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read SIP trunk: %s", err.Error())
+		return utils.TranslateError("Failed to read SIP trunk", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -233,7 +233,7 @@ func resourceSIPTrunkingTrunkUpdate(ctx context.Context, d *schema.ResourceData,
 
 	updateResp, err := client.Trunk(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP trunk: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP trunk", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -248,7 +248,7 @@ func resourceSIPTrunkingTrunkDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).SIPTrunking
 
 	if err := client.Trunk(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete SIP trunk: %s", err.Error())
+		return utils.TranslateError("Failed to delete SIP trunk", err)
 	}
 	d.SetId("")
 	return nil
@@ -268,7 +268,7 @@ func updateRecording(ctx context.Context, d *schema.ResourceData, meta interface
 
 	_, err := client.Trunk(d.Id()).Recording().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update SIP trunk recording: %s", err.Error())
+		return utils.TranslateError("Failed to update SIP trunk recording", err)
 	}
 
 	return nil
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -16,7 +15,7 @@ const originationURLDataSourceName = "twilio_sip_trunking_origination_url"
 func TestAccDataSourceTwilioSIPTrunkingOriginationURL_complete(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.origination_url", originationURLDataSourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	weight := 0
 	priority := 0
 	enabled := false
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ var originationURLResourceName = "twilio_sip_trunking_origination_url"
 func TestAccTwilioSIPTrunkingOriginationURL_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.origination_url", originationURLResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	weight := 0
 	priority := 0
 	enabled := false
@@ -61,8 +60,8 @@ func TestAccTwilioSIPTrunkingOriginationURL_basic(t *testing.T) {
 func TestAccTwilioSIPTrunkingOriginationURL_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.origination_url", originationURLResourceName)
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 	weight := 0
 	priority := 0
 	enabled := false
@@ -129,7 +128,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_blankFriendlyName(t *testing.T) {
 func TestAccTwilioSIPTrunkingOriginationURL_priority(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.origination_url", originationURLResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	weight := 0
 	priority := 65535
 	newPriority := 0
@@ -160,7 +159,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_priority(t *testing.T) {
 }
 
 func TestAccTwilioSIPTrunkingOriginationURL_invalidPriorityOfNegative1(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	weight := 0
 	priority := -1
 	enabled := false
@@ -179,7 +178,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_invalidPriorityOfNegative1(t *testin
 }
 
 func TestAccTwilioSIPTrunkingOriginationURL_invalidPriorityOf65536(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	weight := 0
 	priority := 65536
 	enabled := false
@@ -200,7 +199,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_invalidPriorityOf65536(t *testing.T)
 func TestAccTwilioSIPTrunkingOriginationURL_weight(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.origination_url", originationURLResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	weight := 65535
 	priority := 10
 	newWeight := 0
@@ -231,7 +230,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_weight(t *testing.T) {
 }
 
 func TestAccTwilioSIPTrunkingOriginationURL_invalidWeightOfNegative1(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	priority := 0
 	weight := -1
 	enabled := false
@@ -250,7 +249,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_invalidWeightOfNegative1(t *testing.
 }
 
 func TestAccTwilioSIPTrunkingOriginationURL_invalidWeightOf65536(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	priority := 0
 	weight := 65536
 	enabled := false
@@ -269,7 +268,7 @@ func TestAccTwilioSIPTrunkingOriginationURL_invalidWeightOf65536(t *testing.T) {
 }
 
 func TestAccTwilioSIPTrunkingOriginationURL_invalidSipURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	priority := 0
 	weight := 0
 	enabled := false
@@ -60,7 +60,7 @@ func TestAccTwilioSIPTrunkingTrunk_basic(t *testing.T) {
 func TestAccTwilioSIPTrunkingTrunk_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.trunk", trunkResourceName)
 
-	newFriendlyName := acctest.RandString(10)
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
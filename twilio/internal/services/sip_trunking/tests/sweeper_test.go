@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_sip_trunking_trunk", &resource.Sweeper{
+		Name: "twilio_sip_trunking_trunk",
+		F:    sweepSIPTrunkingTrunks,
+	})
+}
+
+func sweepSIPTrunkingTrunks(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.SIPTrunking
+
+	ctx := context.Background()
+	paginator := client.Trunks.NewTrunksPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list SIP trunks: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Trunks {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("SIP trunk", item.Sid, item.FriendlyName)
+		if err := client.Trunk(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("SIP trunk", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -82,7 +82,7 @@ func dataSourceStudioFlowRead(ctx context.Context, d *schema.ResourceData, meta
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Studio flow with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read studio flow: %s", err.Error())
+		return utils.TranslateError("Failed to read studio flow", err)
 	}
 
 	d.SetId(getResponse.Sid)
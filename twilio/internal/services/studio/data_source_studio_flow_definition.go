@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	sdkStudio "github.com/timworks/twilio-sdk-go/studio"
 	"github.com/timworks/twilio-sdk-go/studio/flow"
 )
 
@@ -43,6 +42,11 @@ func dataSourceStudioFlowDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"auto_layout": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"states": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -63,13 +67,6 @@ func dataSourceStudioFlowDefinition() *schema.Resource {
 }
 
 func dataSourceStudioFlowDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var flags *sdkStudio.FlowFlags
-	if _, ok := d.GetOk("flags"); ok {
-		flags = &sdkStudio.FlowFlags{
-			AllowConcurrentCalls: d.Get("flags.0.allow_concurrent_calls").(bool),
-		}
-	}
-
 	states := []flow.State{}
 	for _, stateDefinition := range d.Get("states").([]interface{}) {
 		stateDefinitionMap := stateDefinition.(map[string]interface{})
@@ -82,24 +79,22 @@ func dataSourceStudioFlowDefinitionRead(ctx context.Context, d *schema.ResourceD
 		states = append(states, state)
 	}
 
-	flow := sdkStudio.Flow{
-		Description:  d.Get("description").(string),
-		Flags:        flags,
-		InitialState: d.Get("initial_state").(string),
-		States:       states,
+	if d.Get("auto_layout").(bool) {
+		layoutFlowDefinition(d.Get("initial_state").(string), states)
 	}
 
-	if err := flow.Validate(); err != nil {
-		return diag.Errorf("Flow defintion failed validation: %s", err.Error())
+	json, err := newFlowDefinitionJSON(d.Get("description").(string), d.Get("flags").([]interface{}), d.Get("initial_state").(string), states)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	json, jsonErr := flow.ToString()
-	if jsonErr != nil {
-		return diag.Errorf("Failed to marshal flow defintion to JSON: %s", jsonErr.Error())
+	diags := flowAnalysisDiagnostics(analyseFlowDefinition(d.Get("initial_state").(string), states))
+	if diags.HasError() {
+		return diags
 	}
 
 	d.SetId(resource.UniqueId())
 	d.Set("json", json)
 
-	return nil
+	return diags
 }
@@ -0,0 +1,311 @@
+package studio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	sdkStudio "github.com/timworks/twilio-sdk-go/studio"
+	"github.com/timworks/twilio-sdk-go/studio/flow"
+)
+
+func dataSourceStudioFlowDefinitionExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowDefinitionExportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"flow_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.StudioFlowSidValidation(),
+			},
+			"revision": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"initial_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_concurrent_calls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"states": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"widget": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transitions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"conditions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"arguments": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"friendly_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offset": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"y": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"hcl": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStudioFlowDefinitionExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Studio
+
+	flowSid := d.Get("flow_sid").(string)
+
+	var friendlyName, status string
+	var revision int
+	var definition map[string]interface{}
+
+	if v, ok := d.GetOk("revision"); ok {
+		getResponse, err := client.Flow(flowSid).Revision(v.(int)).FetchWithContext(ctx)
+		if err != nil {
+			if utils.IsNotFoundError(err) {
+				return diag.Errorf("Revision (%d) of studio flow with sid (%s) was not found", v.(int), flowSid)
+			}
+			return utils.TranslateError("Failed to read studio flow revision", err)
+		}
+		friendlyName = getResponse.FriendlyName
+		status = getResponse.Status
+		revision = getResponse.Revision
+		definition = getResponse.Definition
+	} else {
+		getResponse, err := client.Flow(flowSid).FetchWithContext(ctx)
+		if err != nil {
+			if utils.IsNotFoundError(err) {
+				return diag.Errorf("Studio flow with sid (%s) was not found", flowSid)
+			}
+			return utils.TranslateError("Failed to read studio flow", err)
+		}
+		friendlyName = getResponse.FriendlyName
+		status = getResponse.Status
+		revision = getResponse.Revision
+		definition = getResponse.Definition
+	}
+
+	json, err := structure.FlattenJsonToString(definition)
+	if err != nil {
+		return diag.Errorf("Unable to flattern definition json to string")
+	}
+
+	flowDefinition, err := parseFlowDefinition(json)
+	if err != nil {
+		return diag.Errorf("Failed to parse studio flow definition: %s", err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", flowSid, revision))
+	d.Set("flow_sid", flowSid)
+	d.Set("revision", revision)
+	d.Set("friendly_name", friendlyName)
+	d.Set("status", status)
+	d.Set("definition", json)
+	d.Set("description", flowDefinition.Description)
+	d.Set("initial_state", flowDefinition.InitialState)
+	d.Set("flags", flattenFlowDefinitionExportFlags(flowDefinition.Flags))
+
+	exportedStates := exportFlowDefinitionStates(ctx, flowDefinition.States)
+	states, err := flattenFlowDefinitionExportStates(exportedStates)
+	if err != nil {
+		return diag.Errorf("Failed to flatten studio flow states: %s", err.Error())
+	}
+	d.Set("states", states)
+	d.Set("hcl", flowDefinitionExportHCL(friendlyName, status, *flowDefinition, exportedStates))
+
+	return nil
+}
+
+func parseFlowDefinition(definition string) (*sdkStudio.Flow, error) {
+	flowDefinition := &sdkStudio.Flow{}
+	if err := json.Unmarshal([]byte(definition), flowDefinition); err != nil {
+		return nil, err
+	}
+	return flowDefinition, nil
+}
+
+func flattenFlowDefinitionExportFlags(flags *sdkStudio.FlowFlags) *[]interface{} {
+	if flags == nil {
+		return nil
+	}
+
+	results := make([]interface{}, 0)
+	results = append(results, map[string]interface{}{
+		"allow_concurrent_calls": flags.AllowConcurrentCalls,
+	})
+	return &results
+}
+
+func flattenFlowDefinitionExportStates(states []exportedFlowDefinitionState) (*[]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	for _, state := range states {
+		properties := map[string]interface{}{}
+		if stateProperties, ok := state.State.Properties.(map[string]interface{}); ok {
+			for key, value := range stateProperties {
+				properties[key] = value
+			}
+		}
+
+		var offset []interface{}
+		if value, ok := properties["offset"]; ok {
+			offset, _ = exportFlowDefinitionOffset(value)
+			delete(properties, "offset")
+		}
+
+		propertiesJSON, err := structure.FlattenJsonToString(properties)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":        state.State.Name,
+			"type":        state.State.Type,
+			"widget":      state.WidgetType,
+			"transitions": flattenFlowDefinitionExportTransitions(state.State.Transitions),
+			"properties":  propertiesJSON,
+			"offset":      offset,
+		})
+	}
+
+	return &results, nil
+}
+
+func flattenFlowDefinitionExportTransitions(transitions []flow.Transition) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, transition := range transitions {
+		result := map[string]interface{}{
+			"event": transition.Event,
+		}
+		if transition.Next != nil {
+			result["next"] = *transition.Next
+		}
+
+		if transition.Conditions != nil {
+			conditions := make([]interface{}, 0)
+			for _, condition := range *transition.Conditions {
+				conditions = append(conditions, map[string]interface{}{
+					"arguments":     condition.Arguments,
+					"friendly_name": condition.FriendlyName,
+					"type":          condition.Type,
+					"value":         condition.Value,
+				})
+			}
+			result["conditions"] = conditions
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
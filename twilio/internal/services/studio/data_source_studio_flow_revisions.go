@@ -0,0 +1,145 @@
+package studio
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+func dataSourceStudioFlowRevisions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStudioFlowRevisionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: utils.WithListFilters("revisions", map[string]*schema.Schema{
+			"flow_sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.StudioFlowSidValidation(),
+			},
+			"include_definitions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revisions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"definition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceStudioFlowRevisionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.TwilioClient).Studio
+
+	flowSid := d.Get("flow_sid").(string)
+	paginator := client.Flow(flowSid).Revisions.NewRevisionsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+
+	err := paginator.Error()
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return diag.Errorf("No revisions were found for studio flow with sid (%s)", flowSid)
+		}
+		return utils.TranslateError("Failed to read studio flow revisions", err)
+	}
+
+	d.SetId(flowSid)
+	d.Set("flow_sid", flowSid)
+
+	// The definition of every revision can be large, so the definitions are only stored in state when requested
+	includeDefinitions := d.Get("include_definitions").(bool)
+
+	revisions := make([]interface{}, 0)
+
+	for _, revision := range paginator.Revisions {
+		d.Set("account_sid", revision.AccountSid)
+
+		revisionMap := make(map[string]interface{})
+
+		revisionMap["revision"] = revision.Revision
+		revisionMap["friendly_name"] = revision.FriendlyName
+		revisionMap["status"] = revision.Status
+		revisionMap["commit_message"] = revision.CommitMessage
+
+		if includeDefinitions {
+			json, err := structure.FlattenJsonToString(revision.Definition)
+			if err != nil {
+				return diag.Errorf("Unable to flatten definition json to string")
+			}
+			revisionMap["definition"] = json
+		}
+		revisionMap["valid"] = revision.Valid
+		revisionMap["date_created"] = revision.DateCreated.Format(time.RFC3339)
+
+		if revision.DateUpdated != nil {
+			revisionMap["date_updated"] = revision.DateUpdated.Format(time.RFC3339)
+		}
+
+		revisionMap["url"] = revision.URL
+
+		revisions = append(revisions, revisionMap)
+	}
+
+	filteredRevisions, err := utils.FilterListItems(d, revisions)
+	if err != nil {
+		return diag.Errorf("Failed to filter revisions: %s", err.Error())
+	}
+	d.Set("revisions", &filteredRevisions)
+
+	return nil
+}
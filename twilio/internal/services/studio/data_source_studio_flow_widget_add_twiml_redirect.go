@@ -131,7 +131,7 @@ func dataSourceStudioFlowWidgetAddTwiMLRedirectRead(ctx context.Context, d *sche
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create add TwiML redirect widget: %s", err.Error())
+		return utils.TranslateError("Failed to create add TwiML redirect widget", err)
 	}
 
 	json, jsonErr := state.ToString()
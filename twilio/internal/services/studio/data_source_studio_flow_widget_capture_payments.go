@@ -246,7 +246,7 @@ func dataSourceStudioFlowWidgetCapturePaymentsRead(ctx context.Context, d *schem
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create capture payments widget: %s", err.Error())
+		return utils.TranslateError("Failed to create capture payments widget", err)
 	}
 
 	json, jsonErr := state.ToString()
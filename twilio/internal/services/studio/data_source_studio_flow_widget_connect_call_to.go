@@ -146,7 +146,7 @@ func dataSourceStudioFlowWidgetConnectCallToRead(ctx context.Context, d *schema.
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create connect call to widget: %s", err.Error())
+		return utils.TranslateError("Failed to create connect call to widget", err)
 	}
 
 	json, jsonErr := state.ToString()
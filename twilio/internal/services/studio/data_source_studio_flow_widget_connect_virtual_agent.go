@@ -128,7 +128,7 @@ func dataSourceStudioFlowWidgetConnectVirtualAgentRead(ctx context.Context, d *s
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create connect virtual agent widget: %s", err.Error())
+		return utils.TranslateError("Failed to create connect virtual agent widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -161,7 +161,7 @@ func dataSourceStudioFlowWidgetEnqueueCallRead(ctx context.Context, d *schema.Re
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create enqueue call widget: %s", err.Error())
+		return utils.TranslateError("Failed to create enqueue call widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -171,7 +171,7 @@ func dataSourceStudioFlowWidgetForkStreamRead(ctx context.Context, d *schema.Res
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create fork stream widget: %s", err.Error())
+		return utils.TranslateError("Failed to create fork stream widget", err)
 	}
 
 	json, jsonErr := state.ToString()
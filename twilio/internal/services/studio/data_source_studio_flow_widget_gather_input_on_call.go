@@ -198,7 +198,7 @@ func dataSourceStudioFlowWidgetGatherInputOnCallRead(ctx context.Context, d *sch
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create gather input on call widget: %s", err.Error())
+		return utils.TranslateError("Failed to create gather input on call widget", err)
 	}
 
 	json, jsonErr := state.ToString()
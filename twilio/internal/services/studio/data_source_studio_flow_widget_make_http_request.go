@@ -166,7 +166,7 @@ func dataSourceStudioFlowWidgetMakeHttpRequestRead(ctx context.Context, d *schem
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create make HTTP request widget: %s", err.Error())
+		return utils.TranslateError("Failed to create make HTTP request widget", err)
 	}
 
 	json, jsonErr := state.ToString()
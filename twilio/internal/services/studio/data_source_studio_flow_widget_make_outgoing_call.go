@@ -224,7 +224,7 @@ func dataSourceStudioFlowWidgetMakeOutgoingCallRead(ctx context.Context, d *sche
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create make outgoing call widget: %s", err.Error())
+		return utils.TranslateError("Failed to create make outgoing call widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -160,7 +160,7 @@ func dataSourceStudioFlowWidgetRecordCallRead(ctx context.Context, d *schema.Res
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create record call widget: %s", err.Error())
+		return utils.TranslateError("Failed to create record call widget", err)
 	}
 
 	json, jsonErr := state.ToString()
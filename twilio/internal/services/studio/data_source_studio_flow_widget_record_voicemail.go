@@ -164,7 +164,7 @@ func dataSourceStudioFlowWidgetRecordVoicemailRead(ctx context.Context, d *schem
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create record voicemail widget: %s", err.Error())
+		return utils.TranslateError("Failed to create record voicemail widget", err)
 	}
 
 	json, jsonErr := state.ToString()
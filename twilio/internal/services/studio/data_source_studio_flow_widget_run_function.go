@@ -157,7 +157,7 @@ func dataSourceStudioFlowWidgetRunFunctionRead(ctx context.Context, d *schema.Re
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create run function widget: %s", err.Error())
+		return utils.TranslateError("Failed to create run function widget", err)
 	}
 
 	json, jsonErr := state.ToString()
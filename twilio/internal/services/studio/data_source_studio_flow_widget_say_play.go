@@ -130,7 +130,7 @@ func dataSourceStudioFlowWidgetSayPlayRead(ctx context.Context, d *schema.Resour
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create say play widget: %s", err.Error())
+		return utils.TranslateError("Failed to create say play widget", err)
 	}
 
 	json, jsonErr := state.ToString()
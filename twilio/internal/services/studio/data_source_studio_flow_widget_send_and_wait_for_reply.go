@@ -154,7 +154,7 @@ func dataSourceStudioFlowWidgetSendAndWaitForReplyRead(ctx context.Context, d *s
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create send and wait for reply widget: %s", err.Error())
+		return utils.TranslateError("Failed to create send and wait for reply widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -149,7 +149,7 @@ func dataSourceStudioFlowWidgetSendMessageRead(ctx context.Context, d *schema.Re
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create send message widget: %s", err.Error())
+		return utils.TranslateError("Failed to create send message widget", err)
 	}
 
 	json, jsonErr := state.ToString()
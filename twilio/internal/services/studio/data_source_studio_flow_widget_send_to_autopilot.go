@@ -188,7 +188,7 @@ func dataSourceStudioFlowWidgetSendToAutopilotRead(ctx context.Context, d *schem
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create send to autopilot widget: %s", err.Error())
+		return utils.TranslateError("Failed to create send to autopilot widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -146,7 +146,7 @@ func dataSourceStudioFlowWidgetSendToFlexRead(ctx context.Context, d *schema.Res
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create send to flex widget: %s", err.Error())
+		return utils.TranslateError("Failed to create send to flex widget", err)
 	}
 
 	json, jsonErr := state.ToString()
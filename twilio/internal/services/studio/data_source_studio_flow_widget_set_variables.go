@@ -123,7 +123,7 @@ func dataSourceStudioFlowWidgetSetVariablesRead(ctx context.Context, d *schema.R
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create set variables widget: %s", err.Error())
+		return utils.TranslateError("Failed to create set variables widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -161,7 +161,7 @@ func dataSourceStudioFlowWidgetSplitBasedOnRead(ctx context.Context, d *schema.R
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create split based on widget: %s", err.Error())
+		return utils.TranslateError("Failed to create split based on widget", err)
 	}
 
 	json, jsonErr := state.ToString()
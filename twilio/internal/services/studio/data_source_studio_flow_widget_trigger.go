@@ -101,7 +101,7 @@ func dataSourceStudioFlowWidgetTriggerRead(ctx context.Context, d *schema.Resour
 
 	state, err := widget.ToState()
 	if err != nil {
-		return diag.Errorf("Failed to create trigger widget: %s", err.Error())
+		return utils.TranslateError("Failed to create trigger widget", err)
 	}
 
 	json, jsonErr := state.ToString()
@@ -0,0 +1,222 @@
+package studio
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/timworks/twilio-sdk-go/studio/flow"
+)
+
+// flowAnalysisWaitingStateTypes are the types of states which wait for input from the caller/ contact or another system before transitioning, so a cycle which contains one of these states does not loop continuously
+var flowAnalysisWaitingStateTypes = map[string]bool{
+	"capture-payments":        true,
+	"connect-call-to":         true,
+	"connect-virtual-agent":   true,
+	"enqueue-call":            true,
+	"gather-input-on-call":    true,
+	"record-voicemail":        true,
+	"send-and-wait-for-reply": true,
+	"send-to-auto-pilot":      true,
+	"send-to-flex":            true,
+}
+
+// analyseFlowDefinition checks the graph of states locally, without calling the Twilio API. The errors and warnings use the same property path format as the messages returned by Twilio, so each message can be reported against the state which caused it.
+// Duplicate state names, transitions to states which do not exist and a missing trigger initial state are returned as errors. Unreachable states and cycles which do not contain a state that waits for input are returned as warnings
+func analyseFlowDefinition(initialState string, states []flow.State) ([]flowValidationMessage, []flowValidationMessage) {
+	errors := make([]flowValidationMessage, 0)
+	warnings := make([]flowValidationMessage, 0)
+
+	stateIndexes := map[string]int{}
+	for index, state := range states {
+		if firstIndex, ok := stateIndexes[state.Name]; ok {
+			errors = append(errors, flowValidationMessage{
+				Message:      fmt.Sprintf("the state name is already used by the state at index %d", firstIndex),
+				PropertyPath: fmt.Sprintf("#/states/%d", index),
+				StateName:    state.Name,
+			})
+			continue
+		}
+		stateIndexes[state.Name] = index
+	}
+
+	initialStateIndex, initialStateExists := stateIndexes[initialState]
+	if !initialStateExists {
+		errors = append(errors, flowValidationMessage{
+			Message:      fmt.Sprintf("the initial state (%s) does not exist, the initial state must be a trigger widget", initialState),
+			PropertyPath: "#/initial_state",
+		})
+	} else if states[initialStateIndex].Type != "trigger" {
+		errors = append(errors, flowValidationMessage{
+			Message:      fmt.Sprintf("the state is the initial state but is a %s widget, the initial state must be a trigger widget", states[initialStateIndex].Type),
+			PropertyPath: fmt.Sprintf("#/states/%d", initialStateIndex),
+			StateName:    initialState,
+		})
+	}
+
+	// The graph only contains the first state with each name, as the transitions cannot distinguish between duplicate states
+	graph := make([][]int, len(states))
+	for index, state := range states {
+		if stateIndexes[state.Name] != index {
+			continue
+		}
+
+		for transitionIndex, transition := range state.Transitions {
+			if transition.Next == nil || *transition.Next == "" {
+				continue
+			}
+
+			nextIndex, ok := stateIndexes[*transition.Next]
+			if !ok {
+				errors = append(errors, flowValidationMessage{
+					Message:      fmt.Sprintf("the %s transition moves to a state (%s) which does not exist", transition.Event, *transition.Next),
+					PropertyPath: fmt.Sprintf("#/states/%d/transitions/%d", index, transitionIndex),
+					StateName:    state.Name,
+				})
+				continue
+			}
+			graph[index] = append(graph[index], nextIndex)
+		}
+	}
+
+	if initialStateExists {
+		reachable := map[int]bool{initialStateIndex: true}
+		queue := []int{initialStateIndex}
+		for len(queue) > 0 {
+			index := queue[0]
+			queue = queue[1:]
+			for _, nextIndex := range graph[index] {
+				if !reachable[nextIndex] {
+					reachable[nextIndex] = true
+					queue = append(queue, nextIndex)
+				}
+			}
+		}
+
+		for index, state := range states {
+			if stateIndexes[state.Name] == index && !reachable[index] {
+				warnings = append(warnings, flowValidationMessage{
+					Message:      "the state cannot be reached from the initial state",
+					PropertyPath: fmt.Sprintf("#/states/%d", index),
+					StateName:    state.Name,
+				})
+			}
+		}
+	}
+
+	for _, cycle := range flowAnalysisCycles(graph) {
+		waits := false
+		names := make([]string, 0)
+		for _, index := range cycle {
+			waits = waits || flowAnalysisWaitingStateTypes[states[index].Type]
+			names = append(names, states[index].Name)
+		}
+
+		if !waits {
+			warnings = append(warnings, flowValidationMessage{
+				Message:      fmt.Sprintf("the state is part of a cycle (%s) which does not contain a widget that waits for input, so the flow may loop indefinitely", strings.Join(names, ", ")),
+				PropertyPath: fmt.Sprintf("#/states/%d", cycle[0]),
+				StateName:    states[cycle[0]].Name,
+			})
+		}
+	}
+
+	return errors, warnings
+}
+
+// flowAnalysisCycles returns the strongly connected components of the graph which contain a cycle, using Tarjan's algorithm. The states in each cycle are sorted by index
+func flowAnalysisCycles(graph [][]int) [][]int {
+	index := 0
+	indexes := make([]int, len(graph))
+	lowLinks := make([]int, len(graph))
+	visited := make([]bool, len(graph))
+	onStack := make([]bool, len(graph))
+	stack := make([]int, 0)
+	cycles := make([][]int, 0)
+
+	var connect func(node int)
+	connect = func(node int) {
+		indexes[node] = index
+		lowLinks[node] = index
+		index++
+		visited[node] = true
+		stack = append(stack, node)
+		onStack[node] = true
+
+		selfLoop := false
+		for _, next := range graph[node] {
+			if next == node {
+				selfLoop = true
+			}
+			if !visited[next] {
+				connect(next)
+				if lowLinks[next] < lowLinks[node] {
+					lowLinks[node] = lowLinks[next]
+				}
+			} else if onStack[next] && indexes[next] < lowLinks[node] {
+				lowLinks[node] = indexes[next]
+			}
+		}
+
+		if lowLinks[node] != indexes[node] {
+			return
+		}
+
+		component := make([]int, 0)
+		for {
+			member := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[member] = false
+			component = append(component, member)
+			if member == node {
+				break
+			}
+		}
+
+		if len(component) > 1 || selfLoop {
+			sort.Ints(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for node := range graph {
+		if !visited[node] {
+			connect(node)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
+}
+
+// flowAnalysisDiagnostics converts the errors and warnings into a diagnostic for each message, which is reported against the state or initial state which caused it
+func flowAnalysisDiagnostics(errors []flowValidationMessage, warnings []flowValidationMessage) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0)
+	for _, analysisError := range errors {
+		diags = append(diags, flowAnalysisDiagnostic(diag.Error, "Studio flow definition analysis error", analysisError))
+	}
+	for _, analysisWarning := range warnings {
+		diags = append(diags, flowAnalysisDiagnostic(diag.Warning, "Studio flow definition analysis warning", analysisWarning))
+	}
+	return diags
+}
+
+func flowAnalysisDiagnostic(severity diag.Severity, summaryPrefix string, message flowValidationMessage) diag.Diagnostic {
+	attributePath := cty.GetAttrPath("initial_state")
+	if match := statePropertyPathRegex.FindStringSubmatch(message.PropertyPath); len(match) == 2 {
+		index, _ := strconv.Atoi(match[1])
+		attributePath = cty.GetAttrPath("states").IndexInt(index)
+	}
+
+	return diag.Diagnostic{
+		Severity:      severity,
+		Summary:       fmt.Sprintf("%s: %s", summaryPrefix, message.String()),
+		Detail:        fmt.Sprintf("Property path: %s", message.PropertyPath),
+		AttributePath: attributePath,
+	}
+}
@@ -0,0 +1,291 @@
+package studio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sdkStudio "github.com/timworks/twilio-sdk-go/studio"
+	"github.com/timworks/twilio-sdk-go/studio/flow"
+)
+
+// flowDefinitionWidgets contains the widget blocks which can be used in a `state` block of the `flow_definition`.
+// The schema of each block and the conversion to a state (using the sdk widgets package) are shared with the `twilio_studio_flow_widget_*` data sources
+var flowDefinitionWidgets = map[string]func() *schema.Resource{
+	"add_twiml_redirect":      dataSourceStudioFlowWidgetAddTwiMLRedirect,
+	"capture_payments":        dataSourceStudioFlowWidgetCapturePayments,
+	"connect_call_to":         dataSourceStudioFlowWidgetConnectCallTo,
+	"connect_virtual_agent":   dataSourceStudioFlowWidgetConnectVirtualAgent,
+	"enqueue_call":            dataSourceStudioFlowWidgetEnqueueCall,
+	"fork_stream":             dataSourceStudioFlowWidgetForkStream,
+	"gather_input_on_call":    dataSourceStudioFlowWidgetGatherInputOnCall,
+	"make_http_request":       dataSourceStudioFlowWidgetMakeHttpRequest,
+	"make_outgoing_call":      dataSourceStudioFlowWidgetMakeOutgoingCall,
+	"record_call":             dataSourceStudioFlowWidgetRecordCall,
+	"record_voicemail":        dataSourceStudioFlowWidgetRecordVoicemail,
+	"run_function":            dataSourceStudioFlowWidgetRunFunction,
+	"say_play":                dataSourceStudioFlowWidgetSayPlay,
+	"send_and_wait_for_reply": dataSourceStudioFlowWidgetSendAndWaitForReply,
+	"send_message":            dataSourceStudioFlowWidgetSendMessage,
+	"send_to_autopilot":       dataSourceStudioFlowWidgetSendToAutopilot,
+	"send_to_flex":            dataSourceStudioFlowWidgetSendToFlex,
+	"set_variables":           dataSourceStudioFlowWidgetSetVariables,
+	"split_based_on":          dataSourceStudioFlowWidgetSplitBasedOn,
+	"trigger":                 dataSourceStudioFlowWidgetTrigger,
+}
+
+// flowDefinitionWidgetStateAttributes are defined on the `state` block instead of the widget block
+var flowDefinitionWidgetStateAttributes = []string{"json", "name", "offset"}
+
+// flowDefinitionGetter is implemented by schema.ResourceData and schema.ResourceDiff
+type flowDefinitionGetter interface {
+	Get(key string) interface{}
+}
+
+func flowDefinitionSchema() *schema.Schema {
+	stateSchema := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"offset": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"x": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  0,
+					},
+					"y": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  0,
+					},
+				},
+			},
+		},
+	}
+
+	for _, widgetType := range flowDefinitionWidgetTypes() {
+		widgetSchema := flowDefinitionWidgets[widgetType]().Schema
+		for _, attribute := range flowDefinitionWidgetStateAttributes {
+			delete(widgetSchema, attribute)
+		}
+		removeFlowDefinitionAttributeReferences(widgetSchema)
+
+		stateSchema[widgetType] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: widgetSchema,
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"initial_state": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"flags": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allow_concurrent_calls": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+						},
+					},
+				},
+				"state": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: stateSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+// removeFlowDefinitionAttributeReferences removes the references to other attributes from the widget schema, as the references are relative to the root of the data source and cannot refer to an attribute in a nested block.
+// The widget properties are still validated by the sdk widgets package when the state is converted into JSON
+func removeFlowDefinitionAttributeReferences(widgetSchema map[string]*schema.Schema) {
+	for _, attributeSchema := range widgetSchema {
+		attributeSchema.ConflictsWith = nil
+		attributeSchema.ExactlyOneOf = nil
+		attributeSchema.AtLeastOneOf = nil
+		attributeSchema.RequiredWith = nil
+
+		if elem, ok := attributeSchema.Elem.(*schema.Resource); ok {
+			removeFlowDefinitionAttributeReferences(elem.Schema)
+		}
+	}
+}
+
+func flowDefinitionWidgetTypes() []string {
+	widgetTypes := make([]string, 0)
+	for widgetType := range flowDefinitionWidgets {
+		widgetTypes = append(widgetTypes, widgetType)
+	}
+	sort.Strings(widgetTypes)
+	return widgetTypes
+}
+
+// resourceFlowDefinition returns the normalized JSON definition of the flow, which is built from the `flow_definition` block when it is configured, otherwise the `definition` argument is used
+func resourceFlowDefinition(ctx context.Context, d flowDefinitionGetter) (string, error) {
+	if flowDefinition, ok := configuredFlowDefinition(d); ok {
+		definition, err := expandFlowDefinition(ctx, flowDefinition)
+		if err != nil {
+			return "", err
+		}
+		return structure.NormalizeJsonString(definition)
+	}
+	return structure.NormalizeJsonString(d.Get("definition").(string))
+}
+
+func configuredFlowDefinition(d flowDefinitionGetter) (map[string]interface{}, bool) {
+	flowDefinitions, ok := d.Get("flow_definition").([]interface{})
+	if !ok || len(flowDefinitions) == 0 || flowDefinitions[0] == nil {
+		return nil, false
+	}
+	return flowDefinitions[0].(map[string]interface{}), true
+}
+
+// expandFlowDefinition converts the `flow_definition` block into the JSON definition of the flow
+func expandFlowDefinition(ctx context.Context, flowDefinition map[string]interface{}) (string, error) {
+	states := []flow.State{}
+	for _, rawState := range flowDefinition["state"].([]interface{}) {
+		if rawState == nil {
+			continue
+		}
+
+		state, err := expandFlowDefinitionState(ctx, rawState.(map[string]interface{}))
+		if err != nil {
+			return "", err
+		}
+		states = append(states, *state)
+	}
+
+	return newFlowDefinitionJSON(flowDefinition["description"].(string), flowDefinition["flags"].([]interface{}), flowDefinition["initial_state"].(string), states)
+}
+
+// expandFlowDefinitionState converts a `state` block into a state, using the data source of the configured widget
+func expandFlowDefinitionState(ctx context.Context, stateDefinition map[string]interface{}) (*flow.State, error) {
+	name := stateDefinition["name"].(string)
+
+	widgetTypes := make([]string, 0)
+	for _, widgetType := range flowDefinitionWidgetTypes() {
+		if widgets, ok := stateDefinition[widgetType].([]interface{}); ok && len(widgets) > 0 {
+			widgetTypes = append(widgetTypes, widgetType)
+		}
+	}
+	if len(widgetTypes) != 1 {
+		return nil, fmt.Errorf("The state (%s) must contain exactly one widget block, found %d. Supported widgets are %s", name, len(widgetTypes), strings.Join(flowDefinitionWidgetTypes(), ", "))
+	}
+
+	widgetType := widgetTypes[0]
+	values := map[string]interface{}{
+		"name":   name,
+		"offset": stateDefinition["offset"],
+	}
+	// An empty widget block (i.e. `trigger {}`) is stored as a nil item
+	if widgetDefinition, ok := stateDefinition[widgetType].([]interface{})[0].(map[string]interface{}); ok {
+		for key, value := range widgetDefinition {
+			values[key] = value
+		}
+	}
+
+	return flowDefinitionWidgetState(ctx, widgetType, values)
+}
+
+// flowDefinitionWidgetState converts the widget arguments into a state by reading the widget data source, so the conversion and validation are the same as the `twilio_studio_flow_widget_*` data sources
+func flowDefinitionWidgetState(ctx context.Context, widgetType string, values map[string]interface{}) (*flow.State, error) {
+	name, _ := values["name"].(string)
+	widgetResource := flowDefinitionWidgets[widgetType]()
+	widgetData := widgetResource.Data(nil)
+
+	for key, value := range values {
+		if err := widgetData.Set(key, value); err != nil {
+			return nil, fmt.Errorf("Failed to set %s on the state (%s): %s", key, name, err.Error())
+		}
+	}
+
+	if diags := widgetResource.ReadContext(ctx, widgetData, nil); diags.HasError() {
+		messages := make([]string, 0)
+		for _, diagnostic := range diags {
+			messages = append(messages, diagnostic.Summary)
+		}
+		return nil, fmt.Errorf("The state (%s) is invalid: %s", name, strings.Join(messages, ", "))
+	}
+
+	state := flow.State{}
+	if err := json.Unmarshal([]byte(widgetData.Get("json").(string)), &state); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal json to state struct %s", err.Error())
+	}
+	return &state, nil
+}
+
+// newFlowDefinitionJSON validates the flow definition and returns the JSON representation
+func newFlowDefinitionJSON(description string, rawFlags []interface{}, initialState string, states []flow.State) (string, error) {
+	var flags *sdkStudio.FlowFlags
+	if len(rawFlags) > 0 && rawFlags[0] != nil {
+		flags = &sdkStudio.FlowFlags{
+			AllowConcurrentCalls: rawFlags[0].(map[string]interface{})["allow_concurrent_calls"].(bool),
+		}
+	}
+
+	flowDefinition := sdkStudio.Flow{
+		Description:  description,
+		Flags:        flags,
+		InitialState: initialState,
+		States:       states,
+	}
+
+	if err := flowDefinition.Validate(); err != nil {
+		return "", fmt.Errorf("Flow defintion failed validation: %s", err.Error())
+	}
+
+	definition, err := flowDefinition.ToString()
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal flow defintion to JSON: %s", err.Error())
+	}
+	return *definition, nil
+}
+
+// flowDefinitionsEqual returns whether the JSON definitions are semantically equal
+func flowDefinitionsEqual(a string, b string) bool {
+	var aContent, bContent interface{}
+	if err := json.Unmarshal([]byte(a), &aContent); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bContent); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aContent, bContent)
+}
@@ -0,0 +1,438 @@
+package studio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	sdkStudio "github.com/timworks/twilio-sdk-go/studio"
+	"github.com/timworks/twilio-sdk-go/studio/flow"
+)
+
+// flowDefinitionExportStateTypes maps the type of a state onto the widget which creates the state
+var flowDefinitionExportStateTypes = map[string]string{
+	"add-twiml-redirect":      "add_twiml_redirect",
+	"capture-payments":        "capture_payments",
+	"connect-call-to":         "connect_call_to",
+	"connect-virtual-agent":   "connect_virtual_agent",
+	"enqueue-call":            "enqueue_call",
+	"fork-stream":             "fork_stream",
+	"gather-input-on-call":    "gather_input_on_call",
+	"make-http-request":       "make_http_request",
+	"make-outgoing-call-v2":   "make_outgoing_call",
+	"record-call":             "record_call",
+	"record-voicemail":        "record_voicemail",
+	"run-function":            "run_function",
+	"say-play":                "say_play",
+	"send-and-wait-for-reply": "send_and_wait_for_reply",
+	"send-message":            "send_message",
+	"send-to-auto-pilot":      "send_to_autopilot",
+	"send-to-flex":            "send_to_flex",
+	"set-variables":           "set_variables",
+	"split-based-on":          "split_based_on",
+	"trigger":                 "trigger",
+}
+
+// flowDefinitionExportPropertyNames maps the state properties onto the widget arguments, where the widget uses a different name to the property
+var flowDefinitionExportPropertyNames = map[string]string{
+	"channel":                   "channel_sid",
+	"chat_attributes":           "attributes",
+	"chat_channel":              "channel_sid",
+	"chat_service":              "service_sid",
+	"recording_status_callback": "recording_status_callback_url",
+	"service":                   "service_sid",
+	"status_callback":           "status_callback_url",
+	"waitUrl":                   "wait_url",
+	"waitUrlMethod":             "wait_url_method",
+	"workflow":                  "workflow_sid",
+}
+
+// exportedFlowDefinitionState is a state of an existing flow, along with the widget arguments which recreate the state.
+// The widget type is empty when the state cannot be recreated by any of the widgets
+type exportedFlowDefinitionState struct {
+	State      flow.State
+	WidgetType string
+	Values     map[string]interface{}
+}
+
+// exportFlowDefinitionStates converts each state into the arguments of the widget which creates the state
+func exportFlowDefinitionStates(ctx context.Context, states []flow.State) []exportedFlowDefinitionState {
+	exportedStates := make([]exportedFlowDefinitionState, 0)
+	for _, state := range states {
+		exportedState := exportedFlowDefinitionState{
+			State: state,
+		}
+		if widgetType, values, ok := exportFlowDefinitionState(ctx, state); ok {
+			exportedState.WidgetType = widgetType
+			exportedState.Values = values
+		}
+		exportedStates = append(exportedStates, exportedState)
+	}
+	return exportedStates
+}
+
+// exportFlowDefinitionState converts the state into the arguments of the widget which creates the state.
+// False is returned when the widget type is not supported, or the state contains properties or transitions which are not supported by the widget.
+// The arguments are only returned when the widget recreates the state exactly, so the exported configuration does not change the flow
+func exportFlowDefinitionState(ctx context.Context, state flow.State) (string, map[string]interface{}, bool) {
+	widgetType, ok := flowDefinitionExportStateTypes[state.Type]
+	if !ok {
+		return "", nil, false
+	}
+	widgetSchema := flowDefinitionWidgets[widgetType]().Schema
+
+	values := map[string]interface{}{
+		"name": state.Name,
+	}
+
+	properties, _ := state.Properties.(map[string]interface{})
+	for property, value := range properties {
+		if property == "offset" {
+			offset, ok := exportFlowDefinitionOffset(value)
+			if !ok {
+				return "", nil, false
+			}
+			values["offset"] = offset
+			continue
+		}
+
+		// The make http request widget sets the content type and charset as a single property
+		if _, ok := widgetSchema["charset"]; ok && property == "content_type" {
+			contentType, ok := value.(string)
+			if !ok {
+				return "", nil, false
+			}
+			parts := strings.SplitN(contentType, ";charset=", 2)
+			if len(parts) != 2 {
+				return "", nil, false
+			}
+			values["content_type"] = parts[0]
+			values["charset"] = parts[1]
+			continue
+		}
+
+		attribute := property
+		if name, ok := flowDefinitionExportPropertyNames[property]; ok {
+			if _, exists := widgetSchema[name]; exists {
+				attribute = name
+			}
+		}
+
+		attributeSchema, ok := widgetSchema[attribute]
+		if !ok || attributeSchema.Computed || attribute == "name" || attribute == "offset" || attribute == "transitions" {
+			return "", nil, false
+		}
+
+		exportedValue, ok := exportFlowDefinitionValue(attributeSchema, value)
+		if !ok {
+			return "", nil, false
+		}
+		values[attribute] = exportedValue
+	}
+
+	transitions, ok := exportFlowDefinitionTransitions(widgetSchema["transitions"], state.Transitions)
+	if !ok {
+		return "", nil, false
+	}
+	if transitions != nil {
+		values["transitions"] = []interface{}{transitions}
+	}
+
+	widgetState, err := flowDefinitionWidgetState(ctx, widgetType, values)
+	if err != nil {
+		return "", nil, false
+	}
+
+	expectedJSON, expectedErr := json.Marshal(sortFlowDefinitionTransitions(state))
+	actualJSON, actualErr := json.Marshal(sortFlowDefinitionTransitions(*widgetState))
+	if expectedErr != nil || actualErr != nil || !flowDefinitionsEqual(string(expectedJSON), string(actualJSON)) {
+		return "", nil, false
+	}
+	return widgetType, values, true
+}
+
+// sortFlowDefinitionTransitions sorts the transitions by event, as the widgets sort the transitions. The order of the conditional transitions is retained as the conditions are evaluated in order
+func sortFlowDefinitionTransitions(state flow.State) flow.State {
+	transitions := make([]flow.Transition, len(state.Transitions))
+	copy(transitions, state.Transitions)
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].Event < transitions[j].Event
+	})
+	state.Transitions = transitions
+	return state
+}
+
+func exportFlowDefinitionOffset(value interface{}) ([]interface{}, bool) {
+	offset, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	values := map[string]interface{}{}
+	for _, axis := range []string{"x", "y"} {
+		coordinate, ok := exportFlowDefinitionInt(offset[axis])
+		if !ok {
+			return nil, false
+		}
+		values[axis] = coordinate
+	}
+	return []interface{}{values}, true
+}
+
+// exportFlowDefinitionTransitions converts the transitions into the arguments of the `transitions` block of the widget. The event name is used as the argument name, except for the conditional transitions of the split based on widget
+func exportFlowDefinitionTransitions(transitionsSchema *schema.Schema, transitions []flow.Transition) (map[string]interface{}, bool) {
+	if transitionsSchema == nil {
+		return nil, len(transitions) == 0
+	}
+	transitionSchema := transitionsSchema.Elem.(*schema.Resource).Schema
+
+	values := map[string]interface{}{}
+	matches := make([]interface{}, 0)
+	for _, transition := range transitions {
+		next := ""
+		if transition.Next != nil {
+			next = *transition.Next
+		}
+
+		if transition.Conditions != nil {
+			if _, ok := transitionSchema["matches"]; !ok || transition.Event != "match" {
+				return nil, false
+			}
+
+			conditions := make([]interface{}, 0)
+			for _, condition := range *transition.Conditions {
+				arguments := make([]interface{}, 0)
+				for _, argument := range condition.Arguments {
+					arguments = append(arguments, argument)
+				}
+
+				conditions = append(conditions, map[string]interface{}{
+					"arguments":     arguments,
+					"friendly_name": condition.FriendlyName,
+					"type":          condition.Type,
+					"value":         condition.Value,
+				})
+			}
+			matches = append(matches, map[string]interface{}{
+				"next":       next,
+				"conditions": conditions,
+			})
+			continue
+		}
+
+		attribute := flowDefinitionExportSnakeCase(transition.Event)
+		if _, ok := transitionSchema[attribute]; !ok || attribute == "matches" {
+			return nil, false
+		}
+		if next != "" {
+			values[attribute] = next
+		}
+	}
+
+	if len(matches) > 0 {
+		values["matches"] = matches
+	}
+	if len(values) == 0 {
+		return nil, true
+	}
+	return values, true
+}
+
+// exportFlowDefinitionValue converts the property value into the type of the widget argument
+func exportFlowDefinitionValue(attributeSchema *schema.Schema, value interface{}) (interface{}, bool) {
+	switch attributeSchema.Type {
+	case schema.TypeString:
+		switch v := value.(type) {
+		case string:
+			return v, true
+		case bool:
+			return strconv.FormatBool(v), true
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		case map[string]interface{}, []interface{}:
+			// JSON properties (i.e. attributes) are set using a JSON string
+			content, err := json.Marshal(v)
+			if err != nil {
+				return nil, false
+			}
+			return string(content), true
+		}
+	case schema.TypeInt:
+		return exportFlowDefinitionInt(value)
+	case schema.TypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, true
+		case string:
+			if parsedValue, err := strconv.ParseBool(v); err == nil {
+				return parsedValue, true
+			}
+		}
+	case schema.TypeList:
+		switch elem := attributeSchema.Elem.(type) {
+		case *schema.Schema:
+			items, ok := value.([]interface{})
+			if !ok {
+				// Lists are set as a separated string on some widgets (i.e. hints)
+				stringValue, ok := value.(string)
+				if !ok {
+					return nil, false
+				}
+				items = make([]interface{}, 0)
+				for _, item := range strings.FieldsFunc(stringValue, func(character rune) bool { return character == ',' || unicode.IsSpace(character) }) {
+					items = append(items, item)
+				}
+			}
+
+			exportedItems := make([]interface{}, 0)
+			for _, item := range items {
+				exportedItem, ok := exportFlowDefinitionValue(elem, item)
+				if !ok {
+					return nil, false
+				}
+				exportedItems = append(exportedItems, exportedItem)
+			}
+			return exportedItems, true
+		case *schema.Resource:
+			items, ok := value.([]interface{})
+			if !ok {
+				return nil, false
+			}
+
+			exportedItems := make([]interface{}, 0)
+			for _, item := range items {
+				fields, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, false
+				}
+
+				exportedFields := map[string]interface{}{}
+				for field, fieldValue := range fields {
+					fieldSchema, ok := elem.Schema[field]
+					if !ok {
+						return nil, false
+					}
+					exportedFieldValue, ok := exportFlowDefinitionValue(fieldSchema, fieldValue)
+					if !ok {
+						return nil, false
+					}
+					exportedFields[field] = exportedFieldValue
+				}
+				exportedItems = append(exportedItems, exportedFields)
+			}
+			return exportedItems, true
+		}
+	}
+	return nil, false
+}
+
+func exportFlowDefinitionInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case float64:
+		if v == math.Trunc(v) {
+			return int(v), true
+		}
+	case string:
+		if parsedValue, err := strconv.Atoi(v); err == nil {
+			return parsedValue, true
+		}
+	}
+	return 0, false
+}
+
+// flowDefinitionExportSnakeCase converts the event name into the argument name i.e. incomingMessage is converted to incoming_message
+func flowDefinitionExportSnakeCase(value string) string {
+	var builder strings.Builder
+	for index, character := range value {
+		if unicode.IsUpper(character) {
+			if index > 0 {
+				builder.WriteRune('_')
+			}
+			character = unicode.ToLower(character)
+		}
+		builder.WriteRune(character)
+	}
+	return builder.String()
+}
+
+// flowDefinitionExportHCL returns the configuration of a `twilio_studio_flow` resource, where the definition is built using the `twilio_studio_flow_definition` and `twilio_studio_flow_widget_*` data sources.
+// States which cannot be recreated by a widget are added to the definition as JSON
+func flowDefinitionExportHCL(friendlyName string, status string, definition sdkStudio.Flow, states []exportedFlowDefinitionState) string {
+	names := utils.NewHCLNames()
+	flowName := names.Next("twilio_studio_flow", friendlyName, "flow")
+
+	definitionBlock := &utils.HCLBlock{Type: "data", Labels: []string{"twilio_studio_flow_definition", names.Next("twilio_studio_flow_definition", flowName)}}
+	definitionBlock.AddAttribute("description", utils.QuoteHCLString(definition.Description))
+	definitionBlock.AddAttribute("initial_state", utils.QuoteHCLString(definition.InitialState))
+	if definition.Flags != nil {
+		definitionBlock.AddBlock("flags").AddAttribute("allow_concurrent_calls", strconv.FormatBool(definition.Flags.AllowConcurrentCalls))
+	}
+
+	blocks := make([]*utils.HCLBlock, 0)
+	for _, state := range states {
+		statesBlock := definitionBlock.AddBlock("states")
+
+		if state.WidgetType == "" {
+			stateJSON, _ := json.MarshalIndent(state.State, "    ", "  ")
+			statesBlock.AddAttribute("json", "jsonencode("+utils.EscapeHCLTemplate(string(stateJSON))+")")
+			continue
+		}
+
+		dataSourceType := "twilio_studio_flow_widget_" + state.WidgetType
+		widgetBlock := &utils.HCLBlock{Type: "data", Labels: []string{dataSourceType, names.Next(dataSourceType, flowName+"_"+state.State.Name)}}
+		flowDefinitionExportHCLBody(widgetBlock, flowDefinitionWidgets[state.WidgetType]().Schema, state.Values)
+		blocks = append(blocks, widgetBlock)
+
+		statesBlock.AddAttribute("json", fmt.Sprintf("data.%s.%s.json", dataSourceType, widgetBlock.Labels[1]))
+	}
+	blocks = append(blocks, definitionBlock)
+
+	flowBlock := &utils.HCLBlock{Type: "resource", Labels: []string{"twilio_studio_flow", flowName}}
+	flowBlock.AddAttribute("friendly_name", utils.QuoteHCLString(friendlyName))
+	flowBlock.AddAttribute("status", utils.QuoteHCLString(status))
+	flowBlock.AddAttribute("definition", fmt.Sprintf("data.twilio_studio_flow_definition.%s.json", definitionBlock.Labels[1]))
+	blocks = append(blocks, flowBlock)
+
+	renderedBlocks := make([]string, 0)
+	for _, block := range blocks {
+		renderedBlocks = append(renderedBlocks, block.String())
+	}
+	return strings.Join(renderedBlocks, "\n")
+}
+
+// flowDefinitionExportHCLBody adds the widget arguments to the block. The name is added first, followed by the remaining arguments and nested blocks in alphabetical order
+func flowDefinitionExportHCLBody(block *utils.HCLBlock, blockSchema map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0)
+	for key := range values {
+		if key != "name" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if _, ok := values["name"]; ok {
+		keys = append([]string{"name"}, keys...)
+	}
+
+	for _, key := range keys {
+		if elem, ok := blockSchema[key].Elem.(*schema.Resource); ok {
+			for _, item := range values[key].([]interface{}) {
+				nestedBlock := block.AddBlock(key)
+				if fields, ok := item.(map[string]interface{}); ok {
+					flowDefinitionExportHCLBody(nestedBlock, elem.Schema, fields)
+				}
+			}
+			continue
+		}
+
+		if expression, ok := utils.HCLLiteral(values[key]); ok {
+			block.AddAttribute(key, expression)
+		}
+	}
+}
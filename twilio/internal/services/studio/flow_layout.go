@@ -0,0 +1,82 @@
+package studio
+
+import (
+	"github.com/timworks/twilio-sdk-go/studio/flow"
+)
+
+const (
+	flowLayoutHorizontalSpacing = 400
+	flowLayoutVerticalSpacing   = 250
+)
+
+// layoutFlowDefinition sets the offset of each state which does not have an offset, using a layered top-down layout of the transition graph.
+// The initial state is placed in the first layer and each state is placed in the layer below the first state which transitions to it. States which cannot be reached from the initial state are laid out in the same way, starting from the first unplaced state.
+// Each layer is centred horizontally, states with an explicit offset retain their offset but still occupy a position in the layer so they are not overlapped
+func layoutFlowDefinition(initialState string, states []flow.State) {
+	stateIndexes := map[string]int{}
+	for index, state := range states {
+		if _, ok := stateIndexes[state.Name]; !ok {
+			stateIndexes[state.Name] = index
+		}
+	}
+
+	layers := make([][]int, 0)
+	placed := make([]bool, len(states))
+	place := func(root int) {
+		placed[root] = true
+		queue := []int{root}
+		depths := map[int]int{root: 0}
+
+		for len(queue) > 0 {
+			index := queue[0]
+			queue = queue[1:]
+
+			depth := depths[index]
+			if depth == len(layers) {
+				layers = append(layers, make([]int, 0))
+			}
+			layers[depth] = append(layers[depth], index)
+
+			for _, transition := range states[index].Transitions {
+				if transition.Next == nil {
+					continue
+				}
+				if nextIndex, ok := stateIndexes[*transition.Next]; ok && !placed[nextIndex] {
+					placed[nextIndex] = true
+					depths[nextIndex] = depth + 1
+					queue = append(queue, nextIndex)
+				}
+			}
+		}
+	}
+
+	if initialStateIndex, ok := stateIndexes[initialState]; ok {
+		place(initialStateIndex)
+	}
+	for index := range states {
+		if !placed[index] {
+			place(index)
+		}
+	}
+
+	for depth, layer := range layers {
+		for position, index := range layer {
+			properties, ok := states[index].Properties.(map[string]interface{})
+			if !ok {
+				if states[index].Properties != nil {
+					continue
+				}
+				properties = map[string]interface{}{}
+			}
+			if _, ok := properties["offset"]; ok {
+				continue
+			}
+
+			properties["offset"] = map[string]interface{}{
+				"x": position*flowLayoutHorizontalSpacing - (len(layer)-1)*flowLayoutHorizontalSpacing/2,
+				"y": depth * flowLayoutVerticalSpacing,
+			}
+			states[index].Properties = properties
+		}
+	}
+}
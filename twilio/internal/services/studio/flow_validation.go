@@ -0,0 +1,211 @@
+package studio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	"github.com/timworks/twilio-sdk-go/service/studio/v2/flow_validation"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+var statePropertyPathRegex = regexp.MustCompile(`^#/states/(\d+)`)
+
+// flowValidationMessage is an error or warning returned by Twilio when a flow definition is invalid
+type flowValidationMessage struct {
+	Message      string
+	PropertyPath string
+	StateName    string
+}
+
+func (m flowValidationMessage) String() string {
+	location := m.PropertyPath
+	if m.StateName != "" {
+		location = fmt.Sprintf("state %q", m.StateName)
+	}
+
+	if location == "" {
+		return m.Message
+	}
+	return fmt.Sprintf("%s: %s", location, m.Message)
+}
+
+// validateFlowDiff validates the flow definition with Twilio when the plan is created, so invalid definitions are reported before any resources are changed.
+// Validation only occurs when `validate` is true and all of the arguments are known, definitions which contain values that are only known after apply are validated when the flow is created/updated
+func validateFlowDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The definition of the pinned revision is validated when the flow is updated, as the revision has already been published once
+	if meta == nil || !d.Get("validate").(bool) || d.Get("pinned_revision").(int) > 0 {
+		return nil
+	}
+
+	if d.Id() != "" && !flowDiffHasChanges(d, "friendly_name", "status", "definition", "flow_definition", "commit_message", "validate", "pinned_revision") {
+		return nil
+	}
+
+	definitionKey := "definition"
+	if _, ok := configuredFlowDefinition(d); ok {
+		definitionKey = "flow_definition"
+	}
+
+	for _, key := range []string{"friendly_name", "status", definitionKey, "commit_message"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	var commitMessage *string
+	if value, ok := d.GetOk("commit_message"); ok {
+		commitMessage = sdkUtils.String(value.(string))
+	}
+
+	definitionJSONString, err := resourceFlowDefinition(ctx, d)
+	if err != nil {
+		return fmt.Errorf("Failed to build studio flow definition: %s", err.Error())
+	}
+
+	validateInput := &flow_validation.ValidateFlowInput{
+		FriendlyName:  d.Get("friendly_name").(string),
+		Status:        d.Get("status").(string),
+		Definition:    definitionJSONString,
+		CommitMessage: commitMessage,
+	}
+
+	resp, err := meta.(*common.TwilioClient).Studio.FlowValidation.ValidateWithContext(ctx, validateInput)
+	if err != nil {
+		errors, _ := flowValidationMessages(err, definitionJSONString)
+		if len(errors) == 0 {
+			return fmt.Errorf("Failed to validate studio flow: %s", err.Error())
+		}
+
+		lines := make([]string, 0)
+		for _, validationError := range errors {
+			lines = append(lines, "  - "+validationError.String())
+		}
+		return fmt.Errorf("Failed to validate studio flow: %s\n%s", err.Error(), strings.Join(lines, "\n"))
+	}
+	if !resp.Valid {
+		return fmt.Errorf("Failed to validate studio flow: The template is invalid")
+	}
+	return nil
+}
+
+// flowValidationMessages extracts the errors and warnings from the details of a Twilio error. The property path of each message is resolved to the name of the state which it relates to
+func flowValidationMessages(err error, definition string) ([]flowValidationMessage, []flowValidationMessage) {
+	var twilioErr *sdkUtils.TwilioError
+	if !errors.As(err, &twilioErr) || twilioErr.Details == nil {
+		return nil, nil
+	}
+
+	states := make([]interface{}, 0)
+	var definitionContent map[string]interface{}
+	if json.Unmarshal([]byte(definition), &definitionContent) == nil {
+		if value, ok := definitionContent["states"].([]interface{}); ok {
+			states = value
+		}
+	}
+
+	details := *twilioErr.Details
+	return parseFlowValidationMessages(details["errors"], states), parseFlowValidationMessages(details["warnings"], states)
+}
+
+func parseFlowValidationMessages(value interface{}, states []interface{}) []flowValidationMessage {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	messages := make([]flowValidationMessage, 0)
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		message := flowValidationMessage{}
+		message.Message, _ = fields["message"].(string)
+		message.PropertyPath, _ = fields["property_path"].(string)
+
+		if match := statePropertyPathRegex.FindStringSubmatch(message.PropertyPath); len(match) == 2 {
+			if index, err := strconv.Atoi(match[1]); err == nil && index < len(states) {
+				if state, ok := states[index].(map[string]interface{}); ok {
+					message.StateName, _ = state["name"].(string)
+				}
+			}
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// handleError converts the flow validation errors and warnings returned by Twilio into a diagnostic for each message, so each problem is reported against the state which caused it.
+// All other errors are translated using utils.TranslateError
+func handleError(d flowDefinitionGetter, errorPrefix string, err error, definition string) diag.Diagnostics {
+	errors, warnings := flowValidationMessages(err, definition)
+	if len(errors) == 0 {
+		return utils.TranslateError(errorPrefix, err)
+	}
+
+	diags := make(diag.Diagnostics, 0)
+	for _, validationError := range errors {
+		diags = append(diags, flowValidationDiagnostic(d, diag.Error, errorPrefix, err, validationError))
+	}
+	for _, validationWarning := range warnings {
+		diags = append(diags, flowValidationDiagnostic(d, diag.Warning, "Studio flow validation warning", err, validationWarning))
+	}
+	return diags
+}
+
+func flowValidationDiagnostic(d flowDefinitionGetter, severity diag.Severity, summaryPrefix string, err error, message flowValidationMessage) diag.Diagnostic {
+	detail := err.Error()
+	if message.PropertyPath != "" {
+		detail = fmt.Sprintf("%s\n\nProperty path: %s", detail, message.PropertyPath)
+	}
+
+	return diag.Diagnostic{
+		Severity:      severity,
+		Summary:       fmt.Sprintf("%s: %s", summaryPrefix, message.String()),
+		Detail:        detail,
+		AttributePath: flowValidationAttributePath(d, message),
+	}
+}
+
+// flowValidationAttributePath returns the path of the argument which the definition was built from. When the `flow_definition` block is used, the path of the state block with the same name as the state the message relates to is returned
+func flowValidationAttributePath(d flowDefinitionGetter, message flowValidationMessage) cty.Path {
+	if revision, ok := d.Get("pinned_revision").(int); ok && revision > 0 {
+		return cty.GetAttrPath("pinned_revision")
+	}
+
+	flowDefinition, ok := configuredFlowDefinition(d)
+	if !ok {
+		return cty.GetAttrPath("definition")
+	}
+
+	path := cty.GetAttrPath("flow_definition").IndexInt(0)
+	if message.StateName != "" {
+		states, _ := flowDefinition["state"].([]interface{})
+		for index, rawState := range states {
+			if state, ok := rawState.(map[string]interface{}); ok && state["name"] == message.StateName {
+				return path.GetAttr("state").IndexInt(index)
+			}
+		}
+	}
+	return path
+}
+
+func flowDiffHasChanges(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
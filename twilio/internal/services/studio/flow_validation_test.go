@@ -0,0 +1,144 @@
+package studio
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+type testFlowDefinitionGetter map[string]interface{}
+
+func (g testFlowDefinitionGetter) Get(key string) interface{} {
+	return g[key]
+}
+
+const testFlowDefinition = `{"description":"A New Flow","initial_state":"Trigger","states":[{"name":"Trigger","type":"trigger"},{"name":"SendMessage","type":"send-message"}]}`
+
+func testFlowValidationError(errors []interface{}, warnings []interface{}) *sdkUtils.TwilioError {
+	code := 20001
+	return &sdkUtils.TwilioError{
+		Code:    &code,
+		Message: "Flow definition validation failed, check `details` for more information",
+		Status:  400,
+		Details: &map[string]interface{}{
+			"errors":   errors,
+			"warnings": warnings,
+		},
+	}
+}
+
+func testFlowValidationMessage(propertyPath string, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"message":       message,
+		"property_path": propertyPath,
+	}
+}
+
+func testFlowDefinitionBlock() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"description":   "A New Flow",
+			"initial_state": "Trigger",
+			"state": []interface{}{
+				map[string]interface{}{"name": "SendMessage"},
+				map[string]interface{}{"name": "Trigger"},
+			},
+		},
+	}
+}
+
+func TestHandleErrorAttributePaths(t *testing.T) {
+	testCases := map[string]struct {
+		d            testFlowDefinitionGetter
+		propertyPath string
+		expectedPath cty.Path
+	}{
+		"definition": {
+			d:            testFlowDefinitionGetter{"definition": testFlowDefinition},
+			propertyPath: "#/states/1",
+			expectedPath: cty.GetAttrPath("definition"),
+		},
+		"flow definition state": {
+			d:            testFlowDefinitionGetter{"flow_definition": testFlowDefinitionBlock()},
+			propertyPath: "#/states/1/properties",
+			expectedPath: cty.GetAttrPath("flow_definition").IndexInt(0).GetAttr("state").IndexInt(0),
+		},
+		"flow definition without a state": {
+			d:            testFlowDefinitionGetter{"flow_definition": testFlowDefinitionBlock()},
+			propertyPath: "#",
+			expectedPath: cty.GetAttrPath("flow_definition").IndexInt(0),
+		},
+		"flow definition with an unknown state": {
+			d:            testFlowDefinitionGetter{"flow_definition": testFlowDefinitionBlock()},
+			propertyPath: "#/states/5",
+			expectedPath: cty.GetAttrPath("flow_definition").IndexInt(0),
+		},
+		"pinned revision": {
+			d:            testFlowDefinitionGetter{"flow_definition": testFlowDefinitionBlock(), "pinned_revision": 2},
+			propertyPath: "#/states/1",
+			expectedPath: cty.GetAttrPath("pinned_revision"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := testFlowValidationError([]interface{}{testFlowValidationMessage(testCase.propertyPath, "must have required property 'transitions'")}, nil)
+
+			diags := handleError(testCase.d, "Failed to create studio flow", err, testFlowDefinition)
+			if len(diags) != 1 {
+				t.Fatalf("Expected 1 diagnostic, got %d", len(diags))
+			}
+			if !diags[0].AttributePath.Equals(testCase.expectedPath) {
+				t.Errorf("Expected attribute path %#v, got %#v", testCase.expectedPath, diags[0].AttributePath)
+			}
+		})
+	}
+}
+
+func TestHandleErrorValidationMessages(t *testing.T) {
+	err := testFlowValidationError(
+		[]interface{}{testFlowValidationMessage("#/states/1", "must have required property 'transitions'")},
+		[]interface{}{testFlowValidationMessage("#/states/0", "the state is not connected")},
+	)
+
+	// The error is wrapped to check the validation messages are read from wrapped errors
+	diags := handleError(testFlowDefinitionGetter{"definition": testFlowDefinition}, "Failed to create studio flow", fmt.Errorf("wrapped: %w", err), testFlowDefinition)
+	if len(diags) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got %d", len(diags))
+	}
+
+	if diags[0].Severity != diag.Error || diags[0].Summary != `Failed to create studio flow: state "SendMessage": must have required property 'transitions'` {
+		t.Errorf("Unexpected error diagnostic: %#v", diags[0])
+	}
+	if !strings.Contains(diags[0].Detail, "Property path: #/states/1") {
+		t.Errorf("Expected the detail to contain the property path, got %s", diags[0].Detail)
+	}
+	if diags[1].Severity != diag.Warning || diags[1].Summary != `Studio flow validation warning: state "Trigger": the state is not connected` {
+		t.Errorf("Unexpected warning diagnostic: %#v", diags[1])
+	}
+}
+
+func TestHandleErrorTranslatesOtherErrors(t *testing.T) {
+	code := 20001
+	err := &sdkUtils.TwilioError{
+		Code:    &code,
+		Message: "Invalid Status",
+		Status:  400,
+		Details: &map[string]interface{}{"reason": "unknown"},
+	}
+
+	diags := handleError(testFlowDefinitionGetter{"definition": testFlowDefinition}, "Failed to create studio flow", err, testFlowDefinition)
+	if len(diags) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d", len(diags))
+	}
+	if !strings.HasPrefix(diags[0].Summary, "Failed to create studio flow: ") {
+		t.Errorf("Unexpected summary: %s", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "Twilio error 20001") || !strings.Contains(diags[0].Detail, `Details: {"reason":"unknown"}`) {
+		t.Errorf("Expected the error to be translated, got %s", diags[0].Detail)
+	}
+}
@@ -0,0 +1,42 @@
+package studio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+const namedImportIDFormat = "studio:<flow>"
+
+// resolveImportID converts an import ID which identifies the flow by name, i.e. `studio:<flow friendly_name>`, into the SID based import ID.
+// The name is resolved using the list endpoint. Import IDs which do not start with `studio:` are returned unchanged
+func resolveImportID(ctx context.Context, meta interface{}, id string) (string, error) {
+	segments, ok := utils.NamedImportIDSegments("studio", id)
+	if !ok {
+		return id, nil
+	}
+	if len(segments) != 1 || utils.HasEmptySegment(segments) {
+		return "", fmt.Errorf("The imported ID (%s) does not match the format (%s)", id, namedImportIDFormat)
+	}
+
+	client := meta.(*common.TwilioClient).Studio
+
+	paginator := client.Flows.NewFlowsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return "", fmt.Errorf("Failed to list studio flows: %s", err.Error())
+	}
+
+	candidates := make([]utils.NameCandidate, 0)
+	for _, flow := range paginator.Flows {
+		candidates = append(candidates, utils.NameCandidate{Sid: flow.Sid, Names: []interface{}{flow.FriendlyName}})
+	}
+	flowSid, err := utils.FindSidByName("studio flow", segments[0], candidates)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/Flows/%s", flowSid), nil
+}
@@ -14,6 +14,8 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"twilio_studio_flow":                                dataSourceStudioFlow(),
 		"twilio_studio_flow_definition":                     dataSourceStudioFlowDefinition(),
+		"twilio_studio_flow_definition_export":              dataSourceStudioFlowDefinitionExport(),
+		"twilio_studio_flow_revisions":                      dataSourceStudioFlowRevisions(),
 		"twilio_studio_flow_widget_add_twiml_redirect":      dataSourceStudioFlowWidgetAddTwiMLRedirect(),
 		"twilio_studio_flow_widget_capture_payments":        dataSourceStudioFlowWidgetCapturePayments(),
 		"twilio_studio_flow_widget_connect_call_to":         dataSourceStudioFlowWidgetConnectCallTo(),
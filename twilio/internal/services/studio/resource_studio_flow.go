@@ -2,11 +2,14 @@ package studio
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,10 +29,15 @@ func resourceStudioFlow() *schema.Resource {
 		DeleteContext: resourceStudioFlowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Flows/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 2 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -48,6 +56,15 @@ func resourceStudioFlow() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceStudioFlowV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: utils.NormalizeJSONStateUpgradeFunc("definition"),
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"sid": {
 				Type:     schema.TypeString,
@@ -72,9 +89,18 @@ func resourceStudioFlow() *schema.Resource {
 			},
 			"definition": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: structure.SuppressJsonDiff,
+				DiffSuppressFunc: suppressPinnedDefinitionDiff,
+				StateFunc:        utils.NormalizeJSON,
+				ExactlyOneOf:     []string{"definition", "flow_definition"},
+			},
+			"flow_definition": flowDefinitionSchema(),
+			"pinned_revision": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"commit_message": {
 				Type:     schema.TypeString,
@@ -110,17 +136,32 @@ func resourceStudioFlow() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			pinnedRevisionDiff,
+			flowDefinitionDiff,
+			validateFlowDiff,
+		),
 	}
 }
 
 func resourceStudioFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Studio
 
-	if err := validateRequest(ctx, d, meta); err != nil {
+	// The pinned revision is only checked when planning if the value is known, so a revision which was unknown until apply is rejected here
+	if d.Get("pinned_revision").(int) != 0 {
+		return diag.FromErr(errPinnedRevisionOnCreate)
+	}
+
+	definitionJSONString, err := resourceFlowDefinition(ctx, d)
+	if err != nil {
+		return diag.Errorf("Failed to build studio flow definition: %s", err.Error())
+	}
+
+	if err := validateRequest(ctx, d, meta, definitionJSONString); err != nil {
 		return err
 	}
 
-	definitionJSONString, _ := structure.NormalizeJsonString(d.Get("definition").(string))
 	createInput := &flows.CreateFlowInput{
 		FriendlyName:  d.Get("friendly_name").(string),
 		Status:        d.Get("status").(string),
@@ -130,7 +171,7 @@ func resourceStudioFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	createResult, err := client.Flows.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return handleError("Failed to create studio flow", err)
+		return handleError(d, "Failed to create studio flow", err, definitionJSONString)
 	}
 
 	d.SetId(createResult.Sid)
@@ -146,7 +187,7 @@ func resourceStudioFlowRead(ctx context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read studio flow: %s", err.Error())
+		return utils.TranslateError("Failed to read studio flow", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -158,6 +199,16 @@ func resourceStudioFlowRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("Unable to flatten definition json to string")
 	}
 	d.Set("definition", json)
+
+	// The flow definition block cannot be read back from the definition, so the block is removed from state when the definition has been changed outside of Terraform.
+	// The definition is expected to differ from the block whilst the flow is pinned to a revision
+	if _, ok := configuredFlowDefinition(d); ok && d.Get("pinned_revision").(int) == 0 {
+		if expectedDefinition, err := resourceFlowDefinition(ctx, d); err != nil || !flowDefinitionsEqual(expectedDefinition, json) {
+			log.Printf("[WARN] The definition of studio flow (%s) does not match the flow_definition block", d.Id())
+			d.Set("flow_definition", nil)
+		}
+	}
+
 	d.Set("status", getResponse.Status)
 	d.Set("revision", getResponse.Revision)
 	d.Set("commit_message", getResponse.CommitMessage)
@@ -177,20 +228,35 @@ func resourceStudioFlowRead(ctx context.Context, d *schema.ResourceData, meta in
 func resourceStudioFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).Studio
 
-	if err := validateRequest(ctx, d, meta); err != nil {
+	var definitionJSONString string
+	if revision, ok := d.GetOk("pinned_revision"); ok {
+		definition, err := pinnedRevisionDefinition(ctx, meta, d.Id(), revision.(int))
+		if err != nil {
+			return utils.TranslateError("Failed to read studio flow revision", err)
+		}
+		definitionJSONString = definition
+	} else {
+		definition, err := resourceFlowDefinition(ctx, d)
+		if err != nil {
+			return diag.Errorf("Failed to build studio flow definition: %s", err.Error())
+		}
+		definitionJSONString = definition
+	}
+
+	if err := validateRequest(ctx, d, meta, definitionJSONString); err != nil {
 		return err
 	}
 
 	updateInput := &flow.UpdateFlowInput{
 		FriendlyName:  utils.OptionalString(d, "friendly_name"),
 		Status:        d.Get("status").(string),
-		Definition:    utils.OptionalJSONString(d, "definition"),
+		Definition:    sdkUtils.String(definitionJSONString),
 		CommitMessage: utils.OptionalString(d, "commit_message"),
 	}
 
 	updateResp, err := client.Flow(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return handleError("Failed to update studio flow", err)
+		return handleError(d, "Failed to update studio flow", err, definitionJSONString)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -201,17 +267,17 @@ func resourceStudioFlowDelete(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(*common.TwilioClient).Studio
 
 	if err := client.Flow(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete studio flow: %s", err.Error())
+		return utils.TranslateError("Failed to delete studio flow", err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func validateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// validateRequest validates the flow before it is created/updated. This is required when the definition contains values which were only known after apply, as the flow cannot be validated when the plan is created
+func validateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}, definitionJSONString string) diag.Diagnostics {
 	if d.Get("validate").(bool) {
 		client := meta.(*common.TwilioClient).Studio
 
-		definitionJSONString, _ := structure.NormalizeJsonString(d.Get("definition").(string))
 		validateInput := &flow_validation.ValidateFlowInput{
 			FriendlyName:  d.Get("friendly_name").(string),
 			Status:        d.Get("status").(string),
@@ -221,7 +287,7 @@ func validateRequest(ctx context.Context, d *schema.ResourceData, meta interface
 
 		resp, err := client.FlowValidation.ValidateWithContext(ctx, validateInput)
 		if err != nil {
-			return handleError("Failed to validate studio flow", err)
+			return handleError(d, "Failed to validate studio flow", err, definitionJSONString)
 		}
 		if !resp.Valid {
 			return diag.Errorf("The template is invalid")
@@ -230,10 +296,85 @@ func validateRequest(ctx context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
-func handleError(errorPrefix string, err error) diag.Diagnostics {
-	if twilioErr, ok := err.(*sdkUtils.TwilioError); ok && twilioErr.Details != nil {
-		errDetails, _ := structure.FlattenJsonToString(*twilioErr.Details)
-		return diag.Errorf("%s: %s. Details are %s", errorPrefix, err.Error(), errDetails)
+// flowDefinitionDiff builds the definition from the `flow_definition` block when the plan is created, so invalid states are reported before any resources are changed.
+// The definition is marked as computed instead of being set, so the plan shows the changes to each state rather than a change to the whole JSON definition
+func flowDefinitionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := configuredFlowDefinition(d); !ok || !d.HasChange("flow_definition") || d.Get("pinned_revision").(int) > 0 {
+		return nil
+	}
+
+	if d.NewValueKnown("flow_definition") {
+		if _, err := resourceFlowDefinition(ctx, d); err != nil {
+			return fmt.Errorf("Failed to build studio flow definition: %s", err.Error())
+		}
+	}
+	return d.SetNewComputed("definition")
+}
+
+var errPinnedRevisionOnCreate = errors.New("pinned_revision cannot be set when the studio flow is created, as the flow does not have any revisions")
+
+// pinnedRevisionDiff plans the definition of the revision which the flow is pinned to, so changes made to the flow outside of Terraform are rolled back to the pinned revision.
+// The definition is marked as computed when the revision is not known until apply. A new flow cannot be pinned as the flow has no revisions
+func pinnedRevisionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("pinned_revision") {
+		if d.Id() != "" {
+			return d.SetNewComputed("definition")
+		}
+		return nil
+	}
+
+	revision := d.Get("pinned_revision").(int)
+	if revision == 0 {
+		return nil
+	}
+
+	if d.Id() == "" {
+		return errPinnedRevisionOnCreate
+	}
+
+	if meta == nil {
+		if d.HasChange("pinned_revision") {
+			return d.SetNewComputed("definition")
+		}
+		return nil
+	}
+
+	definition, err := pinnedRevisionDefinition(ctx, meta, d.Id(), revision)
+	if err != nil {
+		return fmt.Errorf("Failed to read studio flow revision: %s", err.Error())
+	}
+
+	if currentDefinition, _ := d.GetChange("definition"); !flowDefinitionsEqual(currentDefinition.(string), definition) {
+		log.Printf("[DEBUG] The definition of studio flow (%s) does not match pinned revision (%d)", d.Id(), revision)
+		return d.SetNew("definition", definition)
+	}
+	return nil
+}
+
+// suppressPinnedDefinitionDiff ignores the configured definition whilst the flow is pinned to a revision, as the definition of the revision is published instead.
+// Differences between the live definition and the pinned revision are planned by pinnedRevisionDiff
+func suppressPinnedDefinitionDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() != "" && d.Get("pinned_revision").(int) > 0 {
+		return true
+	}
+	return structure.SuppressJsonDiff(k, old, new, d)
+}
+
+// pinnedRevisionDefinition returns the definition of the revision which the flow is pinned to, so the flow can be rolled back to an earlier revision
+func pinnedRevisionDefinition(ctx context.Context, meta interface{}, flowSid string, revision int) (string, error) {
+	client := meta.(*common.TwilioClient).Studio
+
+	getResponse, err := client.Flow(flowSid).Revision(revision).FetchWithContext(ctx)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return "", fmt.Errorf("Revision (%d) of studio flow with sid (%s) was not found", revision, flowSid)
+		}
+		return "", err
+	}
+
+	json, err := structure.FlattenJsonToString(getResponse.Definition)
+	if err != nil {
+		return "", fmt.Errorf("Unable to flatten definition json to string")
 	}
-	return diag.Errorf("%s: %s", errorPrefix, err.Error())
+	return json, nil
 }
@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+const flowDefinitionExportDataSourceName = "twilio_studio_flow_definition_export"
+
+func TestAccDataSourceTwilioStudioFlowDefinitionExport_complete(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.export", flowDefinitionExportDataSourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowDefinitionExport_complete(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "flow_sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revision", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateDataSourceName, "status", "draft"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "definition"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "description", "A New Flow"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "initial_state", "Trigger"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "flags.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "flags.0.allow_concurrent_calls", "true"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.#", "3"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.name", "Trigger"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.type", "trigger"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.widget", "trigger"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.transitions.#", "3"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.transitions.1.event", "incomingMessage"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.transitions.1.next", "SendMessage"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.properties", ""),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.offset.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.offset.0.x", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.offset.0.y", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.1.name", "SendMessage"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.1.type", "send-message"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.1.widget", "send_message"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.1.properties", `{"body":"Hello World","from":"{{flow.channel.address}}","to":"{{contact.channel.address}}"}`),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.1.offset.0.x", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.1.offset.0.y", "200"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.2.name", "Custom"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.2.type", "custom-widget"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.2.widget", ""),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.2.transitions.#", "0"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.2.offset.#", "0"),
+					resource.TestMatchResourceAttr(stateDataSourceName, "hcl", regexp.MustCompile(`data "twilio_studio_flow_widget_send_message" "[a-z0-9_-]+_sendmessage" {`)),
+					resource.TestMatchResourceAttr(stateDataSourceName, "hcl", regexp.MustCompile(`"type": "custom-widget"`)),
+					resource.TestMatchResourceAttr(stateDataSourceName, "hcl", regexp.MustCompile(`resource "twilio_studio_flow" "[a-z0-9_-]+" {`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowDefinitionExport_revision(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.export", flowDefinitionExportDataSourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowDefinitionExport_revision(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revision", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "states.0.widget", "trigger"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowDefinitionExport_invalidFlowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowDefinitionExport_invalidFlowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of flow_sid to match regular expression "\^FW\[0-9a-fA-F\]\{32\}\$", got flow_sid`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowDefinitionExport_invalidRevision(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowDefinitionExport_invalidRevision(),
+				ExpectError: regexp.MustCompile(`(?s)expected revision to be at least \(1\), got 0`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowDefinitionExport_complete(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [
+          {
+            "event" : "incomingMessage",
+            "next" : "SendMessage"
+          },
+          {
+            "event" : "incomingCall"
+          },
+          {
+            "event" : "incomingRequest"
+          }
+        ],
+        "type" : "trigger"
+      },
+      {
+        "name" : "SendMessage",
+        "properties" : {
+          "body" : "Hello World",
+          "from" : "{{flow.channel.address}}",
+          "to" : "{{contact.channel.address}}",
+          "offset" : {
+            "x" : 0,
+            "y" : 200
+          }
+        },
+        "transitions" : [
+          {
+            "event" : "sent"
+          },
+          {
+            "event" : "failed"
+          }
+        ],
+        "type" : "send-message"
+      },
+      {
+        "name" : "Custom",
+        "properties" : {
+          "message" : "Hello World"
+        },
+        "transitions" : [],
+        "type" : "custom-widget"
+      }
+    ]
+  })
+  validate = false
+}
+
+data "twilio_studio_flow_definition_export" "export" {
+  flow_sid = twilio_studio_flow.flow.sid
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioStudioFlowDefinitionExport_revision(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+
+data "twilio_studio_flow_definition_export" "export" {
+  flow_sid = twilio_studio_flow.flow.sid
+  revision = twilio_studio_flow.flow.revision
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioStudioFlowDefinitionExport_invalidFlowSid() string {
+	return `
+data "twilio_studio_flow_definition_export" "export" {
+  flow_sid = "flow_sid"
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowDefinitionExport_invalidRevision() string {
+	return `
+data "twilio_studio_flow_definition_export" "export" {
+  flow_sid = "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  revision = 0
+}
+`
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -26,6 +27,64 @@ func TestAccDataSourceTwilioStudioFlowDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceTwilioStudioFlowDefinition_autoLayout(t *testing.T) {
+	stateDataSourceName := "data.twilio_studio_flow_definition.definition"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowDefinition_autoLayout(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "auto_layout", "true"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "json", `{"description":"Flow with auto layout","initial_state":"Trigger","states":[{"name":"Trigger","properties":{"offset":{"x":0,"y":0}},"transitions":[{"event":"incomingCall","next":"SayPlay"},{"event":"incomingMessage","next":"SendMessage"},{"event":"incomingRequest"}],"type":"trigger"},{"name":"SendMessage","properties":{"body":"Hello World","from":"{{flow.channel.address}}","offset":{"x":500,"y":600},"to":"{{contact.channel.address}}"},"transitions":[{"event":"failed"},{"event":"sent"}],"type":"send-message"},{"name":"SayPlay","properties":{"offset":{"x":-200,"y":250},"say":"Hello World"},"transitions":[{"event":"audioComplete"}],"type":"say-play"}]}`),
+					helper.ValidateFlowDefinition(stateDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowDefinition_missingState(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowDefinition_missingState(),
+				ExpectError: regexp.MustCompile(`(?s)state "Trigger": the incomingMessage transition moves to a state \(SendMessage\) which does not exist`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowDefinition_duplicateStateNames(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowDefinition_duplicateStateNames(),
+				ExpectError: regexp.MustCompile(`(?s)state "SendMessage": the state name is already used by the state at index 1`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowDefinition_initialStateNotTrigger(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowDefinition_initialStateNotTrigger(),
+				ExpectError: regexp.MustCompile(`(?s)state "SendMessage": the state is the initial state but is a send-message widget, the initial state must be a trigger widget`),
+			},
+		},
+	})
+}
+
 func testAccDataSourceTwilioStudioFlowDefinition_basic() string {
 	return `
 data "twilio_studio_flow_widget_send_to_autopilot" "send_to_autopilot" {
@@ -99,3 +158,122 @@ data "twilio_studio_flow_definition" "definition" {
 }
 `
 }
+
+func testAccDataSourceTwilioStudioFlowDefinition_autoLayout() string {
+	return `
+data "twilio_studio_flow_widget_say_play" "say_play" {
+  name = "SayPlay"
+  say  = "Hello World"
+}
+
+data "twilio_studio_flow_widget_send_message" "send_message" {
+  name = "SendMessage"
+  body = "Hello World"
+
+  offset {
+    x = 500
+    y = 600
+  }
+}
+
+data "twilio_studio_flow_widget_trigger" "trigger" {
+  name = "Trigger"
+
+  transitions {
+    incoming_call    = data.twilio_studio_flow_widget_say_play.say_play.name
+    incoming_message = data.twilio_studio_flow_widget_send_message.send_message.name
+  }
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Flow with auto layout"
+  initial_state = data.twilio_studio_flow_widget_trigger.trigger.name
+  auto_layout   = true
+
+  states {
+    json = data.twilio_studio_flow_widget_trigger.trigger.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_send_message.send_message.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_say_play.say_play.json
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowDefinition_missingState() string {
+	return `
+data "twilio_studio_flow_widget_trigger" "trigger" {
+  name = "Trigger"
+
+  transitions {
+    incoming_message = "SendMessage"
+  }
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Flow with a missing state"
+  initial_state = data.twilio_studio_flow_widget_trigger.trigger.name
+
+  states {
+    json = data.twilio_studio_flow_widget_trigger.trigger.json
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowDefinition_duplicateStateNames() string {
+	return `
+data "twilio_studio_flow_widget_send_message" "send_message" {
+  name = "SendMessage"
+  body = "Hello World"
+}
+
+data "twilio_studio_flow_widget_trigger" "trigger" {
+  name = "Trigger"
+
+  transitions {
+    incoming_message = data.twilio_studio_flow_widget_send_message.send_message.name
+  }
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Flow with duplicate state names"
+  initial_state = data.twilio_studio_flow_widget_trigger.trigger.name
+
+  states {
+    json = data.twilio_studio_flow_widget_trigger.trigger.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_send_message.send_message.json
+  }
+
+  states {
+    json = data.twilio_studio_flow_widget_send_message.send_message.json
+  }
+}
+`
+}
+
+func testAccDataSourceTwilioStudioFlowDefinition_initialStateNotTrigger() string {
+	return `
+data "twilio_studio_flow_widget_send_message" "send_message" {
+  name = "SendMessage"
+  body = "Hello World"
+}
+
+data "twilio_studio_flow_definition" "definition" {
+  description   = "Flow without a trigger"
+  initial_state = data.twilio_studio_flow_widget_send_message.send_message.name
+
+  states {
+    json = data.twilio_studio_flow_widget_send_message.send_message.json
+  }
+}
+`
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+const flowRevisionsDataSourceName = "twilio_studio_flow_revisions"
+
+func TestAccDataSourceTwilioStudioFlowRevisions_basic(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.revisions", flowRevisionsDataSourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowRevisions_basic(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "flow_sid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "account_sid"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.#", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.revision", "1"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.status", "draft"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.commit_message", "Initial revision"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "include_definitions", "false"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.0.definition", ""),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.valid"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.date_created"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowRevisions_includeDefinitions(t *testing.T) {
+	stateDataSourceName := fmt.Sprintf("data.%s.revisions", flowRevisionsDataSourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTwilioStudioFlowRevisions_includeDefinitions(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stateDataSourceName, "include_definitions", "true"),
+					resource.TestCheckResourceAttr(stateDataSourceName, "revisions.#", "1"),
+					resource.TestCheckResourceAttrSet(stateDataSourceName, "revisions.0.definition"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceTwilioStudioFlowRevisions_invalidFlowSid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceTwilioStudioFlowRevisions_invalidFlowSid(),
+				ExpectError: regexp.MustCompile(`(?s)expected value of flow_sid to match regular expression "\^FW\[0-9a-fA-F\]\{32\}\$", got flow_sid`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTwilioStudioFlowRevisions_basic(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name  = "%s"
+  status         = "draft"
+  commit_message = "Initial revision"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+
+data "twilio_studio_flow_revisions" "revisions" {
+  flow_sid = twilio_studio_flow.flow.sid
+}
+`, friendlyName)
+}
+
+func testAccDataSourceTwilioStudioFlowRevisions_includeDefinitions(friendlyName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "twilio_studio_flow_revisions" "revisions" {
+  flow_sid            = twilio_studio_flow.flow.sid
+  include_definitions = true
+}
+`, testAccTwilioStudioFlow_basic(friendlyName, "draft"))
+}
+
+func testAccDataSourceTwilioStudioFlowRevisions_invalidFlowSid() string {
+	return `
+data "twilio_studio_flow_revisions" "revisions" {
+  flow_sid = "flow_sid"
+}
+`
+}
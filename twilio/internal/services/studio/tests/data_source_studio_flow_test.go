@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ const dataSourceName = "twilio_studio_flow"
 
 func TestAccDataSourceTwilioStudioFlow_complete(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.flow", dataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	status := "draft"
 
 	resource.ParallelTest(t, resource.TestCase{
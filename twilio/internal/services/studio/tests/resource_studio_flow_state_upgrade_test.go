@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestTwilioStudioFlow_stateUpgradeV0(t *testing.T) {
+	v0State := map[string]interface{}{
+		"id":             "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"sid":            "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"friendly_name":  "tf-acc-flow",
+		"status":         "draft",
+		"definition":     "{\n  \"states\": [],\n  \"initial_state\": \"Trigger\",\n  \"flags\": {\n    \"allow_concurrent_calls\": true\n  },\n  \"description\": \"A New Flow\"\n}",
+		"commit_message": "",
+		"validate":       false,
+		"revision":       1,
+	}
+
+	expected := map[string]interface{}{
+		"id":             "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"sid":            "FWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"friendly_name":  "tf-acc-flow",
+		"status":         "draft",
+		"definition":     `{"description":"A New Flow","flags":{"allow_concurrent_calls":true},"initial_state":"Trigger","states":[]}`,
+		"commit_message": "",
+		"validate":       false,
+		"revision":       1,
+	}
+
+	acceptance.CheckUpgradedState(t, resourceName, 0, v0State, expected)
+}
@@ -5,19 +5,20 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+	"github.com/timworks/twilio-sdk-go/service/studio/v2/flow"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
 )
 
 const resourceName = "twilio_studio_flow"
 
 func TestAccTwilioStudio_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.flow", resourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	status := "draft"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -52,12 +53,19 @@ func TestAccTwilioStudio_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"validate"},
 			},
+			{
+				ResourceName:            stateResourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("studio:%s", friendlyName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate"},
+			},
 		},
 	})
 }
 
 func TestAccTwilioStudioFlow_invalidStatus(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	status := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,7 +84,7 @@ func TestAccTwilioStudioFlow_invalidStatus(t *testing.T) {
 func TestAccTwilioStudioFlow_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.flow", resourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	status := "draft"
 	newStatus := "published"
 
@@ -129,6 +137,114 @@ func TestAccTwilioStudioFlow_update(t *testing.T) {
 	})
 }
 
+func TestAccTwilioStudioFlow_pinnedRevision(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.flow", resourceName)
+	friendlyName := acceptance.RandomName()
+	var sid string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioStudioFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioStudioFlow_withDescription(friendlyName, "A New Flow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "1"),
+					resource.TestCheckNoResourceAttr(stateResourceName, "pinned_revision"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"A New Flow"`)),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlow_withDescription(friendlyName, "An Updated Flow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "2"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"An Updated Flow"`)),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlow_withPinnedRevision(friendlyName, "An Updated Flow", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "pinned_revision", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "3"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"A New Flow"`)),
+					testAccGetTwilioStudioFlowSid(stateResourceName, &sid),
+				),
+			},
+			{
+				PreConfig: testAccUpdateTwilioStudioFlowDescription(t, &sid, "A Changed Flow"),
+				Config:    testAccTwilioStudioFlow_withPinnedRevision(friendlyName, "An Updated Flow", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "pinned_revision", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "5"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"A New Flow"`)),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlow_withDescription(friendlyName, "An Updated Flow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckNoResourceAttr(stateResourceName, "pinned_revision"),
+					resource.TestCheckResourceAttr(stateResourceName, "revision", "6"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"description":"An Updated Flow"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_pinnedRevisionOnCreate(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioStudioFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_withPinnedRevision(friendlyName, "A New Flow", 1),
+				ExpectError: regexp.MustCompile(`(?s)pinned_revision cannot be set when the studio flow is created, as the flow does not have any revisions`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_unknownPinnedRevisionOnCreate(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioStudioFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_withUnknownPinnedRevision(friendlyName),
+				ExpectError: regexp.MustCompile(`(?s)pinned_revision cannot be set when the studio flow is created, as the flow does not have any revisions`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_invalidPinnedRevision(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioStudioFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_withPinnedRevision(friendlyName, "A New Flow", 0),
+				ExpectError: regexp.MustCompile(`(?s)expected pinned_revision to be at least \(1\), got 0`),
+			},
+		},
+	})
+}
+
 func TestAccTwilioStudioFlow_blankFriendlyName(t *testing.T) {
 	friendlyName := ""
 	status := "draft"
@@ -170,7 +286,7 @@ func TestAccTwilioStudioFlow_withWidgets(t *testing.T) {
 func TestAccTwilioStudioFlow_commitMessage(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.flow", resourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	status := "published"
 	commitMessage := "Test commit message"
 
@@ -204,7 +320,96 @@ func TestAccTwilioStudioFlow_withInvalidFlow(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccTwilioStudioFlow_invalidFlow(),
-				ExpectError: regexp.MustCompile("(?s)Failed to validate studio flow: Flow definition validation failed, check `details` for more information. Details are .*"),
+				ExpectError: regexp.MustCompile("(?s)Failed to validate studio flow: Flow definition validation failed, check `details` for more information.*must have required property 'initial_state'"),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_withInvalidState(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_invalidState(friendlyName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Failed to validate studio flow: .*state "SendMessage": `),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_flowDefinition(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.flow", resourceName)
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioStudioFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioStudioFlow_flowDefinition(friendlyName, "Hello World"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateResourceName, "flow_definition.#", "1"),
+					resource.TestCheckResourceAttr(stateResourceName, "flow_definition.0.initial_state", "Trigger"),
+					resource.TestCheckResourceAttr(stateResourceName, "flow_definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(stateResourceName, "flow_definition.0.state.1.send_message.0.body", "Hello World"),
+					resource.TestCheckResourceAttr(stateResourceName, "definition", `{"description":"Flow with native state blocks","flags":{"allow_concurrent_calls":true},"initial_state":"Trigger","states":[{"name":"Trigger","properties":{},"transitions":[{"event":"incomingCall"},{"event":"incomingMessage","next":"SendMessage"},{"event":"incomingRequest"}],"type":"trigger"},{"name":"SendMessage","properties":{"body":"Hello World","from":"{{flow.channel.address}}","offset":{"x":0,"y":200},"to":"{{contact.channel.address}}"},"transitions":[{"event":"failed"},{"event":"sent"}],"type":"send-message"}]}`),
+					resource.TestCheckResourceAttrSet(stateResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccTwilioStudioFlow_flowDefinition(friendlyName, "Goodbye World"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioStudioFlowExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "flow_definition.0.state.1.send_message.0.body", "Goodbye World"),
+					resource.TestMatchResourceAttr(stateResourceName, "definition", regexp.MustCompile(`"body":"Goodbye World"`)),
+				),
+			},
+			{
+				ResourceName:            stateResourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTwilioStudioFlowImportStateIdFunc(stateResourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate", "flow_definition"},
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_flowDefinitionWithMultipleWidgets(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_flowDefinitionWithMultipleWidgets(friendlyName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)The state \(Trigger\) must contain exactly one widget block, found 2`),
+			},
+		},
+	})
+}
+
+func TestAccTwilioStudioFlow_definitionAndFlowDefinition(t *testing.T) {
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTwilioStudioFlow_definitionAndFlowDefinition(friendlyName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)"definition": only one of\s+` + "`definition,flow_definition`" + `\s+can be specified`),
 			},
 		},
 	})
@@ -247,6 +452,33 @@ func testAccCheckTwilioStudioFlowExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccGetTwilioStudioFlowSid(name string, sid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*sid = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccUpdateTwilioStudioFlowDescription publishes a new revision of the flow outside of Terraform, so drift from the pinned revision can be tested
+func testAccUpdateTwilioStudioFlowDescription(t *testing.T, sid *string, description string) func() {
+	return func() {
+		client := acceptance.TestAccProvider.Meta().(*common.TwilioClient).Studio
+
+		definition := fmt.Sprintf(`{"description":"%s","flags":{"allow_concurrent_calls":true},"initial_state":"Trigger","states":[{"name":"Trigger","properties":{"offset":{"x":0,"y":0}},"transitions":[],"type":"trigger"}]}`, description)
+		if _, err := client.Flow(*sid).Update(&flow.UpdateFlowInput{
+			Status:     "published",
+			Definition: sdkUtils.String(definition),
+		}); err != nil {
+			t.Fatalf("Failed to update studio flow (%s): %s", *sid, err.Error())
+		}
+	}
+}
+
 func testAccTwilioStudioFlowImportStateIdFunc(name string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[name]
@@ -287,6 +519,114 @@ resource "twilio_studio_flow" "flow" {
 `, friendlyName, status)
 }
 
+func testAccTwilioStudioFlow_withDescription(friendlyName string, description string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "published"
+  definition = jsonencode({
+    "description" : "%s",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+`, friendlyName, description)
+}
+
+func testAccTwilioStudioFlow_withPinnedRevision(friendlyName string, description string, pinnedRevision int) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name   = "%s"
+  status          = "published"
+  pinned_revision = %d
+  definition = jsonencode({
+    "description" : "%s",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+`, friendlyName, pinnedRevision, description)
+}
+
+func testAccTwilioStudioFlow_withUnknownPinnedRevision(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "source" {
+  friendly_name = "%[1]s"
+  status        = "draft"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+
+resource "twilio_studio_flow" "flow" {
+  friendly_name   = "%[1]s"
+  status          = "draft"
+  pinned_revision = twilio_studio_flow.source.revision
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        },
+        "transitions" : [],
+        "type" : "trigger"
+      }
+    ]
+  })
+}
+`, friendlyName)
+}
+
 func testAccTwilioStudioFlow_withCommitMessage(friendlyName string, status string, commitMessage string) string {
 	return fmt.Sprintf(`
 resource "twilio_studio_flow" "flow" {
@@ -362,3 +702,133 @@ resource "twilio_studio_flow" "flow" {
 }
 `
 }
+
+func testAccTwilioStudioFlow_invalidState(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+  validate      = true
+  definition = jsonencode({
+    "description" : "Flow with an invalid state",
+    "flags" : {
+      "allow_concurrent_calls" : true
+    },
+    "initial_state" : "Trigger",
+    "states" : [
+      {
+        "name" : "Trigger",
+        "type" : "trigger",
+        "transitions" : [
+          {
+            "event" : "incomingMessage",
+            "next" : "SendMessage"
+          }
+        ],
+        "properties" : {
+          "offset" : {
+            "x" : 0,
+            "y" : 0
+          }
+        }
+      },
+      {
+        "name" : "SendMessage",
+        "type" : "send-message",
+        "transitions" : []
+      }
+    ]
+  })
+}
+`, friendlyName)
+}
+
+func testAccTwilioStudioFlow_flowDefinition(friendlyName string, body string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+
+  flow_definition {
+    description   = "Flow with native state blocks"
+    initial_state = "Trigger"
+
+    flags {
+      allow_concurrent_calls = true
+    }
+
+    state {
+      name = "Trigger"
+
+      trigger {
+        transitions {
+          incoming_message = "SendMessage"
+        }
+      }
+    }
+
+    state {
+      name = "SendMessage"
+
+      offset {
+        x = 0
+        y = 200
+      }
+
+      send_message {
+        body = "%s"
+      }
+    }
+  }
+}
+`, friendlyName, body)
+}
+
+func testAccTwilioStudioFlow_flowDefinitionWithMultipleWidgets(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+
+  flow_definition {
+    description   = "Flow with an invalid state block"
+    initial_state = "Trigger"
+
+    state {
+      name = "Trigger"
+
+      trigger {}
+
+      send_message {
+        body = "Hello World"
+      }
+    }
+  }
+}
+`, friendlyName)
+}
+
+func testAccTwilioStudioFlow_definitionAndFlowDefinition(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_studio_flow" "flow" {
+  friendly_name = "%s"
+  status        = "draft"
+  definition = jsonencode({
+    "description" : "A New Flow",
+    "initial_state" : "Trigger",
+    "states" : []
+  })
+
+  flow_definition {
+    description   = "Flow with native state blocks"
+    initial_state = "Trigger"
+
+    state {
+      name = "Trigger"
+
+      trigger {}
+    }
+  }
+}
+`, friendlyName)
+}
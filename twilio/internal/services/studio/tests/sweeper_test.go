@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_studio_flow", &resource.Sweeper{
+		Name: "twilio_studio_flow",
+		F:    sweepStudioFlows,
+	})
+}
+
+func sweepStudioFlows(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Studio
+
+	ctx := context.Background()
+	paginator := client.Flows.NewFlowsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list studio flows: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Flows {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("studio flow", item.Sid, item.FriendlyName)
+		if err := client.Flow(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("studio flow", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
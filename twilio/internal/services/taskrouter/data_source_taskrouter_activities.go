@@ -19,7 +19,7 @@ func dataSourceTaskRouterActivities() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("activities", map[string]*schema.Schema{
 			"workspace_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,7 +69,7 @@ func dataSourceTaskRouterActivities() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -92,7 +92,7 @@ func dataSourceTaskRouterActivitiesRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No activities were found for taskrouter workspace with sid (%s)", workspaceSid)
 		}
-		return diag.Errorf("Failed to read taskrouter activity: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter activity", err)
 	}
 
 	d.SetId(workspaceSid)
@@ -118,7 +118,11 @@ func dataSourceTaskRouterActivitiesRead(ctx context.Context, d *schema.ResourceD
 		activities = append(activities, activitiesMap)
 	}
 
-	d.Set("activities", &activities)
+	filteredActivities, err := utils.FilterListItems(d, activities)
+	if err != nil {
+		return diag.Errorf("Failed to filter activities: %s", err.Error())
+	}
+	d.Set("activities", &filteredActivities)
 
 	return nil
 }
@@ -67,7 +67,7 @@ func dataSourceTaskRouterActivityRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Activity with sid (%s) was not found for taskrouter workspace with sid (%s)", sid, workspaceSid)
 		}
-		return diag.Errorf("Failed to read taskrouter activity: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter activity", err)
 	}
 
 	d.SetId(getResponse.Sid)
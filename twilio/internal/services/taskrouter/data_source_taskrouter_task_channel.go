@@ -82,7 +82,7 @@ func dataSourceTaskRouterTaskChannelRead(ctx context.Context, d *schema.Resource
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Task channel with sid/ unique name (%s) was not found for taskrouter workspace with sid (%s)", identifier, workspaceSid)
 		}
-		return diag.Errorf("Failed to read task channel: %s", err.Error())
+		return utils.TranslateError("Failed to read task channel", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceTaskRouterTaskChannels() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("task_channels", map[string]*schema.Schema{
 			"workspace_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -64,7 +64,7 @@ func dataSourceTaskRouterTaskChannels() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -82,7 +82,7 @@ func dataSourceTaskRouterTaskChannelsRead(ctx context.Context, d *schema.Resourc
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No task channels were found for taskrouter workspace with sid (%s)", workspaceSid)
 		}
-		return diag.Errorf("Failed to read task channel: %s", err.Error())
+		return utils.TranslateError("Failed to read task channel", err)
 	}
 
 	d.SetId(workspaceSid)
@@ -109,7 +109,11 @@ func dataSourceTaskRouterTaskChannelsRead(ctx context.Context, d *schema.Resourc
 		taskChannels = append(taskChannels, taskChannelsMap)
 	}
 
-	d.Set("task_channels", &taskChannels)
+	filteredTaskChannels, err := utils.FilterListItems(d, taskChannels)
+	if err != nil {
+		return diag.Errorf("Failed to filter task channels: %s", err.Error())
+	}
+	d.Set("task_channels", &filteredTaskChannels)
 
 	return nil
 }
@@ -91,7 +91,7 @@ func dataSourceTaskRouterTaskQueueRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Task queue with sid (%s) was not found for taskrouter workspace with sid (%s)", sid, workspaceSid)
 		}
-		return diag.Errorf("Failed to read task queue: %s", err.Error())
+		return utils.TranslateError("Failed to read task queue", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,7 +18,7 @@ func dataSourceTaskRouterTaskQueues() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("task_queues", map[string]*schema.Schema{
 			"workspace_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -84,7 +84,7 @@ func dataSourceTaskRouterTaskQueues() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -102,7 +102,7 @@ func dataSourceTaskRouterTaskQueuesRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No task queues were found for taskrouter workspace with sid (%s)", workspaceSid)
 		}
-		return diag.Errorf("Failed to read task queue: %s", err.Error())
+		return utils.TranslateError("Failed to read task queue", err)
 	}
 
 	d.SetId(workspaceSid)
@@ -134,7 +134,11 @@ func dataSourceTaskRouterTaskQueuesRead(ctx context.Context, d *schema.ResourceD
 		taskQueues = append(taskQueues, taskQueuesMap)
 	}
 
-	d.Set("task_queues", &taskQueues)
+	filteredTaskQueues, err := utils.FilterListItems(d, taskQueues)
+	if err != nil {
+		return diag.Errorf("Failed to filter task queues: %s", err.Error())
+	}
+	d.Set("task_queues", &filteredTaskQueues)
 
 	return nil
 }
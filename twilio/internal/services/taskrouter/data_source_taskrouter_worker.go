@@ -83,7 +83,7 @@ func dataSourceTaskRouterWorkerRead(ctx context.Context, d *schema.ResourceData,
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Worker with sid (%s) was not found for taskrouter workspace with sid (%s)", sid, workspaceSid)
 		}
-		return diag.Errorf("Failed to read taskrouter worker: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter worker", err)
 	}
 
 	d.SetId(getResponse.Sid)
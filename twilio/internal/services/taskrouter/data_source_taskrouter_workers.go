@@ -19,7 +19,7 @@ func dataSourceTaskRouterWorkers() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("workers", map[string]*schema.Schema{
 			"workspace_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -107,7 +107,7 @@ func dataSourceTaskRouterWorkers() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -135,7 +135,7 @@ func dataSourceTaskRouterWorkersRead(ctx context.Context, d *schema.ResourceData
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No workers were found for taskrouter workspace with sid (%s)", workspaceSid)
 		}
-		return diag.Errorf("Failed to read taskrouter worker: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter worker", err)
 	}
 
 	d.SetId(workspaceSid)
@@ -168,7 +168,11 @@ func dataSourceTaskRouterWorkersRead(ctx context.Context, d *schema.ResourceData
 		workers = append(workers, workersMap)
 	}
 
-	d.Set("workers", &workers)
+	filteredWorkers, err := utils.FilterListItems(d, workers)
+	if err != nil {
+		return diag.Errorf("Failed to filter workers: %s", err.Error())
+	}
+	d.Set("workers", &filteredWorkers)
 
 	return nil
 }
@@ -83,7 +83,7 @@ func dataSourceTaskRouterWorkflowRead(ctx context.Context, d *schema.ResourceDat
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Workflow with sid (%s) was not found for taskrouter workspace with sid (%s)", sid, workspaceSid)
 		}
-		return diag.Errorf("Failed to read workflow: %s", err.Error())
+		return utils.TranslateError("Failed to read workflow", err)
 	}
 
 	d.SetId(getResponse.Sid)
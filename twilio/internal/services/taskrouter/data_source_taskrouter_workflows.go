@@ -19,7 +19,7 @@ func dataSourceTaskRouterWorkflows() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("workflows", map[string]*schema.Schema{
 			"workspace_sid": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -81,7 +81,7 @@ func dataSourceTaskRouterWorkflows() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -103,7 +103,7 @@ func dataSourceTaskRouterWorkflowsRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No workflows were found for taskrouter workspace with sid (%s)", workspaceSid)
 		}
-		return diag.Errorf("Failed to list workflows: %s", err.Error())
+		return utils.TranslateError("Failed to list workflows", err)
 	}
 
 	d.SetId(workspaceSid)
@@ -133,7 +133,11 @@ func dataSourceTaskRouterWorkflowsRead(ctx context.Context, d *schema.ResourceDa
 		workflows = append(workflows, workflowsMap)
 	}
 
-	d.Set("workflows", &workflows)
+	filteredWorkflows, err := utils.FilterListItems(d, workflows)
+	if err != nil {
+		return diag.Errorf("Failed to filter workflows: %s", err.Error())
+	}
+	d.Set("workflows", &filteredWorkflows)
 
 	return nil
 }
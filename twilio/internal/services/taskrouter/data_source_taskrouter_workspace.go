@@ -93,7 +93,7 @@ func dataSourceTaskRouterWorkspaceRead(ctx context.Context, d *schema.ResourceDa
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("TaskRouter workspace with sid (%s) was not found", sid)
 		}
-		return diag.Errorf("Failed to read taskrouter workspace: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter workspace", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -20,7 +20,7 @@ func dataSourceTaskRouterWorkspaces() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("workspaces", map[string]*schema.Schema{
 			"friendly_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -92,7 +92,7 @@ func dataSourceTaskRouterWorkspaces() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -113,7 +113,7 @@ func dataSourceTaskRouterWorkspacesRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("No workspaces were found")
 		}
-		return diag.Errorf("Failed to read taskrouter workspaces: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter workspaces", err)
 	}
 
 	d.SetId(twilioClient.AccountSid)
@@ -149,7 +149,11 @@ func dataSourceTaskRouterWorkspacesRead(ctx context.Context, d *schema.ResourceD
 		workspaces = append(workspaces, workspacesMap)
 	}
 
-	d.Set("workspaces", &workspaces)
+	filteredWorkspaces, err := utils.FilterListItems(d, workspaces)
+	if err != nil {
+		return diag.Errorf("Failed to filter workspaces: %s", err.Error())
+	}
+	d.Set("workspaces", &filteredWorkspaces)
 
 	return nil
 }
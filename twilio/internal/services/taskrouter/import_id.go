@@ -0,0 +1,120 @@
+package taskrouter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/common"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
+)
+
+const namedImportIDFormat = "taskrouter:<workspace>[/activities/<activity>|/task_channels/<task channel>|/task_queues/<task queue>|/workers/<worker>|/workflows/<workflow>]"
+
+// resolveImportID converts an import ID which identifies the resource by name, i.e. `taskrouter:<workspace friendly_name>/workflows/<workflow friendly_name>`, into the SID based import ID.
+// The names are resolved using the list endpoints. Import IDs which do not start with `taskrouter:` are returned unchanged
+func resolveImportID(ctx context.Context, meta interface{}, id string) (string, error) {
+	segments, ok := utils.NamedImportIDSegments("taskrouter", id)
+	if !ok {
+		return id, nil
+	}
+	if utils.HasEmptySegment(segments) || (len(segments) != 1 && len(segments) != 3) {
+		return "", fmt.Errorf("The imported ID (%s) does not match the format (%s)", id, namedImportIDFormat)
+	}
+
+	client := meta.(*common.TwilioClient).TaskRouter
+
+	workspacesPaginator := client.Workspaces.NewWorkspacesPaginator()
+	for workspacesPaginator.NextWithContext(ctx) {
+	}
+	if err := workspacesPaginator.Error(); err != nil {
+		return "", fmt.Errorf("Failed to list taskrouter workspaces: %s", err.Error())
+	}
+
+	workspaceCandidates := make([]utils.NameCandidate, 0)
+	for _, workspace := range workspacesPaginator.Workspaces {
+		workspaceCandidates = append(workspaceCandidates, utils.NameCandidate{Sid: workspace.Sid, Names: []interface{}{workspace.FriendlyName}})
+	}
+	workspaceSid, err := utils.FindSidByName("taskrouter workspace", segments[0], workspaceCandidates)
+	if err != nil {
+		return "", err
+	}
+
+	if len(segments) == 1 {
+		return fmt.Sprintf("/Workspaces/%s", workspaceSid), nil
+	}
+
+	workspaceClient := client.Workspace(workspaceSid)
+	candidates := make([]utils.NameCandidate, 0)
+	var resourceDescription, pathSegment string
+
+	switch segments[1] {
+	case "activities":
+		resourceDescription, pathSegment = "taskrouter activity", "Activities"
+
+		paginator := workspaceClient.Activities.NewActivitiesPaginator()
+		for paginator.NextWithContext(ctx) {
+		}
+		if err := paginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list taskrouter activities: %s", err.Error())
+		}
+		for _, activity := range paginator.Activities {
+			candidates = append(candidates, utils.NameCandidate{Sid: activity.Sid, Names: []interface{}{activity.FriendlyName}})
+		}
+	case "task_channels":
+		resourceDescription, pathSegment = "taskrouter task channel", "TaskChannels"
+
+		paginator := workspaceClient.TaskChannels.NewTaskChannelsPaginator()
+		for paginator.NextWithContext(ctx) {
+		}
+		if err := paginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list taskrouter task channels: %s", err.Error())
+		}
+		for _, taskChannel := range paginator.TaskChannels {
+			candidates = append(candidates, utils.NameCandidate{Sid: taskChannel.Sid, Names: []interface{}{taskChannel.UniqueName, taskChannel.FriendlyName}})
+		}
+	case "task_queues":
+		resourceDescription, pathSegment = "taskrouter task queue", "TaskQueues"
+
+		paginator := workspaceClient.TaskQueues.NewTaskQueuesPaginator()
+		for paginator.NextWithContext(ctx) {
+		}
+		if err := paginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list taskrouter task queues: %s", err.Error())
+		}
+		for _, taskQueue := range paginator.TaskQueues {
+			candidates = append(candidates, utils.NameCandidate{Sid: taskQueue.Sid, Names: []interface{}{taskQueue.FriendlyName}})
+		}
+	case "workers":
+		resourceDescription, pathSegment = "taskrouter worker", "Workers"
+
+		paginator := workspaceClient.Workers.NewWorkersPaginator()
+		for paginator.NextWithContext(ctx) {
+		}
+		if err := paginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list taskrouter workers: %s", err.Error())
+		}
+		for _, worker := range paginator.Workers {
+			candidates = append(candidates, utils.NameCandidate{Sid: worker.Sid, Names: []interface{}{worker.FriendlyName}})
+		}
+	case "workflows":
+		resourceDescription, pathSegment = "taskrouter workflow", "Workflows"
+
+		paginator := workspaceClient.Workflows.NewWorkflowsPaginator()
+		for paginator.NextWithContext(ctx) {
+		}
+		if err := paginator.Error(); err != nil {
+			return "", fmt.Errorf("Failed to list taskrouter workflows: %s", err.Error())
+		}
+		for _, workflow := range paginator.Workflows {
+			candidates = append(candidates, utils.NameCandidate{Sid: workflow.Sid, Names: []interface{}{workflow.FriendlyName}})
+		}
+	default:
+		return "", fmt.Errorf("The imported ID (%s) does not match the format (%s)", id, namedImportIDFormat)
+	}
+
+	sid, err := utils.FindSidByName(resourceDescription, segments[2], candidates)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/Workspaces/%s/%s/%s", workspaceSid, pathSegment, sid), nil
+}
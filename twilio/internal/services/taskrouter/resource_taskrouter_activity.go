@@ -23,10 +23,15 @@ func resourceTaskRouterActivity() *schema.Resource {
 		DeleteContext: resourceTaskRouterActivityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Workspaces/(.*)/Activities/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -97,7 +102,7 @@ func resourceTaskRouterActivityCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Workspace(d.Get("workspace_sid").(string)).Activities.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create taskrouter activity: %s", err.Error())
+		return utils.TranslateError("Failed to create taskrouter activity", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -113,7 +118,7 @@ func resourceTaskRouterActivityRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read taskrouter activity: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter activity", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -141,7 +146,7 @@ func resourceTaskRouterActivityUpdate(ctx context.Context, d *schema.ResourceDat
 
 	updateResp, err := client.Workspace(d.Get("workspace_sid").(string)).Activity(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update taskrouter activity: %s", err.Error())
+		return utils.TranslateError("Failed to update taskrouter activity", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -152,7 +157,7 @@ func resourceTaskRouterActivityDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).TaskRouter
 
 	if err := client.Workspace(d.Get("workspace_sid").(string)).Activity(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete taskrouter activity: %s", err.Error())
+		return utils.TranslateError("Failed to delete taskrouter activity", err)
 	}
 	d.SetId("")
 	return nil
@@ -23,10 +23,15 @@ func resourceTaskRouterTaskChannel() *schema.Resource {
 		DeleteContext: resourceTaskRouterTaskChannelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Workspaces/(.*)/TaskChannels/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -104,7 +109,7 @@ func resourceTaskRouterTaskChannelCreate(ctx context.Context, d *schema.Resource
 
 	createResult, err := client.Workspace(d.Get("workspace_sid").(string)).TaskChannels.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create task channel: %s", err.Error())
+		return utils.TranslateError("Failed to create task channel", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -120,7 +125,7 @@ func resourceTaskRouterTaskChannelRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read task channel: %s", err.Error())
+		return utils.TranslateError("Failed to read task channel", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -150,7 +155,7 @@ func resourceTaskRouterTaskChannelUpdate(ctx context.Context, d *schema.Resource
 
 	updateResp, err := client.Workspace(d.Get("workspace_sid").(string)).TaskChannel(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update task channel: %s", err.Error())
+		return utils.TranslateError("Failed to update task channel", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -161,7 +166,7 @@ func resourceTaskRouterTaskChannelDelete(ctx context.Context, d *schema.Resource
 	client := meta.(*common.TwilioClient).TaskRouter
 
 	if err := client.Workspace(d.Get("workspace_sid").(string)).TaskChannel(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete task channel: %s", err.Error())
+		return utils.TranslateError("Failed to delete task channel", err)
 	}
 	d.SetId("")
 	return nil
@@ -23,10 +23,15 @@ func resourceTaskRouterTaskQueue() *schema.Resource {
 		DeleteContext: resourceTaskRouterTaskQueueDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Workspaces/(.*)/TaskQueues/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -134,7 +139,7 @@ func resourceTaskRouterTaskQueueCreate(ctx context.Context, d *schema.ResourceDa
 
 	createResult, err := client.Workspace(d.Get("workspace_sid").(string)).TaskQueues.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create task queue: %s", err.Error())
+		return utils.TranslateError("Failed to create task queue", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -150,7 +155,7 @@ func resourceTaskRouterTaskQueueRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read task queue: %s", err.Error())
+		return utils.TranslateError("Failed to read task queue", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -189,7 +194,7 @@ func resourceTaskRouterTaskQueueUpdate(ctx context.Context, d *schema.ResourceDa
 
 	updateResp, err := client.Workspace(d.Get("workspace_sid").(string)).TaskQueue(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update task queue: %s", err.Error())
+		return utils.TranslateError("Failed to update task queue", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -200,7 +205,7 @@ func resourceTaskRouterTaskQueueDelete(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*common.TwilioClient).TaskRouter
 
 	if err := client.Workspace(d.Get("workspace_sid").(string)).TaskQueue(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete task queue: %s", err.Error())
+		return utils.TranslateError("Failed to delete task queue", err)
 	}
 	d.SetId("")
 	return nil
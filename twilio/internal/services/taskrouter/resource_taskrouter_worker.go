@@ -24,10 +24,15 @@ func resourceTaskRouterWorker() *schema.Resource {
 		DeleteContext: resourceTaskRouterWorkerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Workspaces/(.*)/Workers/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -119,7 +124,7 @@ func resourceTaskRouterWorkerCreate(ctx context.Context, d *schema.ResourceData,
 
 	createResult, err := client.Workspace(d.Get("workspace_sid").(string)).Workers.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create taskrouter worker: %s", err.Error())
+		return utils.TranslateError("Failed to create taskrouter worker", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -135,7 +140,7 @@ func resourceTaskRouterWorkerRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read taskrouter worker: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter worker", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -172,7 +177,7 @@ func resourceTaskRouterWorkerUpdate(ctx context.Context, d *schema.ResourceData,
 
 	updateResp, err := client.Workspace(d.Get("workspace_sid").(string)).Worker(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update taskrouter worker: %s", err.Error())
+		return utils.TranslateError("Failed to update taskrouter worker", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -183,7 +188,7 @@ func resourceTaskRouterWorkerDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*common.TwilioClient).TaskRouter
 
 	if err := client.Workspace(d.Get("workspace_sid").(string)).Worker(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete taskrouter worker: %s", err.Error())
+		return utils.TranslateError("Failed to delete taskrouter worker", err)
 	}
 	d.SetId("")
 	return nil
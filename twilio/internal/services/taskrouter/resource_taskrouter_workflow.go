@@ -24,10 +24,15 @@ func resourceTaskRouterWorkflow() *schema.Resource {
 		DeleteContext: resourceTaskRouterWorkflowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Workspaces/(.*)/Workflows/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 3 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -47,6 +52,15 @@ func resourceTaskRouterWorkflow() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceTaskRouterWorkflowV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: utils.NormalizeJSONStateUpgradeFunc("configuration"),
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"sid": {
 				Type:     schema.TypeString,
@@ -92,6 +106,7 @@ func resourceTaskRouterWorkflow() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc:        utils.NormalizeJSON,
 			},
 			"date_created": {
 				Type:     schema.TypeString,
@@ -124,7 +139,7 @@ func resourceTaskRouterWorkflowCreate(ctx context.Context, d *schema.ResourceDat
 
 	createResult, err := client.Workspace(d.Get("workspace_sid").(string)).Workflows.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create workflow: %s", err.Error())
+		return utils.TranslateError("Failed to create workflow", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -140,7 +155,7 @@ func resourceTaskRouterWorkflowRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read workflow: %s", err.Error())
+		return utils.TranslateError("Failed to read workflow", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -151,7 +166,7 @@ func resourceTaskRouterWorkflowRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("assignment_callback_url", getResponse.AssignmentCallbackURL)
 	d.Set("task_reservation_timeout", getResponse.TaskReservationTimeout)
 	d.Set("document_content_type", getResponse.DocumentContentType)
-	d.Set("configuration", getResponse.Configuration)
+	d.Set("configuration", utils.NormalizeJSON(getResponse.Configuration))
 	d.Set("date_created", getResponse.DateCreated.Format(time.RFC3339))
 
 	if getResponse.DateUpdated != nil {
@@ -176,7 +191,7 @@ func resourceTaskRouterWorkflowUpdate(ctx context.Context, d *schema.ResourceDat
 
 	updateResp, err := client.Workspace(d.Get("workspace_sid").(string)).Workflow(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update workflow: %s", err.Error())
+		return utils.TranslateError("Failed to update workflow", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -187,7 +202,7 @@ func resourceTaskRouterWorkflowDelete(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*common.TwilioClient).TaskRouter
 
 	if err := client.Workspace(d.Get("workspace_sid").(string)).Workflow(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete workflow: %s", err.Error())
+		return utils.TranslateError("Failed to delete workflow", err)
 	}
 	d.SetId("")
 	return nil
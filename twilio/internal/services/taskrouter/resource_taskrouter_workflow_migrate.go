@@ -0,0 +1,72 @@
+package taskrouter
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceTaskRouterWorkflowV0 is the schema of the taskrouter workflow resource at version 0. The schema must not be changed as it is used to decode the existing state before it is upgraded
+func resourceTaskRouterWorkflowV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_sid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fallback_assignment_callback_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"assignment_callback_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"task_reservation_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  120,
+			},
+			"document_content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
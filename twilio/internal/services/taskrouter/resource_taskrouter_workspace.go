@@ -26,10 +26,15 @@ func resourceTaskRouterWorkspace() *schema.Resource {
 		DeleteContext: resourceTaskRouterWorkspaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				importID, err := resolveImportID(ctx, meta, d.Id())
+				if err != nil {
+					return nil, err
+				}
+
 				format := "/Workspaces/(.*)"
 				regex := regexp.MustCompile(format)
-				match := regex.FindStringSubmatch(d.Id())
+				match := regex.FindStringSubmatch(importID)
 
 				if len(match) != 2 {
 					return nil, fmt.Errorf("The imported ID (%s) does not match the format (%s)", d.Id(), format)
@@ -169,7 +174,7 @@ func resourceTaskRouterWorkspaceCreate(ctx context.Context, d *schema.ResourceDa
 
 	createResult, err := client.Workspaces.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create taskrouter workspace: %s", err.Error())
+		return utils.TranslateError("Failed to create taskrouter workspace", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -185,7 +190,7 @@ func resourceTaskRouterWorkspaceRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read taskrouter workspace: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter workspace", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -227,7 +232,7 @@ func resourceTaskRouterWorkspaceUpdate(ctx context.Context, d *schema.ResourceDa
 
 	updateResp, err := client.Workspace(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update taskrouter workspace: %s", err.Error())
+		return utils.TranslateError("Failed to update taskrouter workspace", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -238,7 +243,7 @@ func resourceTaskRouterWorkspaceDelete(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*common.TwilioClient).TaskRouter
 
 	if err := client.Workspace(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete taskrouter workspace: %s", err.Error())
+		return utils.TranslateError("Failed to delete taskrouter workspace", err)
 	}
 	d.SetId("")
 	return nil
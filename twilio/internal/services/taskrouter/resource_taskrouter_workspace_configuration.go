@@ -70,7 +70,7 @@ func resourceTaskRouterWorkspaceConfigurationRead(ctx context.Context, d *schema
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read taskrouter workspace configuration: %s", err.Error())
+		return utils.TranslateError("Failed to read taskrouter workspace configuration", err)
 	}
 
 	d.Set("default_activity_name", getResponse.DefaultActivityName)
@@ -91,7 +91,7 @@ func resourceTaskRouterWorkspaceConfigurationUpdate(ctx context.Context, d *sche
 
 	updateResp, err := client.Workspace(d.Get("workspace_sid").(string)).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update taskrouter workspace configuration: %s", err.Error())
+		return utils.TranslateError("Failed to update taskrouter workspace configuration", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ const activitiesDataSourceName = "twilio_taskrouter_activities"
 
 func TestAccDataSourceTwilioTaskRouterActivities_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.activities", activitiesDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	// Twilio creates multiple activities when a workspace is created, so can't guarantee the order
 	resource.ParallelTest(t, resource.TestCase{
@@ -36,7 +35,7 @@ func TestAccDataSourceTwilioTaskRouterActivities_basic(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterActivities_withAvailable(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.activities", activitiesDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	// Twilio creates multiple activities when a workspace is created, so can't guarantee the order
 	resource.ParallelTest(t, resource.TestCase{
@@ -64,7 +63,7 @@ func TestAccDataSourceTwilioTaskRouterActivities_withAvailable(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterActivities_withFriendlyName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.activities", activitiesDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,8 +13,8 @@ const taskChannelDataSourceName = "twilio_taskrouter_task_channel"
 
 func TestAccDataSourceTwilioTaskRouterTaskChannel_sid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_channel", taskChannelDataSourceName)
-	friendlyName := acctest.RandString(10)
-	uniqueName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -42,8 +41,8 @@ func TestAccDataSourceTwilioTaskRouterTaskChannel_sid(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterTaskChannel_uniqueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_channel", taskChannelDataSourceName)
-	friendlyName := acctest.RandString(10)
-	uniqueName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
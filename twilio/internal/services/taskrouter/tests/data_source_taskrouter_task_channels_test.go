@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,8 +13,8 @@ const taskChannelsDataSourceName = "twilio_taskrouter_task_channels"
 
 func TestAccDataSourceTwilioTaskRouterTaskChannels_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.task_channels", taskChannelsDataSourceName)
-	friendlyName := acctest.RandString(10)
-	uniqueName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	uniqueName := acceptance.RandomName()
 
 	// Twilio creates multiple task channels when a workspace is created, so can't guarantee the order
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ const workersDataSourceName = "twilio_taskrouter_workers"
 
 func TestAccDataSourceTwilioTaskRouterWorkers_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -35,7 +34,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_basic(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withActivityName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -66,7 +65,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_withActivityName(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withActivitySid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -97,7 +96,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_withActivitySid(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withAvailable(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -138,7 +137,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_withAvailable(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withFriendlyName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -169,7 +168,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_withFriendlyName(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withTargetWorkerExpression(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -200,7 +199,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_withTargetWorkerExpression(t *test
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withTaskQueueName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -231,7 +230,7 @@ func TestAccDataSourceTwilioTaskRouterWorkers_withTaskQueueName(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkers_withTaskQueueSid(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workers", workersDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
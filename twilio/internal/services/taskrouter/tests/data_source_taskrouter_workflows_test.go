@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ const workflowsDataSourceName = "twilio_taskrouter_workflows"
 
 func TestAccDataSourceTwilioTaskRouterWorkflows_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workflows", workflowsDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -35,7 +34,7 @@ func TestAccDataSourceTwilioTaskRouterWorkflows_basic(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkflows_withFriendlyName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workflows", workflowsDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ const workspacesDataSourceName = "twilio_taskrouter_workspaces"
 
 func TestAccDataSourceTwilioTaskRouterWorkspaces_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workspaces", workspacesDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	queueOrder := "FIFO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccDataSourceTwilioTaskRouterWorkspaces_basic(t *testing.T) {
 
 func TestAccDataSourceTwilioTaskRouterWorkspaces_withFriendlyName(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.workspaces", workspacesDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	queueOrder := "FIFO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioTaskRouterActivity_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.activity", activityResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -55,8 +54,8 @@ func TestAccTwilioTaskRouterActivity_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.activity", activityResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -100,7 +99,7 @@ func TestAccTwilioTaskRouterActivity_update(t *testing.T) {
 func TestAccTwilioTaskRouterActivity_available(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.activity", activityResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
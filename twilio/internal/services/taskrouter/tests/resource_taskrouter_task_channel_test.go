@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,8 +18,8 @@ func TestAccTwilioTaskRouterTaskChannel_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_channel", taskChannelResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
-	uniqueName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -57,9 +56,9 @@ func TestAccTwilioTaskRouterTaskChannel_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_channel", taskChannelResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
-	uniqueName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
+	uniqueName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioTaskRouterTaskQueue_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -61,8 +60,8 @@ func TestAccTwilioTaskRouterTaskQueue_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -131,7 +130,7 @@ func TestAccTwilioTaskRouterTaskQueue_invalidWorkspaceSid(t *testing.T) {
 func TestAccTwilioTaskRouterTaskQueue_maxReservedWorkers(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	newTaskOrder := "LIFO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -165,7 +164,7 @@ func TestAccTwilioTaskRouterTaskQueue_maxReservedWorkers(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterTaskQueue_invalidMaxReservedWorkersOf0(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	maxReservedWorkers := 0
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -181,7 +180,7 @@ func TestAccTwilioTaskRouterTaskQueue_invalidMaxReservedWorkersOf0(t *testing.T)
 }
 
 func TestAccTwilioTaskRouterTaskQueue_invalidMaxReservedWorkersOf51(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	maxReservedWorkers := 51
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -199,7 +198,7 @@ func TestAccTwilioTaskRouterTaskQueue_invalidMaxReservedWorkersOf51(t *testing.T
 func TestAccTwilioTaskRouterTaskQueue_targetWorkers(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	newTargetWorkers := `(languages HAS 'english')`
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -235,7 +234,7 @@ func TestAccTwilioTaskRouterTaskQueue_targetWorkers(t *testing.T) {
 func TestAccTwilioTaskRouterTaskQueue_taskOrder(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	newMaxReservedWorkers := 10
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -269,7 +268,7 @@ func TestAccTwilioTaskRouterTaskQueue_taskOrder(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterTaskQueue_invalidTaskOrder(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	taskOrder := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -288,7 +287,7 @@ func TestAccTwilioTaskRouterTaskQueue_assignmentActivitySid(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 	activityStateResourceName := "twilio_taskrouter_activity.activity"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -344,7 +343,7 @@ func TestAccTwilioTaskRouterTaskQueue_reservationActivitySid(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.task_queue", taskQueueResourceName)
 	activityStateResourceName := "twilio_taskrouter_activity.activity"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioTaskRouterWorker_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.worker", workerResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -59,8 +58,8 @@ func TestAccTwilioTaskRouterWorker_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.worker", workerResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -114,7 +113,7 @@ func TestAccTwilioTaskRouterWorker_customActivity(t *testing.T) {
 	activityStateResourceName := "twilio_taskrouter_activity.activity"
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestTwilioTaskRouterWorkflow_stateUpgradeV0(t *testing.T) {
+	v0State := map[string]interface{}{
+		"id":                       "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"sid":                      "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"workspace_sid":            "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"friendly_name":            "tf-acc-workflow",
+		"task_reservation_timeout": 120,
+		"configuration":            "{\n  \"task_routing\": {\n    \"filters\": [],\n    \"default_filter\": {\n      \"queue\": \"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\"\n    }\n  }\n}",
+	}
+
+	expected := map[string]interface{}{
+		"id":                       "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"sid":                      "WWaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"workspace_sid":            "WSaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"friendly_name":            "tf-acc-workflow",
+		"task_reservation_timeout": 120,
+		"configuration":            `{"task_routing":{"default_filter":{"queue":"WQaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},"filters":[]}}`,
+	}
+
+	acceptance.CheckUpgradedState(t, workflowResourceName, 0, v0State, expected)
+}
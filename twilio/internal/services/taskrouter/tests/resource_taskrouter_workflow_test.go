@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ func TestAccTwilioTaskRouterWorkflow_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workflow", workflowResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -51,6 +50,12 @@ func TestAccTwilioTaskRouterWorkflow_basic(t *testing.T) {
 				ImportStateIdFunc: testAccTwilioTaskRouterWorkflowImportStateIdFunc(stateResourceName),
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      stateResourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("taskrouter:%s/workflows/%s", friendlyName, friendlyName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -59,8 +64,8 @@ func TestAccTwilioTaskRouterWorkflow_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workflow", workflowResourceName)
 	workspaceStateResourceName := "twilio_taskrouter_workspace.workspace"
 
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -112,7 +117,7 @@ func TestAccTwilioTaskRouterWorkflow_update(t *testing.T) {
 func TestAccTwilioTaskRouterWorkflow_assignmentCallback(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workflow", workflowResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	callbackURL := "https://test.com/callback"
 	fallbackCallbackURL := "https://test.com/fallback-callback"
 
@@ -142,7 +147,7 @@ func TestAccTwilioTaskRouterWorkflow_assignmentCallback(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterWorkflow_invalidAssignmentCallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	callbackURL := "callback"
 	fallbackCallbackURL := "https://test.com/fallback-callback"
 
@@ -159,7 +164,7 @@ func TestAccTwilioTaskRouterWorkflow_invalidAssignmentCallbackURL(t *testing.T)
 }
 
 func TestAccTwilioTaskRouterWorkflow_invalidAssignmentFallbackCallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	callbackURL := "https://test.com/callback"
 	fallbackCallbackURL := "fallback-callback"
 
@@ -178,7 +183,7 @@ func TestAccTwilioTaskRouterWorkflow_invalidAssignmentFallbackCallbackURL(t *tes
 func TestAccTwilioTaskRouterWorkflow_taskReservationTimeout(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workflow", workflowResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	taskReservationTimeout := 1
 	newTaskReservationTimeout := 86400
 
@@ -215,7 +220,7 @@ func TestAccTwilioTaskRouterWorkflow_taskReservationTimeout(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterWorkflow_invalidTaskReservationTimeoutOf0(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	taskReservationTimeout := 0
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -231,7 +236,7 @@ func TestAccTwilioTaskRouterWorkflow_invalidTaskReservationTimeoutOf0(t *testing
 }
 
 func TestAccTwilioTaskRouterWorkflow_invalidTaskReservationTimeoutOf86401(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	taskReservationTimeout := 86401
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -18,7 +17,7 @@ const workspaceConfigurationResourceName = "twilio_taskrouter_workspace_configur
 func TestAccTwilioTaskRouterWorkspaceConfiguration_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace_configuration", workspaceConfigurationResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -84,7 +83,7 @@ func TestAccTwilioTaskRouterWorkspaceConfiguration_invalidTimeoutActivitySid(t *
 func TestAccTwilioTaskRouterWorkspaceConfiguration_withDefaultActivitySid(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace_configuration", workspaceConfigurationResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -128,7 +127,7 @@ func TestAccTwilioTaskRouterWorkspaceConfiguration_withDefaultActivitySid(t *tes
 func TestAccTwilioTaskRouterWorkspaceConfiguration_withTimeoutActivitySid(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace_configuration", workspaceConfigurationResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
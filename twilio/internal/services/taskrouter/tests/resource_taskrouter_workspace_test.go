@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -19,7 +18,7 @@ const workspaceResourceName = "twilio_taskrouter_workspace"
 func TestAccTwilioTaskRouterWorkspace_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace", workspaceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	queueOrder := "FIFO"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -60,7 +59,7 @@ func TestAccTwilioTaskRouterWorkspace_basic(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterWorkspace_invalidOrderQueue(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	queueOrder := "test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -79,7 +78,7 @@ func TestAccTwilioTaskRouterWorkspace_invalidOrderQueue(t *testing.T) {
 func TestAccTwilioTaskRouterWorkspace_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace", workspaceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	queueOrder := "FIFO"
 	newQueueOrder := "LIFO"
 
@@ -137,7 +136,7 @@ func TestAccTwilioTaskRouterWorkspace_update(t *testing.T) {
 func TestAccTwilioTaskRouterWorkspace_eventCallback(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace", workspaceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	eventFilters := []string{"task.created", "task.canceled"}
 	callbackURL := "https://test.com/callback"
 
@@ -163,7 +162,7 @@ func TestAccTwilioTaskRouterWorkspace_eventCallback(t *testing.T) {
 func TestAccTwilioTaskRouterWorkspace_updateEventCallbackToEmptyList(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace", workspaceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	eventFilters := []string{"task.created", "task.canceled"}
 	callbackURL := "https://test.com/callback"
 
@@ -198,7 +197,7 @@ func TestAccTwilioTaskRouterWorkspace_updateEventCallbackToEmptyList(t *testing.
 func TestAccTwilioTaskRouterWorkspace_updateEventCallbackToUnsetTheCallbackURL(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace", workspaceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	eventFilters := []string{"task.created", "task.canceled"}
 	callbackURL := "https://test.com/callback"
 
@@ -235,7 +234,7 @@ func TestAccTwilioTaskRouterWorkspace_updateEventCallbackToUnsetTheCallbackURL(t
 func TestAccTwilioTaskRouterWorkspace_removeEventCallback(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.workspace", workspaceResourceName)
 
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	eventFilters := []string{"task.created", "task.canceled"}
 	callbackURL := "https://test.com/callback"
 	queueOrder := "FIFO"
@@ -269,7 +268,7 @@ func TestAccTwilioTaskRouterWorkspace_removeEventCallback(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterWorkspace_invalidEventFilters(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	eventFilters := []string{"test"}
 	callbackURL := "https://test.com/callback"
 
@@ -286,7 +285,7 @@ func TestAccTwilioTaskRouterWorkspace_invalidEventFilters(t *testing.T) {
 }
 
 func TestAccTwilioTaskRouterWorkspace_invalidEventCallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	eventFilters := []string{"task.created", "task.canceled"}
 	callbackURL := "callback"
 
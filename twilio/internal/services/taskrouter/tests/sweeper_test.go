@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_taskrouter_workspace", &resource.Sweeper{
+		Name: "twilio_taskrouter_workspace",
+		F:    sweepTaskRouterWorkspaces,
+	})
+}
+
+func sweepTaskRouterWorkspaces(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.TaskRouter
+
+	ctx := context.Background()
+	paginator := client.Workspaces.NewWorkspacesPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list taskrouter workspaces: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Workspaces {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("taskrouter workspace", item.Sid, item.FriendlyName)
+		if err := client.Workspace(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("taskrouter workspace", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
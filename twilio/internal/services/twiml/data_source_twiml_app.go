@@ -27,7 +27,8 @@ func dataSourceTwimlApp() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"friendly_name": {
@@ -113,13 +114,13 @@ func dataSourceTwimlApp() *schema.Resource {
 func dataSourceTwimlAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Application(d.Get("sid").(string)).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Application(d.Get("sid").(string)).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read application: %s", err.Error())
+		return utils.TranslateError("Failed to read application", err)
 	}
 
 	d.SetId(getResponse.Sid)
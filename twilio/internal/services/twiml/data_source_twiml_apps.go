@@ -19,10 +19,11 @@ func dataSourceTwimlApps() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("apps", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"friendly_name": {
@@ -117,7 +118,7 @@ func dataSourceTwimlApps() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
@@ -128,7 +129,7 @@ func dataSourceTwimlAppsRead(ctx context.Context, d *schema.ResourceData, meta i
 		FriendlyName: utils.OptionalString(d, "friendly_name"),
 	}
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	paginator := client.Account(accountSid).Applications.NewApplicationsPaginatorWithOptions(options)
 	for paginator.NextWithContext(ctx) {
 	}
@@ -136,7 +137,7 @@ func dataSourceTwimlAppsRead(ctx context.Context, d *schema.ResourceData, meta i
 	err := paginator.Error()
 	if err != nil {
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to list apps: %s", err.Error())
+		return utils.TranslateError("Failed to list apps", err)
 	}
 
 	d.SetId(accountSid)
@@ -178,7 +179,11 @@ func dataSourceTwimlAppsRead(ctx context.Context, d *schema.ResourceData, meta i
 		apps = append(apps, appMap)
 	}
 
-	d.Set("apps", &apps)
+	filteredApps, err := utils.FilterListItems(d, apps)
+	if err != nil {
+		return diag.Errorf("Failed to filter apps: %s", err.Error())
+	}
+	d.Set("apps", &filteredApps)
 
 	return nil
 }
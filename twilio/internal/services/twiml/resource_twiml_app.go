@@ -55,7 +55,8 @@ func resourceTwimlApp() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -200,9 +201,9 @@ func resourceTwimlAppCreate(ctx context.Context, d *schema.ResourceData, meta in
 		createInput.StatusCallbackMethod = utils.OptionalStringWithEmptyStringOnChange(d, "voice.0.status_callback_method")
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Applications.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Applications.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create application: %s", err.Error())
+		return utils.TranslateError("Failed to create application", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -212,13 +213,13 @@ func resourceTwimlAppCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceTwimlAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Application(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Application(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read application: %s", err.Error())
+		return utils.TranslateError("Failed to read application", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -266,9 +267,9 @@ func resourceTwimlAppUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		updateInput.StatusCallbackMethod = utils.OptionalStringWithEmptyStringOnChange(d, "voice.0.status_callback_method")
 	}
 
-	updateResp, err := client.Account(d.Get("account_sid").(string)).Application(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResp, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Application(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update application: %s", err.Error())
+		return utils.TranslateError("Failed to update application", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -278,8 +279,8 @@ func resourceTwimlAppUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceTwimlAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Application(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete application: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Application(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete application", err)
 	}
 	d.SetId("")
 	return nil
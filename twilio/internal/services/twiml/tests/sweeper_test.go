@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_twiml_app", &resource.Sweeper{
+		Name: "twilio_twiml_app",
+		F:    sweepTwiMLApps,
+	})
+}
+
+func sweepTwiMLApps(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.API
+	accountSid := sweeperClient.DefaultAccountSid()
+
+	ctx := context.Background()
+	paginator := client.Account(accountSid).Applications.NewApplicationsPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list TwiML apps: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.Applications {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("TwiML app", item.Sid, item.FriendlyName)
+		if err := client.Account(accountSid).Application(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("TwiML app", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -100,7 +100,7 @@ func dataSourceVideoCompositionHookRead(ctx context.Context, d *schema.ResourceD
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Composition hook with sid (%s) was not found ", sid)
 		}
-		return diag.Errorf("Failed to read composition hook: %s", err.Error())
+		return utils.TranslateError("Failed to read composition hook", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -63,7 +63,7 @@ func dataSourceVideoCompositionSettingsRead(ctx context.Context, d *schema.Resou
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Video composition settings was not found")
 		}
-		return diag.Errorf("Failed to read composition settings: %s", err.Error())
+		return utils.TranslateError("Failed to read composition settings", err)
 	}
 
 	d.SetId(getResponse.AccountSid)
@@ -63,7 +63,7 @@ func dataSourceVideoRecordingSettingsRead(ctx context.Context, d *schema.Resourc
 		if utils.IsNotFoundError(err) {
 			return diag.Errorf("Video recording settings was not found")
 		}
-		return diag.Errorf("Failed to read recording settings: %s", err.Error())
+		return utils.TranslateError("Failed to read recording settings", err)
 	}
 
 	d.SetId(getResponse.AccountSid)
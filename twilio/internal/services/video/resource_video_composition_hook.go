@@ -47,6 +47,15 @@ func resourceVideoCompositionHook() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceVideoCompositionHookV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: utils.NormalizeJSONStateUpgradeFunc("video_layout"),
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"sid": {
 				Type:     schema.TypeString,
@@ -120,6 +129,7 @@ func resourceVideoCompositionHook() *schema.Resource {
 				Default:          "{}",
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc:        utils.NormalizeJSON,
 			},
 			"date_created": {
 				Type:     schema.TypeString,
@@ -155,7 +165,7 @@ func resourceVideoCompositionHookCreate(ctx context.Context, d *schema.ResourceD
 
 	createResult, err := client.CompositionHooks.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create composition hook: %s", err.Error())
+		return utils.TranslateError("Failed to create composition hook", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -171,7 +181,7 @@ func resourceVideoCompositionHookRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read composition hook: %s", err.Error())
+		return utils.TranslateError("Failed to read composition hook", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -219,7 +229,7 @@ func resourceVideoCompositionHookUpdate(ctx context.Context, d *schema.ResourceD
 
 	updateResp, err := client.CompositionHook(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update composition hook: %s", err.Error())
+		return utils.TranslateError("Failed to update composition hook", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -230,7 +240,7 @@ func resourceVideoCompositionHookDelete(ctx context.Context, d *schema.ResourceD
 	client := meta.(*common.TwilioClient).Video
 
 	if err := client.CompositionHook(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete composition hook: %s", err.Error())
+		return utils.TranslateError("Failed to delete composition hook", err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,94 @@
+package video
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceVideoCompositionHookV0 is the schema of the video composition hook resource at version 0. The schema must not be changed as it is used to decode the existing state before it is upgraded
+func resourceVideoCompositionHookV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"audio_sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"audio_sources_excluded": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "webm",
+			},
+			"resolution": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "640x480",
+			},
+			"status_callback_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status_callback_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "POST",
+			},
+			"trim": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"video_layout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "{}",
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -83,7 +83,7 @@ func resourceVideoCompositionSettingsRead(ctx context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read composition settings: %s", err.Error())
+		return utils.TranslateError("Failed to read composition settings", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -112,7 +112,7 @@ func resourceVideoCompositionSettingsUpdate(ctx context.Context, d *schema.Resou
 
 	updateResp, err := client.CompositionSettings().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update composition settings: %s", err.Error())
+		return utils.TranslateError("Failed to update composition settings", err)
 	}
 
 	d.SetId(updateResp.AccountSid)
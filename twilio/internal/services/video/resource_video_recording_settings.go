@@ -82,7 +82,7 @@ func resourceVideoRecordingSettingsRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Failed to read recording settings: %s", err.Error())
+		return utils.TranslateError("Failed to read recording settings", err)
 	}
 
 	d.Set("account_sid", getResponse.AccountSid)
@@ -111,7 +111,7 @@ func resourceVideoRecordingSettingsUpdate(ctx context.Context, d *schema.Resourc
 
 	updateResp, err := client.RecordingSettings().UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update recording settings: %s", err.Error())
+		return utils.TranslateError("Failed to update recording settings", err)
 	}
 
 	d.SetId(updateResp.AccountSid)
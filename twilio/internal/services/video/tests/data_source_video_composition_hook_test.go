@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
 )
@@ -14,7 +13,7 @@ var compositionHookDataSourceName = "twilio_video_composition_hook"
 
 func TestAccDataSourceTwilioVideoCompositionHook_basic(t *testing.T) {
 	stateDataSourceName := fmt.Sprintf("data.%s.composition_hook", compositionHookDataSourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestTwilioVideoCompositionHook_stateUpgradeV0(t *testing.T) {
+	v0State := map[string]interface{}{
+		"id":            "HKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"sid":           "HKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"friendly_name": "tf-acc-composition-hook",
+		"audio_sources": []interface{}{"*"},
+		"video_layout":  "{\n  \"grid\": {\n    \"video_sources\": [\"*\"]\n  }\n}",
+	}
+
+	expected := map[string]interface{}{
+		"id":            "HKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"sid":           "HKaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"friendly_name": "tf-acc-composition-hook",
+		"audio_sources": []interface{}{"*"},
+		"video_layout":  `{"grid":{"video_sources":["*"]}}`,
+	}
+
+	acceptance.CheckUpgradedState(t, compositionHookResourceName, 0, v0State, expected)
+}
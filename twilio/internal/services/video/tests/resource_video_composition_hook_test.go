@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
@@ -17,7 +16,7 @@ var compositionHookResourceName = "twilio_video_composition_hook"
 
 func TestAccTwilioVideoCompositionHook_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -60,8 +59,8 @@ func TestAccTwilioVideoCompositionHook_basic(t *testing.T) {
 
 func TestAccTwilioVideoCompositionHook_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -121,7 +120,7 @@ func TestAccTwilioVideoCompositionHook_update(t *testing.T) {
 
 func TestAccTwilioVideoCompositionHook_videoLayout(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -148,7 +147,7 @@ func TestAccTwilioVideoCompositionHook_videoLayout(t *testing.T) {
 }
 
 func TestAccTwilioVideoCompositionHook_invalidVideoLayout(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -166,7 +165,7 @@ func TestAccTwilioVideoCompositionHook_invalidVideoLayout(t *testing.T) {
 
 func TestAccTwilioVideoCompositionHook_format(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	newFormat := "mp4"
 
@@ -201,7 +200,7 @@ func TestAccTwilioVideoCompositionHook_format(t *testing.T) {
 }
 
 func TestAccTwilioVideoCompositionHook_invalidFormat(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	format := "test"
 
@@ -220,7 +219,7 @@ func TestAccTwilioVideoCompositionHook_invalidFormat(t *testing.T) {
 
 func TestAccTwilioVideoCompositionHook_resolution(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	newResolution := "1080x720"
 
@@ -255,7 +254,7 @@ func TestAccTwilioVideoCompositionHook_resolution(t *testing.T) {
 }
 
 func TestAccTwilioVideoCompositionHook_invalidResolution(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	resolution := "HD"
 
@@ -274,7 +273,7 @@ func TestAccTwilioVideoCompositionHook_invalidResolution(t *testing.T) {
 
 func TestAccTwilioVideoCompositionHook_statusCallback(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	callbackURL := "https://test.com/callback"
 	callbackMethod := "POST"
@@ -313,7 +312,7 @@ func TestAccTwilioVideoCompositionHook_statusCallback(t *testing.T) {
 }
 
 func TestAccTwilioVideoCompositionHook_invalidStatusCallbackURL(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	callbackURL := "test"
 	callbackMethod := "POST"
@@ -332,7 +331,7 @@ func TestAccTwilioVideoCompositionHook_invalidStatusCallbackURL(t *testing.T) {
 }
 
 func TestAccTwilioVideoCompositionHook_invalidStatusCallbackMethod(t *testing.T) {
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	callbackURL := "https://test.com/callback"
 	callbackMethod := "test"
@@ -352,7 +351,7 @@ func TestAccTwilioVideoCompositionHook_invalidStatusCallbackMethod(t *testing.T)
 
 func TestAccTwilioVideoCompositionHook_enabled(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -387,7 +386,7 @@ func TestAccTwilioVideoCompositionHook_enabled(t *testing.T) {
 
 func TestAccTwilioVideoCompositionHook_swapAudioSourcesForVideoLayout(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -418,7 +417,7 @@ func TestAccTwilioVideoCompositionHook_swapAudioSourcesForVideoLayout(t *testing
 
 func TestAccTwilioVideoCompositionHook_swapAudioSourcesExcludedForVideoLayout(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.composition_hook", compositionHookResourceName)
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	audioSource := "*"
 	excludedAudioSource := "test"
 
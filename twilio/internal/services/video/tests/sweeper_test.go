@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/timworks/terraform-provider-twilio/twilio/internal/acceptance"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("twilio_video_composition_hook", &resource.Sweeper{
+		Name: "twilio_video_composition_hook",
+		F:    sweepVideoCompositionHooks,
+	})
+}
+
+func sweepVideoCompositionHooks(region string) error {
+	sweeperClient, err := acceptance.SweeperClient(region)
+	if err != nil {
+		return err
+	}
+	client := sweeperClient.Video
+
+	ctx := context.Background()
+	paginator := client.CompositionHooks.NewCompositionHooksPaginator()
+	for paginator.NextWithContext(ctx) {
+	}
+	if err := paginator.Error(); err != nil {
+		return fmt.Errorf("Failed to list video composition hooks: %s", err.Error())
+	}
+
+	var errs acceptance.SweepErrors
+	for _, item := range paginator.CompositionHooks {
+		if !acceptance.HasResourcePrefix(item.FriendlyName) {
+			continue
+		}
+
+		acceptance.LogSweep("video composition hook", item.Sid, item.FriendlyName)
+		if err := client.CompositionHook(item.Sid).DeleteWithContext(ctx); err != nil {
+			errs.Add("video composition hook", item.Sid, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -26,7 +26,8 @@ func dataSourceVoiceQueue() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"friendly_name": {
@@ -60,7 +61,7 @@ func dataSourceVoiceQueue() *schema.Resource {
 func dataSourceVoiceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	sid := d.Get("sid").(string)
 	getResponse, err := client.Account(accountSid).Queue(sid).FetchWithContext(ctx)
 	if err != nil {
@@ -68,7 +69,7 @@ func dataSourceVoiceQueueRead(ctx context.Context, d *schema.ResourceData, meta
 			return diag.Errorf("Queue with sid (%s) was not found in account (%s)", sid, accountSid)
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to read queue: %s", err.Error())
+		return utils.TranslateError("Failed to read queue", err)
 	}
 
 	d.SetId(getResponse.Sid)
@@ -18,10 +18,11 @@ func dataSourceVoiceQueues() *schema.Resource {
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: utils.WithListFilters("queues", map[string]*schema.Schema{
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
 			"queues": {
@@ -60,14 +61,14 @@ func dataSourceVoiceQueues() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceVoiceQueuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	accountSid := d.Get("account_sid").(string)
+	accountSid := meta.(*common.TwilioClient).ResolveAccountSid(d)
 	paginator := client.Account(accountSid).Queues.NewQueuesPaginator()
 	for paginator.NextWithContext(ctx) {
 	}
@@ -75,7 +76,7 @@ func dataSourceVoiceQueuesRead(ctx context.Context, d *schema.ResourceData, meta
 	err := paginator.Error()
 	if err != nil {
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to list queues: %s", err.Error())
+		return utils.TranslateError("Failed to list queues", err)
 	}
 
 	d.SetId(accountSid)
@@ -100,7 +101,11 @@ func dataSourceVoiceQueuesRead(ctx context.Context, d *schema.ResourceData, meta
 		queues = append(queues, queueMap)
 	}
 
-	d.Set("queues", &queues)
+	filteredQueues, err := utils.FilterListItems(d, queues)
+	if err != nil {
+		return diag.Errorf("Failed to filter queues: %s", err.Error())
+	}
+	d.Set("queues", &filteredQueues)
 
 	return nil
 }
@@ -53,7 +53,8 @@ func resourceVoiceQueue() *schema.Resource {
 			},
 			"account_sid": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: utils.AccountSidValidation(),
 			},
@@ -96,9 +97,9 @@ func resourceVoiceQueueCreate(ctx context.Context, d *schema.ResourceData, meta
 		MaxSize:      utils.OptionalInt(d, "max_size"),
 	}
 
-	createResult, err := client.Account(d.Get("account_sid").(string)).Queues.CreateWithContext(ctx, createInput)
+	createResult, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Queues.CreateWithContext(ctx, createInput)
 	if err != nil {
-		return diag.Errorf("Failed to create queue: %s", err.Error())
+		return utils.TranslateError("Failed to create queue", err)
 	}
 
 	d.SetId(createResult.Sid)
@@ -108,14 +109,14 @@ func resourceVoiceQueueCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceVoiceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	getResponse, err := client.Account(d.Get("account_sid").(string)).Queue(d.Id()).FetchWithContext(ctx)
+	getResponse, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Queue(d.Id()).FetchWithContext(ctx)
 	if err != nil {
 		if utils.IsNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
 		// If the account sid is incorrect a 401 is returned, a this is a generic error this will not be handled here and an error will be returned
-		return diag.Errorf("Failed to read queue: %s", err.Error())
+		return utils.TranslateError("Failed to read queue", err)
 	}
 
 	d.Set("sid", getResponse.Sid)
@@ -141,9 +142,9 @@ func resourceVoiceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta
 		MaxSize:      utils.OptionalInt(d, "max_size"),
 	}
 
-	updateResp, err := client.Account(d.Get("account_sid").(string)).Queue(d.Id()).UpdateWithContext(ctx, updateInput)
+	updateResp, err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Queue(d.Id()).UpdateWithContext(ctx, updateInput)
 	if err != nil {
-		return diag.Errorf("Failed to update queue: %s", err.Error())
+		return utils.TranslateError("Failed to update queue", err)
 	}
 
 	d.SetId(updateResp.Sid)
@@ -153,8 +154,8 @@ func resourceVoiceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceVoiceQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.TwilioClient).API
 
-	if err := client.Account(d.Get("account_sid").(string)).Queue(d.Id()).DeleteWithContext(ctx); err != nil {
-		return diag.Errorf("Failed to delete queue: %s", err.Error())
+	if err := client.Account(meta.(*common.TwilioClient).ResolveAccountSid(d)).Queue(d.Id()).DeleteWithContext(ctx); err != nil {
+		return utils.TranslateError("Failed to delete queue", err)
 	}
 
 	d.SetId("")
@@ -19,7 +19,7 @@ func TestAccTwilioAccountQueue_basic(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.queue", queueResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -51,12 +51,35 @@ func TestAccTwilioAccountQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccTwilioAccountQueue_defaultAccountSid(t *testing.T) {
+	stateResourceName := fmt.Sprintf("%s.queue", queueResourceName)
+
+	testData := acceptance.TestAccData
+	friendlyName := acceptance.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.PreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckTwilioAccountQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTwilioAccountQueue_defaultAccountSid(friendlyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTwilioAccountQueueExists(stateResourceName),
+					resource.TestCheckResourceAttr(stateResourceName, "friendly_name", friendlyName),
+					resource.TestCheckResourceAttr(stateResourceName, "account_sid", testData.AccountSid),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTwilioAccountQueue_update(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.queue", queueResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
-	newFriendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
+	newFriendlyName := acceptance.RandomName()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.PreCheck(t) },
@@ -101,7 +124,7 @@ func TestAccTwilioAccountQueue_maxSize(t *testing.T) {
 	stateResourceName := fmt.Sprintf("%s.queue", queueResourceName)
 
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	maxSize := 1
 	newMaxSize := 5000
 
@@ -144,7 +167,7 @@ func TestAccTwilioAccountQueue_maxSize(t *testing.T) {
 
 func TestAccTwilioAccountQueue_invalidMaxSizeOf0(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	maxSize := 0
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -161,7 +184,7 @@ func TestAccTwilioAccountQueue_invalidMaxSizeOf0(t *testing.T) {
 
 func TestAccTwilioAccountQueue_invalidMaxSizeOf10(t *testing.T) {
 	testData := acceptance.TestAccData
-	friendlyName := acctest.RandString(10)
+	friendlyName := acceptance.RandomName()
 	maxSize := 5001
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -308,6 +331,14 @@ resource "twilio_voice_queue" "queue" {
 `, testData.AccountSid, friendlyName)
 }
 
+func testAccTwilioAccountQueue_defaultAccountSid(friendlyName string) string {
+	return fmt.Sprintf(`
+resource "twilio_voice_queue" "queue" {
+  friendly_name = "%s"
+}
+`, friendlyName)
+}
+
 func testAccTwilioAccountQueue_maxSize(testData *acceptance.TestData, friendlyName string, maxSize int) string {
 	return fmt.Sprintf(`
 resource "twilio_voice_queue" "queue" {
@@ -7,9 +7,23 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/timworks/terraform-provider-twilio/twilio/utils"
 )
 
+// Provider returns the provider for development builds, release builds use New so the version can be included in the User-Agent
 func Provider() *schema.Provider {
+	return New("dev")()
+}
+
+// New returns a function which creates the provider with the supplied version
+func New(version string) func() *schema.Provider {
+	return func() *schema.Provider {
+		return newProvider(version)
+	}
+}
+
+func newProvider(version string) *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
 
@@ -27,6 +41,13 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("TWILIO_ACCOUNT_SID", nil),
 				Description: "The Account SID which should be used.",
 			},
+			"subaccount_sid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TWILIO_SUBACCOUNT_SID", nil),
+				Description:  "The SID of a subaccount which API v2010 resources should be managed in by default, using the credentials of the parent account.",
+				ValidateFunc: utils.AccountSidValidation(),
+			},
 			"auth_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -47,6 +68,18 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("TWILIO_API_SECRET", nil),
 				Description: "The API Key secret which should be used.",
 			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_PROFILE", nil),
+				Description: "The name of the profile in the shared config file or twilio-cli config which credentials should be loaded from",
+			},
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_SHARED_CONFIG_FILE", nil),
+				Description: "The path to an INI formatted credentials file which profiles should be loaded from instead of the twilio-cli config",
+			},
 			"retry_attempts": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -59,13 +92,68 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("TWILIO_BACKOFF_INTERVAL_IN_MS", 5000),
 				Description: "The time in ms to wait between each retry attempt",
 			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TWILIO_MAX_REQUESTS_PER_SECOND", 0),
+				Description:  "The maximum number of requests per second which the provider will send to Twilio. A value of 0 disables the limit",
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TWILIO_MAX_CONCURRENT_REQUESTS", 0),
+				Description:  "The maximum number of requests which the provider will send to Twilio concurrently. A value of 0 disables the limit",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_REGION", nil),
+				Description: "The Twilio Region which requests should be processed in",
+				ValidateFunc: validation.StringInSlice([]string{
+					"au1",
+					"ie1",
+					"us1",
+				}, false),
+			},
+			"edge": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_EDGE", nil),
+				Description: "The Twilio Edge Location which requests should be sent to",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ashburn",
+					"dublin",
+					"frankfurt",
+					"sao-paulo",
+					"singapore",
+					"sydney",
+					"tokyo",
+					"umatilla",
+					"roaming",
+				}, false),
+			},
+			"api_base_url_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TWILIO_API_BASE_URL_OVERRIDE", nil),
+				Description:  "The base URL which all requests should be sent to instead of the Twilio API, this is intended for test doubles and proxies",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TWILIO_DELETION_PROTECTION", true),
+				Description: "Whether phone numbers, short codes and subaccounts are protected from being deleted/ released",
+			},
 		},
 
 		DataSourcesMap: dataSources,
 		ResourcesMap:   resources,
 	}
 
-	provider.ConfigureContextFunc = providerConfigure(provider)
+	provider.ConfigureContextFunc = providerConfigure(provider, version)
 
 	return provider
 }
@@ -78,22 +166,50 @@ func validateAndRegisterSupportedResources(registeredResources map[string]*schem
 			panic(fmt.Sprintf("An existing %s exists for %q", resourceType, key))
 		}
 
+		removeUnknownAttributePaths(value)
 		registeredResources[key] = value
 	}
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+// removeUnknownAttributePaths wraps the CRUD functions of the resource, so errors are only attributed to arguments which exist in the schema of the resource
+func removeUnknownAttributePaths(resource *schema.Resource) {
+	wrap := func(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return utils.RemoveUnknownAttributePaths(fn(ctx, d, meta), resource.Schema)
+		}
+	}
+
+	resource.CreateContext = wrap(resource.CreateContext)
+	resource.ReadContext = wrap(resource.ReadContext)
+	resource.UpdateContext = wrap(resource.UpdateContext)
+	resource.DeleteContext = wrap(resource.DeleteContext)
+}
+
+func providerConfigure(p *schema.Provider, version string) schema.ConfigureContextFunc {
 	return func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 
 		config := Config{
-			AccountSid:       d.Get("account_sid").(string),
-			AuthToken:        d.Get("auth_token").(string),
-			APIKey:           d.Get("api_key").(string),
-			APISecret:        d.Get("api_secret").(string),
-			RetryAttempts:    d.Get("retry_attempts").(int),
-			BackoffInterval:  d.Get("backoff_interval_in_ms").(int),
-			terraformVersion: terraformVersion,
+			AccountSid:            d.Get("account_sid").(string),
+			SubaccountSid:         d.Get("subaccount_sid").(string),
+			AuthToken:             d.Get("auth_token").(string),
+			APIKey:                d.Get("api_key").(string),
+			APISecret:             d.Get("api_secret").(string),
+			RetryAttempts:         d.Get("retry_attempts").(int),
+			BackoffInterval:       d.Get("backoff_interval_in_ms").(int),
+			MaxRequestsPerSecond:  d.Get("max_requests_per_second").(float64),
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+			Region:                d.Get("region").(string),
+			Edge:                  d.Get("edge").(string),
+			APIBaseURLOverride:    d.Get("api_base_url_override").(string),
+			Profile:               d.Get("profile").(string),
+			SharedConfigFile:      d.Get("shared_config_file").(string),
+			DeletionProtection:    d.Get("deletion_protection").(bool),
+			terraformVersion:      terraformVersion,
+			providerVersion:       version,
 		}
 
 		return config.Client()
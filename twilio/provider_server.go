@@ -0,0 +1,32 @@
+package twilio
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/timworks/terraform-provider-twilio/twilio/functions"
+)
+
+// ProviderServer creates a protocol 6 server which combines the SDKv2 provider, which contains all of the resources and data sources, with the plugin framework provider which contains the provider functions
+func ProviderServer(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	sdkProvider := New(version)()
+
+	upgradedSDKServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer {
+			return upgradedSDKServer
+		},
+		providerserver.NewProtocol6(functions.New(version, sdkProvider)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
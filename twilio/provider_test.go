@@ -1,7 +1,14 @@
 package twilio
 
 import (
+	"context"
+	"os"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestProvider(t *testing.T) {
@@ -9,3 +16,69 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err.Error())
 	}
 }
+
+func TestProviderDeletionProtectionIsEnabledByDefault(t *testing.T) {
+	// t.Setenv restores the environment variable once the test has completed
+	t.Setenv("TWILIO_DELETION_PROTECTION", "")
+	os.Unsetenv("TWILIO_DELETION_PROTECTION")
+
+	value, err := Provider().Schema["deletion_protection"].DefaultValue()
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if value != true {
+		t.Errorf("Expected deletion protection to be enabled by default, got %v", value)
+	}
+}
+
+func TestProviderRemovesUnknownAttributePaths(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {Type: schema.TypeString, Optional: true},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "known", AttributePath: cty.GetAttrPath("friendly_name")},
+				{Severity: diag.Error, Summary: "unknown", AttributePath: cty.GetAttrPath("url")},
+			}
+		},
+	}
+
+	registeredResources := map[string]*schema.Resource{}
+	validateAndRegisterSupportedResources(registeredResources, map[string]*schema.Resource{"twilio_test": resource}, "Test", "Resources")
+
+	diags := registeredResources["twilio_test"].CreateContext(context.Background(), nil, nil)
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("friendly_name")) {
+		t.Errorf("Expected the diagnostic to be attributed to friendly_name, got %#v", diags[0].AttributePath)
+	}
+	if diags[1].AttributePath != nil {
+		t.Errorf("Expected the attribute path of the unknown argument to be removed, got %#v", diags[1].AttributePath)
+	}
+	if registeredResources["twilio_test"].ReadContext != nil {
+		t.Error("Expected the undefined CRUD functions to remain undefined")
+	}
+}
+
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	providerServer, err := ProviderServer(ctx, "test")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	// The mux server returns an error diagnostic when the provider schemas of the SDKv2 and plugin framework providers differ
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		t.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+
+	for _, name := range []string{"normalize_e164", "parse_sid", "studio_state"} {
+		if _, ok := resp.Functions[name]; !ok {
+			t.Errorf("The %s function is not registered", name)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package twilio
 
+//go:generate go run ./internal/codegen
+
 import (
 	"github.com/timworks/terraform-provider-twilio/twilio/common"
 	"github.com/timworks/terraform-provider-twilio/twilio/internal/services/account"
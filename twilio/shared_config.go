@@ -0,0 +1,198 @@
+package twilio
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	defaultProfileName     = "default"
+	twilioCLIConfigFile    = "~/.twilio-cli/config.json"
+	sharedConfigAccountSid = "account_sid"
+	sharedConfigAuthToken  = "auth_token"
+	sharedConfigAPIKey     = "api_key"
+	sharedConfigAPISecret  = "api_secret"
+	sharedConfigRegion     = "region"
+	sharedConfigEdge       = "edge"
+)
+
+// sharedConfigProfile contains the credentials and settings which were loaded from a profile
+type sharedConfigProfile struct {
+	Name       string
+	AccountSid string
+	AuthToken  string
+	APIKey     string
+	APISecret  string
+	Region     string
+	Edge       string
+}
+
+type twilioCLIConfig struct {
+	ActiveProfile *string                     `json:"activeProfile"`
+	Edge          *string                     `json:"edge"`
+	Profiles      map[string]twilioCLIProfile `json:"profiles"`
+	Projects      []twilioCLIProject          `json:"projects"`
+}
+
+type twilioCLIProfile struct {
+	AccountSid string  `json:"accountSid"`
+	APIKey     string  `json:"apiKey"`
+	APISecret  string  `json:"apiSecret"`
+	Region     *string `json:"region"`
+}
+
+// twilioCLIProject is the profile format used by versions of the twilio-cli prior to v3
+type twilioCLIProject struct {
+	ID         string  `json:"id"`
+	AccountSid string  `json:"accountSid"`
+	Region     *string `json:"region"`
+}
+
+// loadSharedConfigProfile retrieves the credentials for the profile from either the shared config file (when specified) or the twilio-cli config file.
+// When no profile has been explicitly requested and no config file can be found, nil is returned so the provider can fall back to the static/ environment variable credentials
+func loadSharedConfigProfile(profile string, sharedConfigFile string) (*sharedConfigProfile, error) {
+	if sharedConfigFile != "" {
+		path, err := homedir.Expand(sharedConfigFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding homedir: %s", err.Error())
+		}
+
+		profileName := profile
+		if profileName == "" {
+			profileName = defaultProfileName
+		}
+		return loadSharedConfigFileProfile(path, profileName)
+	}
+
+	path, err := homedir.Expand(twilioCLIConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir: %s", err.Error())
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if profile != "" {
+			return nil, fmt.Errorf("The profile (%s) could not be loaded as the twilio-cli config file (%s) does not exist", profile, path)
+		}
+		return nil, nil
+	}
+
+	return loadTwilioCLIProfile(path, profile)
+}
+
+// loadSharedConfigFileProfile reads the profile from an INI formatted credentials file. i.e.
+//
+// [default]
+// account_sid = ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX
+// auth_token  = my-auth-token
+func loadSharedConfigFileProfile(path string, profileName string) (*sharedConfigProfile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open shared config file (%s): %s", path, err.Error())
+	}
+	defer file.Close()
+
+	sections := make(map[string]map[string]string)
+	section := ""
+
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(line, "]"), "["))
+			if _, ok := sections[section]; !ok {
+				sections[section] = make(map[string]string)
+			}
+			continue
+		}
+
+		keyValue := strings.SplitN(line, "=", 2)
+		if len(keyValue) != 2 || section == "" {
+			return nil, fmt.Errorf("Failed to parse shared config file (%s) on line %d", path, lineNumber)
+		}
+		sections[section][strings.ToLower(strings.TrimSpace(keyValue[0]))] = strings.Trim(strings.TrimSpace(keyValue[1]), `"'`)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Failed to read shared config file (%s): %s", path, err.Error())
+	}
+
+	values, ok := sections[profileName]
+	if !ok {
+		return nil, fmt.Errorf("The profile (%s) was not found in the shared config file (%s)", profileName, path)
+	}
+
+	return &sharedConfigProfile{
+		Name:       profileName,
+		AccountSid: values[sharedConfigAccountSid],
+		AuthToken:  values[sharedConfigAuthToken],
+		APIKey:     values[sharedConfigAPIKey],
+		APISecret:  values[sharedConfigAPISecret],
+		Region:     values[sharedConfigRegion],
+		Edge:       values[sharedConfigEdge],
+	}, nil
+}
+
+// loadTwilioCLIProfile reads the profile from the twilio-cli config file. When no profile name is supplied the active profile is used
+func loadTwilioCLIProfile(path string, profileName string) (*sharedConfigProfile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read twilio-cli config file (%s): %s", path, err.Error())
+	}
+
+	var config twilioCLIConfig
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("Failed to parse twilio-cli config file (%s): %s", path, err.Error())
+	}
+
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		if config.ActiveProfile == nil {
+			return nil, nil
+		}
+		profileName = *config.ActiveProfile
+	}
+
+	edge := ""
+	if config.Edge != nil {
+		edge = *config.Edge
+	}
+
+	if profile, ok := config.Profiles[profileName]; ok {
+		region := ""
+		if profile.Region != nil {
+			region = *profile.Region
+		}
+
+		return &sharedConfigProfile{
+			Name:       profileName,
+			AccountSid: profile.AccountSid,
+			APIKey:     profile.APIKey,
+			APISecret:  profile.APISecret,
+			Region:     region,
+			Edge:       edge,
+		}, nil
+	}
+
+	for _, project := range config.Projects {
+		if project.ID == profileName {
+			return nil, fmt.Errorf("The profile (%s) in the twilio-cli config file (%s) stores the API Key & Secret in the system keychain, please upgrade the twilio-cli and re-create the profile", profileName, path)
+		}
+	}
+
+	if !explicitProfile {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("The profile (%s) was not found in the twilio-cli config file (%s)", profileName, path)
+}
@@ -1,9 +1,14 @@
 package utils
 
-import "github.com/timworks/twilio-sdk-go/utils"
+import (
+	"errors"
+
+	"github.com/timworks/twilio-sdk-go/utils"
+)
 
 func IsNotFoundError(err error) bool {
-	if twilioError, ok := err.(*utils.TwilioError); ok {
+	var twilioError *utils.TwilioError
+	if errors.As(err, &twilioError) {
 		return twilioError.IsNotFoundError()
 	}
 	return false
@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// HCLBlock is a block of generated Terraform configuration, i.e. a resource, data source or nested block
+type HCLBlock struct {
+	Type       string
+	Labels     []string
+	Attributes []HCLAttribute
+	Blocks     []*HCLBlock
+}
+
+// HCLAttribute is an argument of a generated block. The value is the rendered HCL expression
+type HCLAttribute struct {
+	Name  string
+	Value string
+}
+
+// AddAttribute adds the argument to the block, the value must already be a HCL expression
+func (b *HCLBlock) AddAttribute(name string, value string) {
+	b.Attributes = append(b.Attributes, HCLAttribute{Name: name, Value: value})
+}
+
+// AddBlock adds a nested block and returns it, so the arguments of the nested block can be added
+func (b *HCLBlock) AddBlock(blockType string, labels ...string) *HCLBlock {
+	block := &HCLBlock{Type: blockType, Labels: labels}
+	b.Blocks = append(b.Blocks, block)
+	return block
+}
+
+// String renders the block in the same format as terraform fmt
+func (b *HCLBlock) String() string {
+	var builder strings.Builder
+	b.render(&builder, "")
+	return builder.String()
+}
+
+func (b *HCLBlock) render(builder *strings.Builder, indent string) {
+	builder.WriteString(indent + b.Type)
+	for _, label := range b.Labels {
+		builder.WriteString(" " + QuoteHCLString(label))
+	}
+
+	if len(b.Attributes) == 0 && len(b.Blocks) == 0 {
+		builder.WriteString(" {}\n")
+		return
+	}
+	builder.WriteString(" {\n")
+
+	// Consecutive single line arguments are aligned in the same way as terraform fmt
+	for start := 0; start < len(b.Attributes); {
+		end := start
+		width := 0
+		for ; end < len(b.Attributes) && !strings.Contains(b.Attributes[end].Value, "\n"); end++ {
+			if len(b.Attributes[end].Name) > width {
+				width = len(b.Attributes[end].Name)
+			}
+		}
+
+		for _, attribute := range b.Attributes[start:end] {
+			fmt.Fprintf(builder, "%s  %-*s = %s\n", indent, width, attribute.Name, attribute.Value)
+		}
+		if end < len(b.Attributes) {
+			attribute := b.Attributes[end]
+			fmt.Fprintf(builder, "%s  %s = %s\n", indent, attribute.Name, attribute.Value)
+			end++
+		}
+		start = end
+	}
+
+	for index, block := range b.Blocks {
+		if index > 0 || len(b.Attributes) > 0 {
+			builder.WriteString("\n")
+		}
+		block.render(builder, indent+"  ")
+	}
+	builder.WriteString(indent + "}\n")
+}
+
+// HCLLiteral renders strings, booleans, numbers and lists of these types (including pointers) as a HCL expression. False is returned when the value is nil or the type is not supported
+func HCLLiteral(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return QuoteHCLString(v), true
+	case *string:
+		if v == nil {
+			return "", false
+		}
+		return QuoteHCLString(*v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case *bool:
+		if v == nil {
+			return "", false
+		}
+		return strconv.FormatBool(*v), true
+	case int:
+		return strconv.Itoa(v), true
+	case *int:
+		if v == nil {
+			return "", false
+		}
+		return strconv.Itoa(*v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case *float64:
+		if v == nil {
+			return "", false
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64), true
+	case []string:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, item)
+		}
+		return HCLLiteral(items)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			expression, ok := HCLLiteral(item)
+			if !ok {
+				return "", false
+			}
+			items = append(items, expression)
+		}
+		return "[" + strings.Join(items, ", ") + "]", true
+	default:
+		return "", false
+	}
+}
+
+// QuoteHCLString renders a HCL string literal, template sequences are escaped so the value is not interpolated
+func QuoteHCLString(value string) string {
+	var builder strings.Builder
+	builder.WriteString("\"")
+	for _, character := range value {
+		switch {
+		case character == '"' || character == '\\':
+			builder.WriteRune('\\')
+			builder.WriteRune(character)
+		case character == '\n':
+			builder.WriteString("\\n")
+		case character == '\r':
+			builder.WriteString("\\r")
+		case character == '\t':
+			builder.WriteString("\\t")
+		case character < 0x20:
+			fmt.Fprintf(&builder, "\\u%04x", character)
+		default:
+			builder.WriteRune(character)
+		}
+	}
+	builder.WriteString("\"")
+	return EscapeHCLTemplate(builder.String())
+}
+
+// EscapeHCLTemplate escapes the template sequences in the value, so the value is not interpolated by Terraform
+func EscapeHCLTemplate(value string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(value)
+}
+
+var invalidHCLNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// HCLNames generates unique Terraform resource names for each resource type
+type HCLNames struct {
+	used map[string]bool
+}
+
+func NewHCLNames() *HCLNames {
+	return &HCLNames{
+		used: make(map[string]bool),
+	}
+}
+
+// Next converts the first non empty candidate (i.e. the friendly/unique name of the Twilio resource) into a valid and unique resource name
+func (n *HCLNames) Next(resourceType string, candidates ...string) string {
+	name := ""
+	for _, candidate := range candidates {
+		name = strings.Trim(invalidHCLNameCharacters.ReplaceAllString(strings.ToLower(candidate), "_"), "_-")
+		if name != "" {
+			break
+		}
+	}
+
+	if name == "" {
+		name = "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+
+	uniqueName := name
+	for index := 2; n.used[resourceType+"."+uniqueName]; index++ {
+		uniqueName = fmt.Sprintf("%s_%d", name, index)
+	}
+	n.used[resourceType+"."+uniqueName] = true
+	return uniqueName
+}
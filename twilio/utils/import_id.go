@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamedImportIDSegments splits an import ID which identifies a resource by name, i.e. `serverless:my-service/functions/my-function`, into its path segments.
+// False is returned when the ID does not start with the prefix, in which case the ID should be treated as a SID based import ID
+func NamedImportIDSegments(prefix string, id string) ([]string, bool) {
+	if !strings.HasPrefix(id, prefix+":") {
+		return nil, false
+	}
+	return strings.Split(strings.TrimPrefix(id, prefix+":"), "/"), true
+}
+
+// HasEmptySegment returns whether any of the segments of a named import ID are empty, i.e. `taskrouter:my-workspace/workflows/`, so the import ID can be rejected before an empty name is resolved
+func HasEmptySegment(segments []string) bool {
+	for _, segment := range segments {
+		if segment == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// NameCandidate is a resource returned by a list endpoint which may be matched by name when resolving a named import ID
+type NameCandidate struct {
+	Sid string
+	// Names are the friendly/unique names of the resource, the SDK returns names as either a string or a string pointer depending on whether the field is optional, so both are supported
+	Names []interface{}
+}
+
+// FindSidByName returns the SID of the only candidate which has a name that exactly matches the supplied name. The SID of the resource is also accepted in place of the name.
+// An error is returned when no candidates or more than one candidate match, so an import never silently selects the wrong resource
+func FindSidByName(resourceDescription string, name string, candidates []NameCandidate) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("The name of the %s must not be empty", resourceDescription)
+	}
+
+	matches := make([]string, 0)
+	for _, candidate := range candidates {
+		if candidate.Sid == name {
+			return candidate.Sid, nil
+		}
+
+		for _, candidateName := range candidate.Names {
+			if nameValue(candidateName) == name {
+				matches = append(matches, candidate.Sid)
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("No %s was found with the name (%s)", resourceDescription, name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("The name (%s) matches %d %s resources (%s), please import the %s using the SID instead", name, len(matches), resourceDescription, strings.Join(matches, ", "), resourceDescription)
+	}
+}
+
+func nameValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNamedImportIDSegments(t *testing.T) {
+	testCases := map[string]struct {
+		id               string
+		expectedSegments []string
+		expectedOk       bool
+	}{
+		"service":                {id: "serverless:my-service", expectedSegments: []string{"my-service"}, expectedOk: true},
+		"nested resource":        {id: "serverless:my-service/functions/my-function", expectedSegments: []string{"my-service", "functions", "my-function"}, expectedOk: true},
+		"empty name":             {id: "serverless:", expectedSegments: []string{""}, expectedOk: true},
+		"trailing separator":     {id: "serverless:my-service/functions/", expectedSegments: []string{"my-service", "functions", ""}, expectedOk: true},
+		"sid based import id":    {id: "/Services/ZS00000000000000000000000000000000", expectedOk: false},
+		"different prefix":       {id: "taskrouter:my-workspace", expectedOk: false},
+		"prefix without a colon": {id: "serverless/my-service", expectedOk: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			segments, ok := NamedImportIDSegments("serverless", testCase.id)
+			if ok != testCase.expectedOk {
+				t.Fatalf("Expected %t, got %t", testCase.expectedOk, ok)
+			}
+			if !reflect.DeepEqual(segments, testCase.expectedSegments) {
+				t.Errorf("Expected segments %#v, got %#v", testCase.expectedSegments, segments)
+			}
+		})
+	}
+}
+
+func TestHasEmptySegment(t *testing.T) {
+	testCases := map[string]struct {
+		segments []string
+		expected bool
+	}{
+		"no empty segments":   {segments: []string{"my-workspace", "workflows", "my-workflow"}, expected: false},
+		"empty first segment": {segments: []string{"", "workflows", "my-workflow"}, expected: true},
+		"empty type segment":  {segments: []string{"my-workspace", "", "my-workflow"}, expected: true},
+		"empty last segment":  {segments: []string{"my-workspace", "workflows", ""}, expected: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if actual := HasEmptySegment(testCase.segments); actual != testCase.expected {
+				t.Errorf("Expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFindSidByName(t *testing.T) {
+	optionalName := "optional-name"
+	candidates := []NameCandidate{
+		{Sid: "WW00000000000000000000000000000001", Names: []interface{}{"unique-name", "friendly-name"}},
+		{Sid: "WW00000000000000000000000000000002", Names: []interface{}{"duplicate-name"}},
+		{Sid: "WW00000000000000000000000000000003", Names: []interface{}{"duplicate-name"}},
+		{Sid: "WW00000000000000000000000000000004", Names: []interface{}{&optionalName}},
+		{Sid: "WW00000000000000000000000000000005", Names: []interface{}{(*string)(nil), ""}},
+		{Sid: "WW00000000000000000000000000000006", Names: []interface{}{"same-name", "same-name"}},
+	}
+
+	testCases := map[string]struct {
+		name          string
+		expectedSid   string
+		expectedError string
+	}{
+		"unique name": {
+			name:        "unique-name",
+			expectedSid: "WW00000000000000000000000000000001",
+		},
+		"second name": {
+			name:        "friendly-name",
+			expectedSid: "WW00000000000000000000000000000001",
+		},
+		"optional name": {
+			name:        "optional-name",
+			expectedSid: "WW00000000000000000000000000000004",
+		},
+		"sid": {
+			name:        "WW00000000000000000000000000000002",
+			expectedSid: "WW00000000000000000000000000000002",
+		},
+		"candidate matched by multiple names": {
+			name:        "same-name",
+			expectedSid: "WW00000000000000000000000000000006",
+		},
+		"ambiguous name": {
+			name:          "duplicate-name",
+			expectedError: "The name (duplicate-name) matches 2 taskrouter workflow resources (WW00000000000000000000000000000002, WW00000000000000000000000000000003), please import the taskrouter workflow using the SID instead",
+		},
+		"no match": {
+			name:          "missing-name",
+			expectedError: "No taskrouter workflow was found with the name (missing-name)",
+		},
+		"names are case sensitive": {
+			name:          "Unique-Name",
+			expectedError: "No taskrouter workflow was found with the name (Unique-Name)",
+		},
+		"empty name": {
+			name:          "",
+			expectedError: "The name of the taskrouter workflow must not be empty",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sid, err := FindSidByName("taskrouter workflow", testCase.name, candidates)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("Expected error %q, got %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err: %s", err.Error())
+			}
+			if sid != testCase.expectedSid {
+				t.Errorf("Expected %s, got %s", testCase.expectedSid, sid)
+			}
+		})
+	}
+
+	if _, err := FindSidByName("taskrouter workflow", "unique-name", nil); err == nil {
+		t.Error("Expected an error when there are no candidates")
+	}
+}
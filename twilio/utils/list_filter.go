@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// WithListFilters adds the `filter`, `max_results` and `sort_by` arguments to the schema of a data source which returns a list, so the items can be filtered, sorted and limited before they are stored in state.
+// Only the top level string, bool and number attributes of the list items can be used to filter and sort the items
+func WithListFilters(listAttribute string, dataSourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	attributeNames := listFilterAttributeNames(dataSourceSchema[listAttribute])
+
+	dataSourceSchema["filter"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(attributeNames, false),
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"regex": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+	dataSourceSchema["max_results"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	dataSourceSchema["sort_by"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(attributeNames, false),
+	}
+	return dataSourceSchema
+}
+
+type listFilter struct {
+	name    string
+	values  []string
+	regexes []*regexp.Regexp
+}
+
+func (f listFilter) matches(item map[string]interface{}) bool {
+	value := listItemValue(item[f.name])
+	for _, regex := range f.regexes {
+		if regex.MatchString(value) {
+			return true
+		}
+	}
+	for _, filterValue := range f.values {
+		if value == filterValue {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterListItems returns the list items which match all of the `filter` blocks, sorted by the `sort_by` attribute and limited to `max_results` items.
+// An item matches a filter when the value of the attribute equals one of the values, or matches one of the values when `regex` is true
+func FilterListItems(d *schema.ResourceData, items []interface{}) ([]interface{}, error) {
+	filters := make([]listFilter, 0)
+	for _, rawFilter := range d.Get("filter").([]interface{}) {
+		filterMap := rawFilter.(map[string]interface{})
+		filter := listFilter{
+			name: filterMap["name"].(string),
+		}
+
+		for _, value := range ConvertToStringSlice(filterMap["values"].([]interface{})) {
+			if !filterMap["regex"].(bool) {
+				filter.values = append(filter.values, value)
+				continue
+			}
+
+			regex, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("The filter value (%s) for %s is not a valid regular expression: %s", value, filter.name, err.Error())
+			}
+			filter.regexes = append(filter.regexes, regex)
+		}
+		filters = append(filters, filter)
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		matched := true
+		for _, filter := range filters {
+			if !filter.matches(itemMap) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			results = append(results, item)
+		}
+	}
+
+	if sortBy, ok := d.GetOk("sort_by"); ok {
+		sort.SliceStable(results, func(i, j int) bool {
+			return listItemLess(results[i].(map[string]interface{})[sortBy.(string)], results[j].(map[string]interface{})[sortBy.(string)])
+		})
+	}
+
+	if maxResults, ok := d.GetOk("max_results"); ok && len(results) > maxResults.(int) {
+		results = results[:maxResults.(int)]
+	}
+	return results, nil
+}
+
+func listFilterAttributeNames(listSchema *schema.Schema) []string {
+	names := make([]string, 0)
+	if listSchema == nil {
+		return names
+	}
+
+	if elem, ok := listSchema.Elem.(*schema.Resource); ok {
+		for name, attributeSchema := range elem.Schema {
+			switch attributeSchema.Type {
+			case schema.TypeString, schema.TypeBool, schema.TypeInt, schema.TypeFloat:
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// listItemValue converts the value of an attribute into the string which is compared with the filter values. The SDK returns optional values as pointers, nil values are treated as an empty string
+func listItemValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		// Pointers are dereferenced so optional values are compared in the same way as required values
+		reflectValue := reflect.ValueOf(v)
+		if reflectValue.Kind() == reflect.Ptr {
+			if reflectValue.IsNil() {
+				return ""
+			}
+			return listItemValue(reflectValue.Elem().Interface())
+		}
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// listItemLess compares numbers numerically and all other values as strings
+func listItemLess(a interface{}, b interface{}) bool {
+	aValue := listItemValue(a)
+	bValue := listItemValue(b)
+
+	aNumber, aErr := strconv.ParseFloat(aValue, 64)
+	bNumber, bErr := strconv.ParseFloat(bValue, 64)
+	if aErr == nil && bErr == nil {
+		return aNumber < bNumber
+	}
+	return aValue < bValue
+}
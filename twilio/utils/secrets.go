@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// SecretStoragePlaintext stores the secret in state as plaintext, this is the default behaviour
+	SecretStoragePlaintext = "plaintext"
+	// SecretStorageHash stores a salted hash of the secret in state instead of the plaintext, so changes can still be detected
+	SecretStorageHash = "hash"
+
+	secretHashPrefix = "hmac-sha256:"
+	secretSaltLength = 16
+)
+
+// SecretStorageSchema is the schema of the `secret_storage` argument, which controls whether a secret is stored in state as plaintext or as a hash
+func SecretStorageSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: forceNew,
+		Default:  SecretStoragePlaintext,
+		ValidateFunc: validation.StringInSlice([]string{
+			SecretStoragePlaintext,
+			SecretStorageHash,
+		}, false),
+	}
+}
+
+// PGPKeySchema is the schema of the `pgp_key` argument, which is used to encrypt a secret generated by Twilio before it is stored in state
+func PGPKeySchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: PGPKeyValidation(),
+	}
+}
+
+// HashSecret returns a HMAC-SHA256 of the secret keyed with a random salt, in the format `hmac-sha256:<salt>:<hash>`.
+// The salt is stored alongside the hash so the hash can be verified using VerifySecretHash, and the same secret produces a different hash each time so common secrets cannot be identified from state
+func HashSecret(secret string) string {
+	salt := make([]byte, secretSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		//lintignore:R009
+		panic(fmt.Sprintf("Failed to generate salt: %s", err.Error()))
+	}
+	return secretHashPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(secretHMAC(salt, secret))
+}
+
+// VerifySecretHash returns whether the hash was returned by HashSecret for the secret
+func VerifySecretHash(hash string, secret string) bool {
+	if !IsSecretHash(hash) {
+		return false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(hash, secretHashPrefix), ":")
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil || len(salt) != secretSaltLength {
+		return false
+	}
+	mac, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(mac, secretHMAC(salt, secret))
+}
+
+func secretHMAC(salt []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(secret))
+	return mac.Sum(nil)
+}
+
+// IsSecretHash returns whether the value is a hash returned by HashSecret
+func IsSecretHash(value string) bool {
+	return strings.HasPrefix(value, secretHashPrefix)
+}
+
+// SecretHashForState returns the existing hash when it is the hash of the secret, otherwise a new hash is returned. This prevents the value in state changing when the secret has not changed
+func SecretHashForState(existingHash string, secret string) string {
+	if VerifySecretHash(existingHash, secret) {
+		return existingHash
+	}
+	return HashSecret(secret)
+}
+
+// SecretValueForState returns the value of the secret argument which should be stored in state, based on the `secret_storage` argument of the resource
+func SecretValueForState(d *schema.ResourceData, key string, secret string) string {
+	if d.Get("secret_storage").(string) == SecretStorageHash && secret != "" {
+		existingValue, _ := d.GetChange(key)
+		return SecretHashForState(existingValue.(string), secret)
+	}
+	return secret
+}
+
+// SecretArgumentDiffSuppressFunc suppresses the diff of a secret argument when the state contains the hash of the configured secret, so storing the secret as a hash does not cause the resource to be updated or replaced
+func SecretArgumentDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return VerifySecretHash(old, new)
+}
+
+// SecretArgumentFromConfig returns the plaintext value of a secret argument from the configuration.
+// The value in state may be a hash, so the configuration is used to retrieve the plaintext when the secret is sent to Twilio or the storage mode is changed
+func SecretArgumentFromConfig(d *schema.ResourceData, key string) string {
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsNull() && rawConfig.Type().IsObjectType() && rawConfig.Type().HasAttribute(key) {
+		if value := rawConfig.GetAttr(key); value.IsKnown() && !value.IsNull() && value.Type() == cty.String {
+			return value.AsString()
+		}
+	}
+	return d.Get(key).(string)
+}
+
+// PGPKeyValidation validates the PGP key is a base64 encoded PGP public key
+func PGPKeyValidation() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		value, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if _, err := readPGPEntity(value); err != nil {
+			return nil, []error{fmt.Errorf("%s must be a base64 encoded PGP public key: %s", k, err.Error())}
+		}
+		return nil, nil
+	}
+}
+
+// EncryptSecret encrypts the secret using the base64 encoded PGP public key. The base64 encoded encrypted secret and the fingerprint of the key are returned.
+// The encrypted secret can be decrypted using `terraform output -raw encrypted_secret | base64 --decode | gpg --decrypt`
+func EncryptSecret(pgpKey string, secret string) (string, string, error) {
+	entity, err := readPGPEntity(pgpKey)
+	if err != nil {
+		return "", "", fmt.Errorf("Unable to read PGP key: %s", err.Error())
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	writer, err := openpgp.Encrypt(buffer, []*openpgp.Entity{entity}, nil, nil, nil)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := writer.Write([]byte(secret)); err != nil {
+		return "", "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buffer.Bytes()), hex.EncodeToString(entity.PrimaryKey.Fingerprint), nil
+}
+
+func readPGPEntity(pgpKey string) (*openpgp.Entity, error) {
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(pgpKey))
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := openpgp.ReadKeyRing(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected 1 key but found %d keys", len(entities))
+	}
+	return entities[0], nil
+}
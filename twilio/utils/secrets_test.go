@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+func TestHashSecret(t *testing.T) {
+	hash := HashSecret("my-secret")
+	if !regexp.MustCompile(`^hmac-sha256:[0-9a-f]{32}:[0-9a-f]{64}$`).MatchString(hash) {
+		t.Errorf("Expected the hash to be in the hmac-sha256:<salt>:<hash> format, got %s", hash)
+	}
+	if !IsSecretHash(hash) {
+		t.Errorf("Expected %s to be identified as a hash", hash)
+	}
+	if strings.Contains(hash, "my-secret") {
+		t.Errorf("Expected the hash not to contain the secret, got %s", hash)
+	}
+
+	otherHash := HashSecret("my-secret")
+	if hash == otherHash {
+		t.Error("Expected a different salt to be used each time the secret is hashed")
+	}
+	if !VerifySecretHash(hash, "my-secret") || !VerifySecretHash(otherHash, "my-secret") {
+		t.Error("Expected both hashes to be verified against the secret")
+	}
+}
+
+func TestVerifySecretHash(t *testing.T) {
+	hash := HashSecret("my-secret")
+	parts := strings.Split(hash, ":")
+
+	testCases := map[string]struct {
+		hash     string
+		secret   string
+		expected bool
+	}{
+		"matching secret":     {hash: hash, secret: "my-secret", expected: true},
+		"different secret":    {hash: hash, secret: "my-secret2", expected: false},
+		"empty secret":        {hash: hash, secret: "", expected: false},
+		"plaintext value":     {hash: "my-secret", secret: "my-secret", expected: false},
+		"unsalted hash":       {hash: "sha256:" + parts[2], secret: "my-secret", expected: false},
+		"missing hash":        {hash: "hmac-sha256:" + parts[1], secret: "my-secret", expected: false},
+		"invalid salt":        {hash: "hmac-sha256:zz:" + parts[2], secret: "my-secret", expected: false},
+		"short salt":          {hash: "hmac-sha256:" + parts[1][:8] + ":" + parts[2], secret: "my-secret", expected: false},
+		"invalid hash":        {hash: "hmac-sha256:" + parts[1] + ":zz", secret: "my-secret", expected: false},
+		"different salt":      {hash: "hmac-sha256:" + strings.Repeat("0", 32) + ":" + parts[2], secret: "my-secret", expected: false},
+		"additional segments": {hash: hash + ":00", secret: "my-secret", expected: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if actual := VerifySecretHash(testCase.hash, testCase.secret); actual != testCase.expected {
+				t.Errorf("Expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSecretHashForState(t *testing.T) {
+	hash := HashSecret("my-secret")
+
+	if actual := SecretHashForState(hash, "my-secret"); actual != hash {
+		t.Errorf("Expected the existing hash to be retained when the secret has not changed, got %s", actual)
+	}
+
+	newHash := SecretHashForState(hash, "new-secret")
+	if newHash == hash || !VerifySecretHash(newHash, "new-secret") {
+		t.Errorf("Expected a new hash of the new secret to be returned, got %s", newHash)
+	}
+
+	if actual := SecretHashForState("", "my-secret"); !VerifySecretHash(actual, "my-secret") {
+		t.Errorf("Expected a hash of the secret to be returned when there is no existing hash, got %s", actual)
+	}
+}
+
+func TestSecretArgumentDiffSuppressFunc(t *testing.T) {
+	hash := HashSecret("my-secret")
+
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		"hash of the configured secret":    {old: hash, new: "my-secret", expected: true},
+		"hash of a different secret":       {old: hash, new: "new-secret", expected: false},
+		"plaintext matching the secret":    {old: "my-secret", new: "my-secret", expected: false},
+		"plaintext different to the value": {old: "my-secret", new: "new-secret", expected: false},
+		"hash configured as the secret":    {old: hash, new: hash, expected: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if actual := SecretArgumentDiffSuppressFunc("password", testCase.old, testCase.new, nil); actual != testCase.expected {
+				t.Errorf("Expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+// newTestPGPKey generates a PGP key pair and returns the entity along with the base64 encoded public key, in the same format as the `pgp_key` argument
+func newTestPGPKey(t *testing.T) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity("Terraform Test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatalf("Failed to generate PGP key: %s", err.Error())
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	if err := entity.Serialize(buffer); err != nil {
+		t.Fatalf("Failed to serialize PGP key: %s", err.Error())
+	}
+	return entity, base64.StdEncoding.EncodeToString(buffer.Bytes())
+}
+
+func TestPGPKeyValidation(t *testing.T) {
+	_, pgpKey := newTestPGPKey(t)
+	_, otherPGPKey := newTestPGPKey(t)
+	first, _ := base64.StdEncoding.DecodeString(pgpKey)
+	second, _ := base64.StdEncoding.DecodeString(otherPGPKey)
+
+	testCases := map[string]struct {
+		value       interface{}
+		expectError bool
+	}{
+		"valid key":                    {value: pgpKey},
+		"valid key with whitespace":    {value: "\n" + pgpKey + "\n"},
+		"not a string":                 {value: 1, expectError: true},
+		"not base64 encoded":           {value: "not-base64!", expectError: true},
+		"base64 encoded non key value": {value: base64.StdEncoding.EncodeToString([]byte("not a key")), expectError: true},
+		"multiple keys":                {value: base64.StdEncoding.EncodeToString(append(first, second...)), expectError: true},
+		"keybase username":             {value: "keybase:username", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := PGPKeyValidation()(testCase.value, "pgp_key")
+			if testCase.expectError && len(errs) == 0 {
+				t.Error("Expected a validation error")
+			}
+			if !testCase.expectError && len(errs) != 0 {
+				t.Errorf("Expected no validation errors, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestEncryptSecret(t *testing.T) {
+	entity, pgpKey := newTestPGPKey(t)
+
+	encryptedSecret, fingerprint, err := EncryptSecret(pgpKey, "my-secret")
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if fingerprint != hex.EncodeToString(entity.PrimaryKey.Fingerprint) {
+		t.Errorf("Expected the fingerprint %s, got %s", hex.EncodeToString(entity.PrimaryKey.Fingerprint), fingerprint)
+	}
+
+	content, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		t.Fatalf("Expected the encrypted secret to be base64 encoded: %s", err.Error())
+	}
+	if bytes.Contains(content, []byte("my-secret")) {
+		t.Error("Expected the secret to be encrypted")
+	}
+
+	message, err := openpgp.ReadMessage(bytes.NewReader(content), openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to decrypt the secret: %s", err.Error())
+	}
+	secret, err := io.ReadAll(message.UnverifiedBody)
+	if err != nil {
+		t.Fatalf("Failed to read the decrypted secret: %s", err.Error())
+	}
+	if string(secret) != "my-secret" {
+		t.Errorf("Expected the decrypted secret to be my-secret, got %s", secret)
+	}
+
+	if _, _, err := EncryptSecret("not-base64!", "my-secret"); err == nil {
+		t.Error("Expected an error when the PGP key is invalid")
+	}
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// NormalizeJSON is a StateFunc which stores JSON strings in state in a consistent form (keys sorted and whitespace removed), so formatting changes to the configuration do not cause a diff.
+// Invalid JSON is stored as supplied so the error can be reported by the validation of the argument
+func NormalizeJSON(value interface{}) string {
+	jsonString, ok := value.(string)
+	if !ok {
+		return ""
+	}
+
+	normalizedJSON, err := structure.NormalizeJsonString(jsonString)
+	if err != nil {
+		return jsonString
+	}
+	return normalizedJSON
+}
+
+// StateUpgradeFuncs combines multiple upgrade functions into a single function, so a resource can apply several changes when upgrading from one schema version to the next.
+// The functions are run in the order they are supplied
+func StateUpgradeFuncs(upgradeFuncs ...schema.StateUpgradeFunc) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		var err error
+		for _, upgradeFunc := range upgradeFuncs {
+			if rawState, err = upgradeFunc(ctx, rawState, meta); err != nil {
+				return nil, err
+			}
+		}
+		return rawState, nil
+	}
+}
+
+// NormalizeJSONStateUpgradeFunc upgrades the JSON string attributes to the form stored by NormalizeJSON, so existing state matches the normalized configuration and the resources are not updated or replaced
+func NormalizeJSONStateUpgradeFunc(attributes ...string) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		for _, attribute := range attributes {
+			if value, ok := rawState[attribute].(string); ok && value != "" {
+				rawState[attribute] = NormalizeJSON(value)
+			}
+		}
+		return rawState, nil
+	}
+}
+
+// RenameAttributeStateUpgradeFunc moves the value of an attribute which has been renamed to the new attribute name
+func RenameAttributeStateUpgradeFunc(oldName string, newName string) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		if value, ok := rawState[oldName]; ok {
+			rawState[newName] = value
+			delete(rawState, oldName)
+		}
+		return rawState, nil
+	}
+}
+
+// ReplaceAttributeValueStateUpgradeFunc replaces values of an attribute which are no longer supported, i.e. a runtime which has been removed by Twilio, with the value which should be used instead
+func ReplaceAttributeValueStateUpgradeFunc(attribute string, replacements map[string]string) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		if value, ok := rawState[attribute].(string); ok {
+			if replacement, ok := replacements[value]; ok {
+				rawState[attribute] = replacement
+			}
+		}
+		return rawState, nil
+	}
+}
+
+// DefaultAttributeValueStateUpgradeFunc sets the value of an attribute which was added with a default value, as the default value is not applied to the existing state of a resource
+func DefaultAttributeValueStateUpgradeFunc(attribute string, value interface{}) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		if existingValue, ok := rawState[attribute]; !ok || existingValue == nil {
+			rawState[attribute] = value
+		}
+		return rawState, nil
+	}
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "time"
+
+// FormatTime returns the time in RFC3339 format. The SDK returns optional times as pointers, so nil is returned when the time is not set
+func FormatTime(value interface{}) *string {
+	switch t := value.(type) {
+	case time.Time:
+		formatted := t.Format(time.RFC3339)
+		return &formatted
+	case *time.Time:
+		if t == nil {
+			return nil
+		}
+		return FormatTime(*t)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/timworks/twilio-sdk-go/utils"
+)
+
+// twilioErrorCode describes a Twilio error code, see https://www.twilio.com/docs/api/errors for the full list of error codes
+type twilioErrorCode struct {
+	summary   string
+	detail    string
+	retryable bool
+}
+
+var twilioErrorCodes = map[int]twilioErrorCode{
+	20001: {summary: "Invalid parameter", detail: "One or more of the arguments supplied were rejected by Twilio, please check the arguments against the resource documentation"},
+	20003: {summary: "Authentication failed", detail: "Twilio was unable to authenticate the request. Please check the account_sid/auth_token or api_key/api_secret supplied to the provider and that the credentials have access to the account"},
+	20005: {summary: "Account not active", detail: "The account is suspended or closed, so the request cannot be processed"},
+	20008: {summary: "Test credentials not supported", detail: "The resource cannot be managed using test credentials, please use the live credentials for the account"},
+	20404: {summary: "Resource not found", detail: "The resource does not exist or has been deleted outside of Terraform"},
+	20429: {summary: "Too many requests", detail: "Twilio has rate limited the request. Consider reducing the parallelism of Terraform or the max_requests_per_second and max_concurrent_requests provider arguments", retryable: true},
+	20500: {summary: "Internal server error", detail: "Twilio encountered an unexpected error whilst processing the request", retryable: true},
+	20503: {summary: "Service unavailable", detail: "The Twilio service is temporarily unavailable", retryable: true},
+	21218: {summary: "Invalid application SID", detail: "The TwiML application does not exist in the account"},
+	21421: {summary: "Invalid phone number", detail: "The phone number is not a valid E.164 formatted phone number"},
+	21422: {summary: "Phone number not available", detail: "The phone number is not available to purchase, it may have been purchased by another customer"},
+	21452: {summary: "No phone numbers found", detail: "No phone numbers are available which match the search criteria"},
+}
+
+// parameterAliases maps Twilio parameter names which do not match the name of the corresponding Terraform argument
+var parameterAliases = map[string]string{
+	"StatusCallback": "status_callback_url",
+}
+
+var parameterRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)^invalid parameter:? ['"\x60]?([A-Za-z]+)['"\x60]?`),
+	regexp.MustCompile(`(?i)^(?:the )?parameter ['"\x60]?([A-Za-z]+)['"\x60]? `),
+	regexp.MustCompile(`(?i)^(?:missing )?required parameter:? ['"\x60]?([A-Za-z]+)['"\x60]?`),
+	regexp.MustCompile(`^([A-Z][a-z0-9]+(?:[A-Z][a-z0-9]*)+) (?:is|must be|must not be|cannot be) `),
+}
+
+var capitalLetterRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// TwilioErrorDetails is the translated form of an error returned by the Twilio API
+type TwilioErrorDetails struct {
+	Status    int
+	Code      int
+	Message   string
+	MoreInfo  string
+	Details   map[string]interface{}
+	Summary   string
+	Detail    string
+	Parameter string
+	Retryable bool
+}
+
+// TranslateTwilioError converts an error returned by the Twilio SDK into the details which are used to build diagnostics. Errors which wrap a Twilio error, i.e. errors returned by a waiter, are also translated.
+// False is returned when the error did not originate from the Twilio API
+func TranslateTwilioError(err error) (*TwilioErrorDetails, bool) {
+	var twilioError *utils.TwilioError
+	if !errors.As(err, &twilioError) {
+		return nil, false
+	}
+
+	details := &TwilioErrorDetails{
+		Status:    twilioError.Status,
+		Message:   twilioError.Message,
+		Parameter: parameterName(twilioError.Message),
+		Retryable: twilioError.Status == http.StatusTooManyRequests || twilioError.Status >= http.StatusInternalServerError,
+	}
+	if twilioError.Code != nil {
+		details.Code = *twilioError.Code
+	}
+	if twilioError.MoreInfo != nil {
+		details.MoreInfo = *twilioError.MoreInfo
+	}
+	if twilioError.Details != nil {
+		details.Details = *twilioError.Details
+	}
+
+	if errorCode, ok := twilioErrorCodes[details.Code]; ok {
+		details.Summary = errorCode.summary
+		details.Detail = errorCode.detail
+		details.Retryable = details.Retryable || errorCode.retryable
+	}
+
+	if details.MoreInfo == "" && details.Code != 0 {
+		details.MoreInfo = fmt.Sprintf("https://www.twilio.com/docs/errors/%d", details.Code)
+	}
+	return details, true
+}
+
+// IsRetryableError returns whether the request which caused the error can be retried, i.e. the request was rate limited or Twilio was unavailable
+func IsRetryableError(err error) bool {
+	if details, ok := TranslateTwilioError(err); ok {
+		return details.Retryable
+	}
+	return false
+}
+
+// TranslateError creates the diagnostics for an error returned by the Twilio SDK. The summary describes the operation which failed, i.e. "Failed to create serverless service".
+// Twilio errors are enriched with a description of the error code, the link to the Twilio documentation and whether the error is retryable. When the error relates to a parameter the diagnostic is attributed to the argument,
+// the attribute path is removed by RemoveUnknownAttributePaths when the argument does not exist in the schema of the resource.
+// All other errors are returned in the same format as diag.Errorf
+func TranslateError(summary string, err error) diag.Diagnostics {
+	details, ok := TranslateTwilioError(err)
+	if !ok {
+		return diag.Errorf("%s: %s", summary, err.Error())
+	}
+
+	detailLines := make([]string, 0)
+	if details.Summary != "" {
+		detailLines = append(detailLines, fmt.Sprintf("Twilio error %d (%s): %s.", details.Code, details.Summary, details.Detail))
+	} else if details.Code != 0 {
+		detailLines = append(detailLines, fmt.Sprintf("Twilio error %d.", details.Code))
+	}
+
+	if details.Retryable {
+		detailLines = append(detailLines, "This error is retryable, the request may succeed if it is retried.")
+	} else {
+		detailLines = append(detailLines, "This error is permanent, the request will not succeed if it is retried without changes.")
+	}
+
+	if len(details.Details) > 0 {
+		if content, err := json.Marshal(details.Details); err == nil {
+			detailLines = append(detailLines, fmt.Sprintf("Details: %s", content))
+		}
+	}
+
+	if details.MoreInfo != "" {
+		detailLines = append(detailLines, fmt.Sprintf("More information: %s", details.MoreInfo))
+	}
+
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s: %s", summary, err.Error()),
+		Detail:   strings.Join(detailLines, "\n"),
+	}
+	if details.Parameter != "" {
+		diagnostic.AttributePath = cty.GetAttrPath(details.Parameter)
+	}
+	return diag.Diagnostics{diagnostic}
+}
+
+// parameterName extracts the name of the parameter from the error message and converts it to the name of the Terraform argument i.e. FriendlyName is converted to friendly_name
+func parameterName(message string) string {
+	for _, regex := range parameterRegexes {
+		if match := regex.FindStringSubmatch(message); len(match) == 2 {
+			if alias, ok := parameterAliases[match[1]]; ok {
+				return alias
+			}
+			return strings.ToLower(capitalLetterRegex.ReplaceAllString(match[1], "${1}_${2}"))
+		}
+	}
+	return ""
+}
+
+// RemoveUnknownAttributePaths removes the attribute path from any diagnostic which refers to an argument that is not defined in the schema.
+// The parameter name is derived from the error message returned by Twilio, so the name may not correspond to an argument of the resource which returned the error
+func RemoveUnknownAttributePaths(diags diag.Diagnostics, schemaMap map[string]*schema.Schema) diag.Diagnostics {
+	for index := range diags {
+		if !attributePathExists(diags[index].AttributePath, schemaMap) {
+			diags[index].AttributePath = nil
+		}
+	}
+	return diags
+}
+
+func attributePathExists(path cty.Path, schemaMap map[string]*schema.Schema) bool {
+	var current *schema.Schema
+	for _, step := range path {
+		switch value := step.(type) {
+		case cty.GetAttrStep:
+			if current != nil {
+				resource, ok := current.Elem.(*schema.Resource)
+				if !ok {
+					return false
+				}
+				schemaMap = resource.Schema
+			}
+
+			var ok bool
+			if current, ok = schemaMap[value.Name]; !ok {
+				return false
+			}
+		case cty.IndexStep:
+			if current == nil || (current.Type != schema.TypeList && current.Type != schema.TypeSet && current.Type != schema.TypeMap) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+func TestTranslateTwilioError(t *testing.T) {
+	twilioError := &sdkUtils.TwilioError{
+		Code:     sdkUtils.Int(20001),
+		Message:  "Invalid parameter: FriendlyName",
+		MoreInfo: sdkUtils.String("https://www.twilio.com/docs/errors/20001"),
+		Details:  &map[string]interface{}{"field": "FriendlyName"},
+		Status:   400,
+	}
+
+	details, ok := TranslateTwilioError(twilioError)
+	if !ok {
+		t.Fatal("Expected the Twilio error to be translated")
+	}
+	if details.Status != 400 || details.Code != 20001 || details.Message != "Invalid parameter: FriendlyName" {
+		t.Errorf("Expected the status, code and message to be read from the error, got %+v", details)
+	}
+	if details.MoreInfo != "https://www.twilio.com/docs/errors/20001" {
+		t.Errorf("Expected the more info link to be read from the error, got %s", details.MoreInfo)
+	}
+	if details.Details["field"] != "FriendlyName" {
+		t.Errorf("Expected the details to be read from the error, got %v", details.Details)
+	}
+	if details.Parameter != "friendly_name" {
+		t.Errorf("Expected the parameter to be friendly_name, got %s", details.Parameter)
+	}
+	if details.Summary != "Invalid parameter" || details.Retryable {
+		t.Errorf("Expected a permanent invalid parameter error, got %+v", details)
+	}
+
+	if _, ok := TranslateTwilioError(errors.New("connection reset")); ok {
+		t.Error("Expected errors which did not originate from the Twilio API not to be translated")
+	}
+}
+
+func TestTranslateTwilioErrorWrapped(t *testing.T) {
+	err := fmt.Errorf("Failed whilst waiting for the build to complete: %w", &sdkUtils.TwilioError{
+		Code:    sdkUtils.Int(20429),
+		Message: "Too Many Requests",
+		Status:  429,
+	})
+
+	details, ok := TranslateTwilioError(err)
+	if !ok {
+		t.Fatal("Expected the wrapped Twilio error to be translated")
+	}
+	if details.Code != 20429 || details.Summary != "Too many requests" || !details.Retryable {
+		t.Errorf("Expected a retryable too many requests error, got %+v", details)
+	}
+	if !IsRetryableError(err) {
+		t.Error("Expected the wrapped error to be retryable")
+	}
+
+	if !IsNotFoundError(fmt.Errorf("wrapped: %w", &sdkUtils.TwilioError{Message: "Not Found", Status: 404})) {
+		t.Error("Expected the wrapped not found error to be identified")
+	}
+}
+
+func TestTranslateTwilioErrorWithoutOptionalFields(t *testing.T) {
+	details, ok := TranslateTwilioError(&sdkUtils.TwilioError{Message: "Service unavailable", Status: 503})
+	if !ok {
+		t.Fatal("Expected the Twilio error to be translated")
+	}
+	if details.Code != 0 || details.MoreInfo != "" || details.Details != nil {
+		t.Errorf("Expected the optional fields to be empty, got %+v", details)
+	}
+	if !details.Retryable {
+		t.Error("Expected a 503 error to be retryable")
+	}
+}
+
+func TestTranslateError(t *testing.T) {
+	diags := TranslateError("Failed to create service", &sdkUtils.TwilioError{
+		Code:    sdkUtils.Int(20001),
+		Message: "StatusCallback is not valid",
+		Details: &map[string]interface{}{"reason": "invalid url"},
+		Status:  400,
+	})
+	if len(diags) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d", len(diags))
+	}
+
+	diagnostic := diags[0]
+	if diagnostic.Summary != "Failed to create service: StatusCallback is not valid" {
+		t.Errorf("Unexpected summary %q", diagnostic.Summary)
+	}
+	for _, expected := range []string{"Twilio error 20001 (Invalid parameter)", "This error is permanent", `Details: {"reason":"invalid url"}`, "More information: https://www.twilio.com/docs/errors/20001"} {
+		if !strings.Contains(diagnostic.Detail, expected) {
+			t.Errorf("Expected the detail to contain %q, got %q", expected, diagnostic.Detail)
+		}
+	}
+	if !diagnostic.AttributePath.Equals(cty.GetAttrPath("status_callback_url")) {
+		t.Errorf("Expected the diagnostic to be attributed to status_callback_url, got %#v", diagnostic.AttributePath)
+	}
+
+	diags = TranslateError("Failed to create service", errors.New("connection reset"))
+	if len(diags) != 1 || diags[0].Summary != "Failed to create service: connection reset" || diags[0].AttributePath != nil {
+		t.Errorf("Expected other errors to be returned unchanged, got %+v", diags)
+	}
+}
+
+func TestRemoveUnknownAttributePaths(t *testing.T) {
+	schemaMap := map[string]*schema.Schema{
+		"friendly_name": {Type: schema.TypeString},
+		"voice": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path     cty.Path
+		expected cty.Path
+	}{
+		"no path":                {path: nil, expected: nil},
+		"known argument":         {path: cty.GetAttrPath("friendly_name"), expected: cty.GetAttrPath("friendly_name")},
+		"unknown argument":       {path: cty.GetAttrPath("url"), expected: nil},
+		"known nested argument":  {path: cty.GetAttrPath("voice").IndexInt(0).GetAttr("url"), expected: cty.GetAttrPath("voice").IndexInt(0).GetAttr("url")},
+		"unknown nested":         {path: cty.GetAttrPath("voice").IndexInt(0).GetAttr("method"), expected: nil},
+		"index of a primitive":   {path: cty.GetAttrPath("friendly_name").IndexInt(0), expected: nil},
+		"attribute of primitive": {path: cty.GetAttrPath("friendly_name").GetAttr("url"), expected: nil},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := RemoveUnknownAttributePaths(diag.Diagnostics{{Severity: diag.Error, Summary: "error", AttributePath: testCase.path}}, schemaMap)
+			if !diags[0].AttributePath.Equals(testCase.expected) {
+				t.Errorf("Expected the attribute path %#v, got %#v", testCase.expected, diags[0].AttributePath)
+			}
+		})
+	}
+}
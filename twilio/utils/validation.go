@@ -155,24 +155,17 @@ func IdentitySidValidation() schema.SchemaValidateFunc {
 	return validation.StringMatch(regexp.MustCompile("^RI[0-9a-fA-F]{32}$"), "")
 }
 
-// Messaging
-
-func MessagingAlphaSenderSidValidation() schema.SchemaValidateFunc {
-	return validation.StringMatch(regexp.MustCompile("^AI[0-9a-fA-F]{32}$"), "")
-}
-
-func MessagingServiceSidValidation() schema.SchemaValidateFunc {
-	return validation.StringMatch(regexp.MustCompile("^MG[0-9a-fA-F]{32}$"), "")
-}
-
 // Phone Number
 
 func PhoneNumberSidValidation() schema.SchemaValidateFunc {
 	return validation.StringMatch(regexp.MustCompile("^PN[0-9a-fA-F]{32}$"), "")
 }
 
+// PhoneNumberRegex matches phone numbers in the E.164 format
+var PhoneNumberRegex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
 func PhoneNumberValidation() schema.SchemaValidateFunc {
-	return validation.StringMatch(regexp.MustCompile(`^\+[1-9]\d{1,14}$`), "")
+	return validation.StringMatch(PhoneNumberRegex, "")
 }
 
 // Proxy
@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// WaiterRefreshFunc fetches the latest version of the resource being waited on and returns the resource along with the current state
+type WaiterRefreshFunc func(ctx context.Context) (result interface{}, state string, err error)
+
+// Waiter polls a resource until it reaches one of the target states. The waiter stops when the context is cancelled (i.e. Terraform is interrupted), the timeout is reached or the maximum number of attempts have been made.
+// The delay between each attempt is doubled after each attempt until the maximum delay is reached, when MaxDelay is not set the delay is constant
+type Waiter struct {
+	// Description is the name of the resource being waited on i.e. "serverless build", which is used in log and error messages
+	Description string
+	// Pending are the states which the resource can be in whilst waiting for a target state. Any other state causes the waiter to fail
+	Pending []string
+	// Target are the states which the resource is expected to reach
+	Target []string
+	// Refresh is called on each attempt to fetch the current state of the resource
+	Refresh WaiterRefreshFunc
+	// Timeout is the maximum duration to wait, this should normally be the timeout of the resource operation i.e. d.Timeout(schema.TimeoutCreate)
+	Timeout time.Duration
+	// Delay is the delay before the second attempt, defaults to 1 second
+	Delay time.Duration
+	// MaxDelay is the upper limit of the exponential delay
+	MaxDelay time.Duration
+	// MaxAttempts is the maximum number of times the resource is fetched, when 0 the number of attempts is only limited by the timeout
+	MaxAttempts int
+}
+
+// UnexpectedStateError is returned when the resource reaches a state which is neither pending or a target, i.e. a build has failed
+type UnexpectedStateError struct {
+	Description string
+	State       string
+	Target      []string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("The %s reached an unexpected state (%s) whilst waiting for state (%s)", e.Description, e.State, strings.Join(e.Target, ", "))
+}
+
+// WaiterTimeoutError is returned when the resource did not reach a target state before the timeout or the maximum number of attempts was reached
+type WaiterTimeoutError struct {
+	Description string
+	LastState   string
+	Target      []string
+	Attempts    int
+}
+
+func (e *WaiterTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %d attempt(s) waiting for the %s to reach state (%s), the last state was (%s)", e.Attempts, e.Description, strings.Join(e.Target, ", "), e.LastState)
+}
+
+// WaitWithContext polls the resource until a target state is reached and returns the latest version of the resource
+func (w *Waiter) WaitWithContext(ctx context.Context) (interface{}, error) {
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	delay := w.Delay
+	if delay <= 0 {
+		delay = time.Second
+	}
+	lastState := ""
+	for attempt := 1; ; attempt++ {
+		log.Printf("[INFO] Waiting for %s to reach state (%s), attempt # %d", w.Description, strings.Join(w.Target, ", "), attempt)
+
+		result, state, err := w.Refresh(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, w.contextError(ctx, lastState, attempt)
+			}
+			if !IsRetryableError(err) {
+				return nil, err
+			}
+			log.Printf("[WARN] Retryable error whilst waiting for %s: %s", w.Description, err.Error())
+		} else {
+			lastState = state
+			if containsState(w.Target, state) {
+				return result, nil
+			}
+			if !containsState(w.Pending, state) {
+				return result, &UnexpectedStateError{
+					Description: w.Description,
+					State:       state,
+					Target:      w.Target,
+				}
+			}
+		}
+
+		if w.MaxAttempts > 0 && attempt >= w.MaxAttempts {
+			return nil, &WaiterTimeoutError{
+				Description: w.Description,
+				LastState:   lastState,
+				Target:      w.Target,
+				Attempts:    attempt,
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, w.contextError(ctx, lastState, attempt)
+		case <-timer.C:
+		}
+
+		delay = w.nextDelay(delay)
+	}
+}
+
+// nextDelay doubles the delay until the maximum delay is reached, the delay is not changed when MaxDelay is not set
+func (w *Waiter) nextDelay(delay time.Duration) time.Duration {
+	if w.MaxDelay > delay {
+		delay *= 2
+		if delay > w.MaxDelay {
+			delay = w.MaxDelay
+		}
+	}
+	return delay
+}
+
+func (w *Waiter) contextError(ctx context.Context, lastState string, attempts int) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return &WaiterTimeoutError{
+			Description: w.Description,
+			LastState:   lastState,
+			Target:      w.Target,
+			Attempts:    attempts,
+		}
+	}
+	return fmt.Errorf("Cancelled whilst waiting for the %s: %s", w.Description, ctx.Err().Error())
+}
+
+func containsState(states []string, state string) bool {
+	for _, value := range states {
+		if value == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	sdkUtils "github.com/timworks/twilio-sdk-go/utils"
+)
+
+// testRefreshFunc returns the supplied states in order, the last state is returned once all other states have been returned
+func testRefreshFunc(attempts *int, states ...string) WaiterRefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
+		index := *attempts
+		*attempts++
+		if index >= len(states) {
+			index = len(states) - 1
+		}
+		return states[index], states[index], nil
+	}
+}
+
+func TestWaiterTargetReached(t *testing.T) {
+	attempts := 0
+	waiter := &Waiter{
+		Description: "serverless build",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh:     testRefreshFunc(&attempts, "building", "building", "completed"),
+		Delay:       time.Millisecond,
+	}
+
+	result, err := waiter.WaitWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+	if result != "completed" {
+		t.Errorf("Expected the latest version of the resource to be returned, got %v", result)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestWaiterUnexpectedState(t *testing.T) {
+	attempts := 0
+	waiter := &Waiter{
+		Description: "serverless build",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh:     testRefreshFunc(&attempts, "building", "failed", "completed"),
+		Delay:       time.Millisecond,
+	}
+
+	result, err := waiter.WaitWithContext(context.Background())
+	var unexpectedStateError *UnexpectedStateError
+	if !errors.As(err, &unexpectedStateError) {
+		t.Fatalf("Expected an unexpected state error, got %v", err)
+	}
+	if unexpectedStateError.State != "failed" || err.Error() != "The serverless build reached an unexpected state (failed) whilst waiting for state (completed)" {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	if result != "failed" {
+		t.Errorf("Expected the resource in the unexpected state to be returned, got %v", result)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected the waiter to stop after 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWaiterMaxAttempts(t *testing.T) {
+	attempts := 0
+	waiter := &Waiter{
+		Description: "flex plugin release",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh:     testRefreshFunc(&attempts, "building"),
+		Delay:       time.Millisecond,
+		MaxAttempts: 3,
+	}
+
+	_, err := waiter.WaitWithContext(context.Background())
+	var timeoutError *WaiterTimeoutError
+	if !errors.As(err, &timeoutError) {
+		t.Fatalf("Expected a timeout error, got %v", err)
+	}
+	if timeoutError.Attempts != 3 || timeoutError.LastState != "building" {
+		t.Errorf("Unexpected timeout error: %+v", timeoutError)
+	}
+	if err.Error() != "Timed out after 3 attempt(s) waiting for the flex plugin release to reach state (completed), the last state was (building)" {
+		t.Errorf("Unexpected error message: %s", err.Error())
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	attempts := 0
+	waiter := &Waiter{
+		Description: "autopilot model build",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh:     testRefreshFunc(&attempts, "building"),
+		Timeout:     50 * time.Millisecond,
+		Delay:       10 * time.Millisecond,
+	}
+
+	start := time.Now()
+	_, err := waiter.WaitWithContext(context.Background())
+	var timeoutError *WaiterTimeoutError
+	if !errors.As(err, &timeoutError) {
+		t.Fatalf("Expected a timeout error, got %v", err)
+	}
+	if timeoutError.LastState != "building" || timeoutError.Attempts < 1 {
+		t.Errorf("Unexpected timeout error: %+v", timeoutError)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > 5*time.Second {
+		t.Errorf("Expected the waiter to stop once the timeout was reached, waited %s", elapsed)
+	}
+}
+
+func TestWaiterTimeoutWhilstRefreshing(t *testing.T) {
+	waiter := &Waiter{
+		Description: "serverless deployment",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			<-ctx.Done()
+			return nil, "", ctx.Err()
+		},
+		Timeout: 10 * time.Millisecond,
+	}
+
+	_, err := waiter.WaitWithContext(context.Background())
+	var timeoutError *WaiterTimeoutError
+	if !errors.As(err, &timeoutError) {
+		t.Fatalf("Expected a timeout error, got %v", err)
+	}
+}
+
+func TestWaiterContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	waiter := &Waiter{
+		Description: "serverless build",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			attempts++
+			if attempts == 2 {
+				cancel()
+			}
+			return nil, "building", nil
+		},
+		Delay: time.Millisecond,
+	}
+
+	_, err := waiter.WaitWithContext(ctx)
+	if err == nil || err.Error() != "Cancelled whilst waiting for the serverless build: context canceled" {
+		t.Fatalf("Expected a cancellation error, got %v", err)
+	}
+	var timeoutError *WaiterTimeoutError
+	if errors.As(err, &timeoutError) {
+		t.Error("Expected a cancellation not to be reported as a timeout")
+	}
+	if attempts != 2 {
+		t.Errorf("Expected the waiter to stop after 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWaiterErrors(t *testing.T) {
+	attempts := 0
+	waiter := &Waiter{
+		Description: "serverless build",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			attempts++
+			switch attempts {
+			case 1:
+				return nil, "", &sdkUtils.TwilioError{Message: "Too Many Requests", Status: 429}
+			case 2:
+				return nil, "", &sdkUtils.TwilioError{Message: "Forbidden", Status: 403}
+			}
+			return nil, "completed", nil
+		},
+		Delay: time.Millisecond,
+	}
+
+	_, err := waiter.WaitWithContext(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "Forbidden") {
+		t.Fatalf("Expected the permanent error to be returned, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected the retryable error to be retried and the permanent error to stop the waiter, got %d attempts", attempts)
+	}
+}
+
+func TestWaiterNextDelay(t *testing.T) {
+	testCases := map[string]struct {
+		waiter   *Waiter
+		expected []time.Duration
+	}{
+		"constant delay without max delay": {
+			waiter:   &Waiter{Delay: time.Second},
+			expected: []time.Duration{time.Second, time.Second, time.Second, time.Second},
+		},
+		"delay doubles until max delay": {
+			waiter:   &Waiter{Delay: time.Second, MaxDelay: 5 * time.Second},
+			expected: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+		"max delay lower than delay": {
+			waiter:   &Waiter{Delay: 2 * time.Second, MaxDelay: time.Second},
+			expected: []time.Duration{2 * time.Second, 2 * time.Second, 2 * time.Second},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			delay := testCase.waiter.Delay
+			for index, expected := range testCase.expected {
+				if delay != expected {
+					t.Fatalf("Expected delay %d to be %s, got %s", index, expected, delay)
+				}
+				delay = testCase.waiter.nextDelay(delay)
+			}
+		})
+	}
+}
+
+func TestWaiterBackoff(t *testing.T) {
+	attemptTimes := make([]time.Time, 0)
+	waiter := &Waiter{
+		Description: "serverless build",
+		Pending:     []string{"building"},
+		Target:      []string{"completed"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			attemptTimes = append(attemptTimes, time.Now())
+			if len(attemptTimes) == 5 {
+				return nil, "completed", nil
+			}
+			return nil, "building", nil
+		},
+		Delay:    10 * time.Millisecond,
+		MaxDelay: 40 * time.Millisecond,
+	}
+
+	if _, err := waiter.WaitWithContext(context.Background()); err != nil {
+		t.Fatalf("err: %s", err.Error())
+	}
+
+	// Timers only guarantee the minimum duration, so only the lower bound of each delay is checked
+	for index, expected := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+		if actual := attemptTimes[index+1].Sub(attemptTimes[index]); actual < expected {
+			t.Errorf("Expected delay %d to be at least %s, got %s", index, expected, actual)
+		}
+	}
+}